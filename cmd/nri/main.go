@@ -0,0 +1,165 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Command nri inspects the plugins connected to the NRI of a runtime, for
+// debugging NRI on a node.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/net/multiplex"
+	"github.com/containerd/ttrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const usage = `Usage: %s [options] <command> [command options]
+
+Commands:
+  plugins    list registered plugins, their subscriptions and statistics
+  hooks      list per-hook request statistics of registered plugins
+  simulate   show the adjustments plugins would make to a hypothetical pod
+  new-plugin generate the skeleton of a new plugin
+  manifests  generate deployment manifests for a plugin
+
+Options:
+`
+
+// command is a CLI command, run with a connection to the runtime.
+type command struct {
+	// flags of the command, if any.
+	flags *flag.FlagSet
+	// local commands do not talk to the runtime.
+	local bool
+	// run the command, writing its output in the given format.
+	run func(ctx context.Context, runtime api.RuntimeService, w io.Writer, output string) error
+}
+
+func main() {
+	var (
+		socketPath string
+		output     string
+		timeout    time.Duration
+	)
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage, os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.StringVar(&socketPath, "socket", api.DefaultSocketPath, "NRI socket to connect to")
+	flag.StringVar(&output, "o", "table", "output format, table or json")
+	flag.DurationVar(&timeout, "timeout", 5*time.Second, "timeout for talking to the runtime")
+	flag.Parse()
+
+	commands := map[string]*command{
+		"plugins":    pluginsCommand(),
+		"hooks":      hooksCommand(),
+		"simulate":   simulateCommand(),
+		"new-plugin": newPluginCommand(),
+		"manifests":  manifestsCommand(),
+	}
+
+	name, args := "plugins", []string(nil)
+	if flag.NArg() > 0 {
+		name, args = flag.Arg(0), flag.Args()[1:]
+	}
+
+	cmd, ok := commands[name]
+	if !ok || (output != "table" && output != "json") {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if cmd.flags != nil {
+		if err := cmd.flags.Parse(args); err != nil {
+			os.Exit(2)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var (
+		runtime    api.RuntimeService
+		disconnect = func() {}
+		err        error
+	)
+	if !cmd.local {
+		runtime, disconnect, err = connect(ctx, socketPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+	}
+	defer disconnect()
+
+	if err = cmd.run(ctx, runtime, os.Stdout, output); err != nil {
+		fmt.Fprintf(os.Stderr, "%s failed: %v\n", name, err)
+		disconnect()
+		os.Exit(1)
+	}
+}
+
+// connect to the runtime, returning a client for its Runtime service. The
+// connection is never registered as a plugin.
+func connect(ctx context.Context, socketPath string) (api.RuntimeService, func(), error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", socketPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to NRI socket %s: %w", socketPath, err)
+	}
+
+	mux := multiplex.Multiplex(conn)
+	rconn, err := mux.Open(multiplex.RuntimeServiceConn)
+	if err != nil {
+		mux.Close()
+		return nil, nil, fmt.Errorf("failed to multiplex runtime connection: %w", err)
+	}
+
+	rpcc := ttrpc.NewClient(rconn)
+	disconnect := func() {
+		rpcc.Close()
+		mux.Close()
+	}
+
+	// Identify ourselves, so the runtime does not wait for us to register
+	// as a plugin. Older runtimes do not know about clients.
+	runtime := api.NewRuntimeClient(rpcc)
+	_, err = runtime.RegisterClient(ctx, &api.RegisterClientRequest{
+		ClientName: filepath.Base(os.Args[0]),
+	})
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		disconnect()
+		return nil, nil, fmt.Errorf("failed to register client: %w", err)
+	}
+
+	return runtime, disconnect, nil
+}
+
+func showJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
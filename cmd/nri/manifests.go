@@ -0,0 +1,68 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/deploy"
+)
+
+func manifestsCommand() *command {
+	var (
+		flags     = flag.NewFlagSet("manifests", flag.ExitOnError)
+		specFile  string
+		image     string
+		namespace string
+	)
+
+	flags.StringVar(&specFile, "spec", "deploy.yaml", "YAML file with the deployment spec of the plugin")
+	flags.StringVar(&image, "image", "", "container image of the plugin, overriding the spec")
+	flags.StringVar(&namespace, "namespace", "", "namespace to deploy to, overriding the spec")
+
+	return &command{
+		flags: flags,
+		local: true,
+		run: func(_ context.Context, _ api.RuntimeService, w io.Writer, output string) error {
+			if output == "json" {
+				return errors.New("manifests are only generated as YAML")
+			}
+
+			spec, err := deploy.LoadSpec(specFile)
+			if err != nil {
+				return err
+			}
+			if image != "" {
+				spec.Image = image
+			}
+			if namespace != "" {
+				spec.Namespace = namespace
+			}
+
+			manifests, err := spec.Manifests()
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(manifests)
+			return err
+		},
+	}
+}
@@ -0,0 +1,284 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strings"
+	"text/template"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+// pluginHook is a plugin handler the generator can produce.
+type pluginHook struct {
+	Name      string
+	Interface string
+	Signature string
+	Body      string
+}
+
+const (
+	podHookSignature       = "(pod *api.PodSandbox) error"
+	containerHookSignature = "(pod *api.PodSandbox, ctr *api.Container) error"
+	podHookBody            = "\tlog.Infof(\"%s pod %%s/%%s...\", pod.GetNamespace(), pod.GetName())\n\treturn nil"
+	containerHookBody      = "\tlog.Infof(\"%s container %%s/%%s/%%s...\", pod.GetNamespace(), pod.GetName(), ctr.GetName())\n\treturn nil"
+)
+
+// pluginHooks are the plugin handlers the generator can produce, by event.
+var pluginHooks = map[api.Event]*pluginHook{
+	api.Event_RUN_POD_SANDBOX: {
+		Name:      "RunPodSandbox",
+		Interface: "RunPodInterface",
+		Signature: podHookSignature,
+		Body:      fmt.Sprintf(podHookBody, "Started"),
+	},
+	api.Event_STOP_POD_SANDBOX: {
+		Name:      "StopPodSandbox",
+		Interface: "StopPodInterface",
+		Signature: podHookSignature,
+		Body:      fmt.Sprintf(podHookBody, "Stopped"),
+	},
+	api.Event_REMOVE_POD_SANDBOX: {
+		Name:      "RemovePodSandbox",
+		Interface: "RemovePodInterface",
+		Signature: podHookSignature,
+		Body:      fmt.Sprintf(podHookBody, "Removed"),
+	},
+	api.Event_CREATE_CONTAINER: {
+		Name:      "CreateContainer",
+		Interface: "CreateContainerInterface",
+		Signature: "(pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)",
+		Body: "\tlog.Infof(\"Creating container %s/%s/%s...\", pod.GetNamespace(), pod.GetName(), ctr.GetName())\n\n" +
+			"\t// Adjust the container being created, see pkg/api/adjustment.go\n" +
+			"\t// for the available controls.\n" +
+			"\tadjust := &api.ContainerAdjustment{}\n\n" +
+			"\treturn adjust, nil, nil",
+	},
+	api.Event_POST_CREATE_CONTAINER: {
+		Name:      "PostCreateContainer",
+		Interface: "PostCreateContainerInterface",
+		Signature: containerHookSignature,
+		Body:      fmt.Sprintf(containerHookBody, "Created"),
+	},
+	api.Event_START_CONTAINER: {
+		Name:      "StartContainer",
+		Interface: "StartContainerInterface",
+		Signature: containerHookSignature,
+		Body:      fmt.Sprintf(containerHookBody, "Starting"),
+	},
+	api.Event_POST_START_CONTAINER: {
+		Name:      "PostStartContainer",
+		Interface: "PostStartContainerInterface",
+		Signature: containerHookSignature,
+		Body:      fmt.Sprintf(containerHookBody, "Started"),
+	},
+	api.Event_UPDATE_CONTAINER: {
+		Name:      "UpdateContainer",
+		Interface: "UpdateContainerInterface",
+		Signature: "(pod *api.PodSandbox, ctr *api.Container) ([]*api.ContainerUpdate, error)",
+		Body: "\tlog.Infof(\"Updating container %s/%s/%s...\", pod.GetNamespace(), pod.GetName(), ctr.GetName())\n\n" +
+			"\t// Alter the pending update, or update other containers, see\n" +
+			"\t// pkg/api/update.go for the available controls.\n" +
+			"\treturn nil, nil",
+	},
+	api.Event_POST_UPDATE_CONTAINER: {
+		Name:      "PostUpdateContainer",
+		Interface: "PostUpdateContainerInterface",
+		Signature: containerHookSignature,
+		Body:      fmt.Sprintf(containerHookBody, "Updated"),
+	},
+	api.Event_STOP_CONTAINER: {
+		Name:      "StopContainer",
+		Interface: "StopContainerInterface",
+		Signature: "(pod *api.PodSandbox, ctr *api.Container) ([]*api.ContainerUpdate, error)",
+		Body: "\tlog.Infof(\"Stopped container %s/%s/%s...\", pod.GetNamespace(), pod.GetName(), ctr.GetName())\n\n" +
+			"\t// Update the remaining containers, see pkg/api/update.go for the\n" +
+			"\t// available controls.\n" +
+			"\treturn nil, nil",
+	},
+	api.Event_REMOVE_CONTAINER: {
+		Name:      "RemoveContainer",
+		Interface: "RemoveContainerInterface",
+		Signature: containerHookSignature,
+		Body:      fmt.Sprintf(containerHookBody, "Removed"),
+	},
+}
+
+// pluginFiles are the files generated for a plugin, by template.
+var pluginFiles = map[string]string{
+	"main.go.tmpl":        "main.go",
+	"plugin_test.go.tmpl": "plugin_test.go",
+	"go.mod.tmpl":         "go.mod",
+	"Dockerfile.tmpl":     "Dockerfile",
+	"deploy.yaml.tmpl":    "deploy.yaml",
+	"README.md.tmpl":      "README.md",
+}
+
+// newPlugin is the data the plugin templates are executed with.
+type newPlugin struct {
+	Name       string
+	Index      string
+	Module     string
+	Image      string
+	NRIVersion string
+	NRIReplace string
+	Hooks      []*pluginHook
+}
+
+func newPluginCommand() *command {
+	var (
+		flags = flag.NewFlagSet("new-plugin", flag.ExitOnError)
+		p     = &newPlugin{}
+		hooks string
+		dir   string
+	)
+
+	flags.StringVar(&p.Name, "name", "", "name of the plugin")
+	flags.StringVar(&p.Index, "idx", "50", "default plugin index")
+	flags.StringVar(&p.Module, "module", "", "Go module path of the plugin, example.com/<name> by default")
+	flags.StringVar(&p.Image, "image", "", "container image of the plugin, <name>:latest by default")
+	flags.StringVar(&p.NRIVersion, "nri-version", nriVersion(), "NRI version to require")
+	flags.StringVar(&p.NRIReplace, "nri-replace", "", "local NRI source tree to replace the NRI module with")
+	flags.StringVar(&hooks, "hooks", "CreateContainer", "comma-separated events to generate handlers for")
+	flags.StringVar(&dir, "dir", "", "directory to generate the plugin in, ./<name> by default")
+
+	return &command{
+		flags: flags,
+		local: true,
+		run: func(_ context.Context, _ api.RuntimeService, w io.Writer, _ string) error {
+			if err := p.setDefaults(hooks); err != nil {
+				return err
+			}
+			if dir == "" {
+				dir = p.Name
+			}
+			if err := p.generate(dir); err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "Generated plugin %s in %s. To build and test it, run\n", p.Name, dir)
+			fmt.Fprintf(w, "  cd %s && go mod tidy && go test ./... && go build .\n", dir)
+			return nil
+		},
+	}
+}
+
+// setDefaults validates the plugin parameters and fills in any defaults.
+func (p *newPlugin) setDefaults(hooks string) error {
+	if p.Name == "" {
+		return errors.New("no plugin name given")
+	}
+	if strings.ContainsAny(p.Name, "-/ ") {
+		return fmt.Errorf("invalid plugin name %q", p.Name)
+	}
+	if err := api.CheckPluginIndex(p.Index); err != nil {
+		return err
+	}
+	if p.Module == "" {
+		p.Module = "example.com/" + p.Name
+	}
+	if p.Image == "" {
+		p.Image = p.Name + ":latest"
+	}
+
+	var mask api.EventMask
+	for _, name := range strings.Split(hooks, ",") {
+		m, err := api.ParseEventMask(name)
+		if err != nil {
+			return err
+		}
+		if m == 0 {
+			return fmt.Errorf("unknown hook %q", name)
+		}
+		mask |= m
+	}
+	for e := api.Event_UNKNOWN + 1; e < api.Event_LAST; e++ {
+		if !mask.IsSet(e) {
+			continue
+		}
+		h, ok := pluginHooks[e]
+		if !ok {
+			events := api.EventMask(0)
+			return fmt.Errorf("generating %s handlers is not supported", events.Set(e).PrettyString())
+		}
+		p.Hooks = append(p.Hooks, h)
+	}
+	if len(p.Hooks) == 0 {
+		return errors.New("no hooks given")
+	}
+
+	return nil
+}
+
+// generate the plugin in the given directory, which must not contain any
+// of the generated files yet.
+func (p *newPlugin) generate(dir string) error {
+	tmpl, err := template.ParseFS(templates, "templates/*.tmpl")
+	if err != nil {
+		return fmt.Errorf("failed to parse plugin templates: %w", err)
+	}
+
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create plugin directory: %w", err)
+	}
+
+	for name, file := range pluginFiles {
+		path := filepath.Join(dir, file)
+		if _, err = os.Stat(path); err == nil {
+			return fmt.Errorf("refusing to overwrite existing file %s", path)
+		}
+
+		buf := &bytes.Buffer{}
+		if err = tmpl.ExecuteTemplate(buf, name, p); err != nil {
+			return fmt.Errorf("failed to generate %s: %w", path, err)
+		}
+		data := buf.Bytes()
+		if filepath.Ext(file) == ".go" {
+			if data, err = format.Source(data); err != nil {
+				return fmt.Errorf("failed to format %s: %w", path, err)
+			}
+		}
+		if err = os.WriteFile(path, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// nriVersion returns the version of NRI the CLI was built from.
+func nriVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		if v := info.Main.Version; v != "" && v != "(devel)" {
+			return v
+		}
+	}
+	return "latest"
+}
@@ -0,0 +1,97 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+func pluginsCommand() *command {
+	return &command{
+		run: func(ctx context.Context, runtime api.RuntimeService, w io.Writer, output string) error {
+			plugins, err := listPlugins(ctx, runtime)
+			if err != nil {
+				return err
+			}
+			if output == "json" {
+				return showJSON(w, plugins)
+			}
+			return showPlugins(w, plugins)
+		},
+	}
+}
+
+func hooksCommand() *command {
+	return &command{
+		run: func(ctx context.Context, runtime api.RuntimeService, w io.Writer, output string) error {
+			plugins, err := listPlugins(ctx, runtime)
+			if err != nil {
+				return err
+			}
+			if output == "json" {
+				return showJSON(w, plugins)
+			}
+			return showHooks(w, plugins)
+		},
+	}
+}
+
+func listPlugins(ctx context.Context, runtime api.RuntimeService) ([]*api.PluginInfo, error) {
+	rpl, err := runtime.ListPlugins(ctx, &api.ListPluginsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return rpl.GetPlugins(), nil
+}
+
+func showPlugins(w io.Writer, plugins []*api.PluginInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "INDEX\tNAME\tCALLS\tERRORS\tLAST ERROR\tBUILD\tEVENTS")
+	for _, p := range plugins {
+		var calls, errors uint64
+		for _, h := range p.GetStats().GetHooks() {
+			calls += h.GetCalls()
+			errors += h.GetErrors()
+		}
+		lastError := "-"
+		if at := p.GetStats().LastErrorAt(); !at.IsZero() {
+			lastError = fmt.Sprintf("%s ago: %s", time.Since(at).Round(time.Second), p.GetStats().GetLastError())
+		}
+		events := p.EventMask()
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n", p.GetIndex(), p.GetName(),
+			calls, errors, lastError, p.GetBuildInfo().Summary(), events.PrettyString())
+	}
+	return tw.Flush()
+}
+
+func showHooks(w io.Writer, plugins []*api.PluginInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "PLUGIN\tHOOK\tCALLS\tERRORS\tAVG LATENCY\tMAX LATENCY")
+	for _, p := range plugins {
+		for _, h := range p.GetStats().GetHooks() {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\t%s\n", p.FullName(), h.GetHook(),
+				h.GetCalls(), h.GetErrors(), h.AverageLatency(), time.Duration(h.GetMaxLatency()))
+		}
+	}
+	return tw.Flush()
+}
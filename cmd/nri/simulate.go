@@ -0,0 +1,137 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+func simulateCommand() *command {
+	var (
+		flags   = flag.NewFlagSet("simulate", flag.ExitOnError)
+		podFile string
+	)
+
+	flags.StringVar(&podFile, "pod", "", "YAML or JSON file with the pod and containers to simulate")
+
+	return &command{
+		flags: flags,
+		run: func(ctx context.Context, runtime api.RuntimeService, w io.Writer, output string) error {
+			req, err := loadPod(podFile)
+			if err != nil {
+				return err
+			}
+			rpl, err := runtime.SimulatePod(ctx, req)
+			if err != nil {
+				return err
+			}
+			if output == "json" {
+				return showJSON(w, rpl)
+			}
+			return showSimulation(w, rpl)
+		},
+	}
+}
+
+// loadPod loads a hypothetical pod and its containers from a file, filling
+// in any missing IDs.
+func loadPod(path string) (*api.SimulatePodRequest, error) {
+	if path == "" {
+		return nil, errors.New("no pod file given")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pod file: %w", err)
+	}
+
+	req := &api.SimulatePodRequest{}
+	if err = yaml.UnmarshalStrict(data, req); err != nil {
+		return nil, fmt.Errorf("failed to parse pod file %s: %w", path, err)
+	}
+
+	pod := req.GetPod()
+	if pod == nil {
+		return nil, fmt.Errorf("invalid pod file %s, no pod given", path)
+	}
+	if pod.Id == "" {
+		pod.Id = "simulated-" + pod.Name
+	}
+	for i, ctr := range req.Containers {
+		if ctr.Id == "" {
+			ctr.Id = fmt.Sprintf("%s-%d", pod.Id, i)
+		}
+		if ctr.PodSandboxId == "" {
+			ctr.PodSandboxId = pod.Id
+		}
+	}
+
+	return req, nil
+}
+
+func showSimulation(w io.Writer, rpl *api.SimulatePodResponse) error {
+	for _, r := range rpl.GetRequests() {
+		event := api.EventMask(0)
+		what := r.GetPlugin() + ": " + event.Set(r.GetEvent()).PrettyString()
+		if r.GetContainerId() != "" {
+			what += " " + r.GetContainerId()
+		}
+
+		var result interface{}
+		switch {
+		case r.GetError() != "":
+			fmt.Fprintf(w, "%s: failed: %s\n", what, r.GetError())
+			continue
+		case r.GetPodAdjust() != nil:
+			result = r.GetPodAdjust()
+		case r.GetAdjust() != nil || len(r.GetUpdate()) > 0:
+			result = struct {
+				Adjust *api.ContainerAdjustment `json:"adjust,omitempty"`
+				Update []*api.ContainerUpdate   `json:"update,omitempty"`
+			}{r.GetAdjust(), r.GetUpdate()}
+		default:
+			fmt.Fprintf(w, "%s: no adjustments\n", what)
+			continue
+		}
+
+		data, err := yaml.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to marshal simulation result: %w", err)
+		}
+		fmt.Fprintf(w, "%s:\n", what)
+		for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+
+	if len(rpl.GetSkipped()) > 0 {
+		fmt.Fprintf(w, "skipped plugins without dry-run support: %s\n",
+			strings.Join(rpl.GetSkipped(), ", "))
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ package adaptation
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -49,19 +50,50 @@ type SyncCB func(context.Context, []*PodSandbox, []*Container) ([]*ContainerUpda
 // UpdateFn is a container runtime function for unsolicited container updates.
 type UpdateFn func(context.Context, []*ContainerUpdate) ([]*ContainerUpdate, error)
 
+// StatsFn is a container runtime function for getting the current resource
+// usage of a container or pod sandbox by its ID.
+type StatsFn func(context.Context, string) (*Stats, error)
+
+// RestartFn is a container runtime function for restarting or stopping a
+// container on behalf of the named plugin. The runtime is expected to check
+// if the plugin is authorized to do so and return an error if it is not.
+type RestartFn func(ctx context.Context, plugin string, req *RestartContainerRequest) error
+
 // Adaptation is the NRI abstraction for container runtime NRI adaptation/integration.
 type Adaptation struct {
 	sync.Mutex
-	name       string
-	version    string
-	dropinPath string
-	pluginPath string
-	socketPath string
-	dontListen bool
-	syncFn     SyncFn
-	updateFn   UpdateFn
-	listener   net.Listener
-	plugins    []*plugin
+	name         string
+	version      string
+	buildInfo    *BuildInfo
+	dropinPath   string
+	pluginPath   string
+	socketPath   string
+	dontListen   bool
+	compress     int
+	sysctls      []string
+	uidRanges    []IDRange
+	gidRanges    []IDRange
+	caps         map[string]struct{}
+	peerPolicy   *PeerPolicy
+	scopeGrants  map[string]*ScopeMask
+	responseKeys map[string]ed25519.PublicKey
+	syncFn       SyncFn
+	updateFn     UpdateFn
+	statsFn      StatsFn
+	restartFn    RestartFn
+	readiness    networkReadiness
+	listener     net.Listener
+	plugins      []*plugin
+	pluginData   *pluginData
+	state        *stateLog
+	chainLock    sync.RWMutex
+	chain        []*chainEntry
+}
+
+// chainEntry is a snapshot of a plugin in the plugin chain.
+type chainEntry struct {
+	info  *PluginInfo
+	stats *pluginStats
 }
 
 var (
@@ -104,6 +136,83 @@ func WithDisabledExternalConnections() Option {
 	}
 }
 
+// WithCompression returns an option to compress messages of at least the
+// given size to plugins which support compression.
+func WithCompression(size int) Option {
+	return func(r *Adaptation) error {
+		if size < 0 {
+			return fmt.Errorf("invalid compression size %d", size)
+		}
+		r.compress = size
+		return nil
+	}
+}
+
+// WithStatsFn returns an option to set the runtime function used to get the
+// resource usage of containers and pod sandboxes for plugins. Without it
+// plugins cannot query resource usage.
+func WithStatsFn(fn StatsFn) Option {
+	return func(r *Adaptation) error {
+		r.statsFn = fn
+		return nil
+	}
+}
+
+// WithRestartFn returns an option to set the runtime function used to restart
+// or stop containers on behalf of plugins. Without it plugins are not allowed
+// to restart or stop containers.
+func WithRestartFn(fn RestartFn) Option {
+	return func(r *Adaptation) error {
+		r.restartFn = fn
+		return nil
+	}
+}
+
+// WithSysctlAllowlist returns an option to set the sysctls plugins are allowed
+// to adjust. Patterns are full sysctl names, or prefixes with a trailing '*'.
+// By default plugins are not allowed to adjust any sysctls.
+func WithSysctlAllowlist(patterns ...string) Option {
+	return func(r *Adaptation) error {
+		r.sysctls = append(r.sysctls, patterns...)
+		return nil
+	}
+}
+
+// WithCapabilityAllowlist returns an option to set the maximum set of
+// capabilities plugins are allowed to add to containers. Capabilities are
+// given by their full name, like CAP_NET_ADMIN. By default plugins are not
+// allowed to add any capabilities. Plugins can always drop capabilities.
+func WithCapabilityAllowlist(capabilities ...string) Option {
+	return func(r *Adaptation) error {
+		if r.caps == nil {
+			r.caps = make(map[string]struct{})
+		}
+		for _, c := range capabilities {
+			if !isCapabilityName(c) {
+				return fmt.Errorf("invalid capability %q", c)
+			}
+			r.caps[c] = struct{}{}
+		}
+		return nil
+	}
+}
+
+// WithUserNamespaceRanges returns an option to set the ranges of host user
+// and group IDs plugins are allowed to map into user namespaces. By default
+// plugins are not allowed to adjust user namespace ID mappings.
+func WithUserNamespaceRanges(uids, gids []IDRange) Option {
+	return func(r *Adaptation) error {
+		for _, rng := range append(append([]IDRange{}, uids...), gids...) {
+			if err := rng.validate(); err != nil {
+				return err
+			}
+		}
+		r.uidRanges = append(r.uidRanges, uids...)
+		r.gidRanges = append(r.gidRanges, gids...)
+		return nil
+	}
+}
+
 // New creates a new NRI Runtime.
 func New(name, version string, syncFn SyncFn, updateFn UpdateFn, opts ...Option) (*Adaptation, error) {
 	var err error
@@ -118,11 +227,14 @@ func New(name, version string, syncFn SyncFn, updateFn UpdateFn, opts ...Option)
 	r := &Adaptation{
 		name:       name,
 		version:    version,
+		buildInfo:  api.GetBuildInfo(version),
 		syncFn:     syncFn,
 		updateFn:   updateFn,
 		pluginPath: DefaultPluginPath,
 		dropinPath: DefaultPluginConfigPath,
 		socketPath: DefaultSocketPath,
+		pluginData: newPluginData(),
+		state:      newStateLog(),
 	}
 
 	for _, o := range opts {
@@ -167,7 +279,30 @@ func (r *Adaptation) Stop() {
 
 // RunPodSandbox relays the corresponding CRI event to plugins.
 func (r *Adaptation) RunPodSandbox(ctx context.Context, evt *StateChangeEvent) error {
+	_, err := r.RunPodSandboxWithAdjustment(ctx, evt)
+	return err
+}
+
+// RunPodSandboxWithAdjustment relays the corresponding CRI event to plugins,
+// and returns the combined pod adjustments requested by them.
+func (r *Adaptation) RunPodSandboxWithAdjustment(ctx context.Context, evt *StateChangeEvent) (*PodSandboxAdjustment, error) {
 	evt.Event = Event_RUN_POD_SANDBOX
+	result, err := r.stateChange(ctx, evt)
+	if err != nil {
+		return nil, err
+	}
+	return result.adjustment(), nil
+}
+
+// UpdatePodSandbox relays a pod-level resource update to plugins.
+func (r *Adaptation) UpdatePodSandbox(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_UPDATE_POD_SANDBOX
+	return r.StateChange(ctx, evt)
+}
+
+// PostUpdatePodSandbox relays a completed pod-level resource update to plugins.
+func (r *Adaptation) PostUpdatePodSandbox(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_POST_UPDATE_POD_SANDBOX
 	return r.StateChange(ctx, evt)
 }
 
@@ -189,19 +324,128 @@ func (r *Adaptation) CreateContainer(ctx context.Context, req *CreateContainerRe
 	defer r.Unlock()
 	defer r.removeClosedPlugins()
 
-	result := collectCreateContainerResult(req)
+	// Responses are applied in plugin order. Once a plugin defers its
+	// response, we keep invoking the remaining plugins but queue their
+	// responses until the deferred one has been delivered.
+	type pendingReply struct {
+		plugin *plugin
+		rpl    *CreateContainerResponse
+	}
+
+	var (
+		result  = collectCreateContainerResult(req)
+		pending []*pendingReply
+	)
+
+	req.Generation = r.state.changed(req.GetPod().GetId(), req.GetContainer().GetId())
+
+	defer func() {
+		for _, p := range pending {
+			if p.rpl.GetDeferred() {
+				p.plugin.dropDeferred(req.GetContainer().GetId())
+			}
+		}
+	}()
+
 	for _, plugin := range r.plugins {
+		r.pluginData.inject(req.Pod)
 		rpl, err := plugin.createContainer(ctx, req)
 		if err != nil {
+			return nil, rejectedObject(err, containerObject(req.Pod, req.Container))
+		}
+		if len(pending) > 0 || rpl.GetDeferred() {
+			pending = append(pending, &pendingReply{plugin: plugin, rpl: rpl})
+			continue
+		}
+		if err = r.applyCreateContainerReply(result, req, plugin, rpl); err != nil {
 			return nil, err
 		}
-		err = result.apply(rpl, plugin.name())
-		if err != nil {
+	}
+
+	for _, p := range pending {
+		rpl := p.rpl
+		if rpl.GetDeferred() {
+			var err error
+			rpl, err = p.plugin.deferredCreateContainer(ctx, req.GetContainer().GetId())
+			if err != nil {
+				return nil, rejectedObject(err, containerObject(req.Pod, req.Container))
+			}
+		}
+		if err := r.applyCreateContainerReply(result, req, p.plugin, rpl); err != nil {
 			return nil, err
 		}
 	}
 
-	return result.createContainerResponse(), nil
+	rpl := result.createContainerResponse()
+	if len(rpl.Update) > 0 {
+		r.state.changed("", updatedContainers(rpl.Update)...)
+	}
+
+	return rpl, nil
+}
+
+// rejectedObject sets the object of a plugin rejection, if err is one.
+func rejectedObject(err error, object string) error {
+	var rejected *api.ErrRejected
+	if errors.As(err, &rejected) && rejected.Object == "" {
+		rejected.Object = object
+	}
+	return err
+}
+
+func podObject(pod *PodSandbox) string {
+	return "pod " + pod.GetNamespace() + "/" + pod.GetName()
+}
+
+func containerObject(pod *PodSandbox, ctr *Container) string {
+	return "container " + pod.GetNamespace() + "/" + pod.GetName() + "/" + ctr.GetName()
+}
+
+// applyCreateContainerReply checks and collects a plugin CreateContainer response.
+func (r *Adaptation) applyCreateContainerReply(result *result, req *CreateContainerRequest, plugin *plugin, rpl *CreateContainerResponse) error {
+	err := plugin.checkContainerScopes(rpl.GetAdjust(), rpl.GetUpdate())
+	if err != nil {
+		return err
+	}
+	err = r.checkContainerAdjustment(rpl.GetAdjust(), plugin.name())
+	if err != nil {
+		return err
+	}
+	err = result.apply(rpl, plugin.name())
+	if err != nil {
+		return err
+	}
+	return r.pluginData.apply(req.Pod, plugin.name(), rpl.GetPluginData())
+}
+
+// checkContainerAdjustment checks a container adjustment against the
+// restrictions the runtime places on plugins.
+func (r *Adaptation) checkContainerAdjustment(adjust *ContainerAdjustment, plugin string) error {
+	err := r.checkSysctls(adjust.GetLinux().GetSysctl(), plugin)
+	if err != nil {
+		return err
+	}
+	err = r.checkIDMappings(adjust.GetLinux().GetUidMappings(),
+		adjust.GetLinux().GetGidMappings(), plugin)
+	if err != nil {
+		return err
+	}
+	err = r.checkMountIDMappings(adjust.GetMounts(), plugin)
+	if err != nil {
+		return err
+	}
+	return r.checkCapabilities(adjust.GetLinux().GetCapabilities(), plugin)
+}
+
+// checkPodAdjustment checks a pod adjustment against the restrictions the
+// runtime places on plugins.
+func (r *Adaptation) checkPodAdjustment(adjust *PodSandboxAdjustment, plugin string) error {
+	err := r.checkSysctls(adjust.GetLinux().GetSysctl(), plugin)
+	if err != nil {
+		return err
+	}
+	return r.checkIDMappings(adjust.GetLinux().GetUidMappings(),
+		adjust.GetLinux().GetGidMappings(), plugin)
 }
 
 // PostCreateContainer relays the corresponding CRI event to plugins.
@@ -228,19 +472,35 @@ func (r *Adaptation) UpdateContainer(ctx context.Context, req *UpdateContainerRe
 	defer r.Unlock()
 	defer r.removeClosedPlugins()
 
+	req.Generation = r.state.changed(req.GetPod().GetId(), req.GetContainer().GetId())
+
 	result := collectUpdateContainerResult(req)
 	for _, plugin := range r.plugins {
+		r.pluginData.inject(req.Pod)
 		rpl, err := plugin.updateContainer(ctx, req)
 		if err != nil {
 			return nil, err
 		}
+		err = plugin.checkContainerScopes(nil, rpl.GetUpdate())
+		if err != nil {
+			return nil, err
+		}
 		err = result.apply(rpl, plugin.name())
 		if err != nil {
 			return nil, err
 		}
+		err = r.pluginData.apply(req.Pod, plugin.name(), rpl.GetPluginData())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rpl := result.updateContainerResponse()
+	if len(rpl.Update) > 0 {
+		r.state.changed("", updatedContainers(rpl.Update)...)
 	}
 
-	return result.updateContainerResponse(), nil
+	return rpl, nil
 }
 
 // PostUpdateContainer relays the corresponding CRI event to plugins.
@@ -255,19 +515,35 @@ func (r *Adaptation) StopContainer(ctx context.Context, req *StopContainerReques
 	defer r.Unlock()
 	defer r.removeClosedPlugins()
 
+	req.Generation = r.state.changed(req.GetPod().GetId(), req.GetContainer().GetId())
+
 	result := collectStopContainerResult()
 	for _, plugin := range r.plugins {
+		r.pluginData.inject(req.Pod)
 		rpl, err := plugin.stopContainer(ctx, req)
 		if err != nil {
 			return nil, err
 		}
+		err = plugin.checkContainerScopes(nil, rpl.GetUpdate())
+		if err != nil {
+			return nil, err
+		}
 		err = result.apply(rpl, plugin.name())
 		if err != nil {
 			return nil, err
 		}
+		err = r.pluginData.apply(req.Pod, plugin.name(), rpl.GetPluginData())
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return result.stopContainerResponse(), nil
+	rpl := result.stopContainerResponse()
+	if len(rpl.Update) > 0 {
+		r.state.changed("", updatedContainers(rpl.Update)...)
+	}
+
+	return rpl, nil
 }
 
 // RemoveContainer relays the corresponding CRI event to plugins.
@@ -276,24 +552,272 @@ func (r *Adaptation) RemoveContainer(ctx context.Context, evt *StateChangeEvent)
 	return r.StateChange(ctx, evt)
 }
 
+// CheckpointPodSandbox relays a pod checkpoint event to plugins.
+func (r *Adaptation) CheckpointPodSandbox(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_CHECKPOINT_POD_SANDBOX
+	return r.StateChange(ctx, evt)
+}
+
+// RestorePodSandbox relays a pod restore event to plugins.
+func (r *Adaptation) RestorePodSandbox(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_RESTORE_POD_SANDBOX
+	return r.StateChange(ctx, evt)
+}
+
+// CheckpointContainer relays a container checkpoint event to plugins.
+func (r *Adaptation) CheckpointContainer(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_CHECKPOINT_CONTAINER
+	return r.StateChange(ctx, evt)
+}
+
+// RestoreContainer relays a container restore event to plugins.
+func (r *Adaptation) RestoreContainer(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_RESTORE_CONTAINER
+	return r.StateChange(ctx, evt)
+}
+
+// ImagePulled relays an image pull event to plugins.
+func (r *Adaptation) ImagePulled(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_IMAGE_PULLED
+	return r.StateChange(ctx, evt)
+}
+
+// ImageRemoved relays an image removal event to plugins.
+func (r *Adaptation) ImageRemoved(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_IMAGE_REMOVED
+	return r.StateChange(ctx, evt)
+}
+
+// PreExec relays an exec or attach session about to start to plugins.
+func (r *Adaptation) PreExec(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_PRE_EXEC
+	return r.StateChange(ctx, evt)
+}
+
+// PostExec relays a finished exec or attach session to plugins.
+func (r *Adaptation) PostExec(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_POST_EXEC
+	return r.StateChange(ctx, evt)
+}
+
+// ContainerOOM relays an OOM kill in a container to plugins.
+func (r *Adaptation) ContainerOOM(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_CONTAINER_OOM
+	return r.StateChange(ctx, evt)
+}
+
+// ContainerExit relays the exit of a container, with its status, to plugins.
+func (r *Adaptation) ContainerExit(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_CONTAINER_EXIT
+	return r.StateChange(ctx, evt)
+}
+
+// ResourcePressure relays resource pressure, like PSI thresholds being
+// crossed or memory.events changes, to plugins. The pod and container are
+// left unset for node-wide pressure.
+func (r *Adaptation) ResourcePressure(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_RESOURCE_PRESSURE
+	return r.StateChange(ctx, evt)
+}
+
+// NetworkStatus relays the readiness of CNI networks, as reported by the
+// CNI STATUS verb, to plugins. Runtimes relay it when the readiness of any
+// network changes.
+func (r *Adaptation) NetworkStatus(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_NETWORK_STATUS
+	return r.StateChange(ctx, evt)
+}
+
+// NetworkGC relays CNI garbage collection to plugins, with the network
+// attachments still in use. Runtimes relay it when they invoke the CNI GC
+// verb, so that plugins can release any state they hold for other
+// attachments.
+func (r *Adaptation) NetworkGC(ctx context.Context, evt *StateChangeEvent) error {
+	evt.Event = Event_NETWORK_GC
+	return r.StateChange(ctx, evt)
+}
+
+// SetupNetworkFailed relays a failed attempt to set up the network of a pod
+// with CNI ADD to plugins, and returns the combined changes they request for
+// the next attempt. Runtimes relay it before retrying, and fail the pod if a
+// plugin returns an error.
+func (r *Adaptation) SetupNetworkFailed(ctx context.Context, evt *StateChangeEvent) (*NetworkSetupRetry, error) {
+	evt.Event = Event_SETUP_NETWORK_FAILED
+	result, err := r.stateChange(ctx, evt)
+	if err != nil {
+		return nil, err
+	}
+	return result.networkRetry(), nil
+}
+
 // StateChange relays pod- or container events to plugins.
 func (r *Adaptation) StateChange(ctx context.Context, evt *StateChangeEvent) error {
+	_, err := r.stateChange(ctx, evt)
+	return err
+}
+
+func (r *Adaptation) stateChange(ctx context.Context, evt *StateChangeEvent) (_ *podResult, retErr error) {
 	if evt.Event == Event_UNKNOWN {
-		return errors.New("invalid (unset) event in state change notification")
+		return nil, errors.New("invalid (unset) event in state change notification")
 	}
 
 	r.Lock()
 	defer r.Unlock()
 	defer r.removeClosedPlugins()
 
+	if evt.Event == Event_RUN_POD_SANDBOX {
+		// A pod which fails to start is never removed, so drop its data.
+		defer func() {
+			if retErr != nil {
+				r.pluginData.remove(evt.Pod)
+			}
+		}()
+	}
+
+	if evt.Pod != nil || evt.Container != nil {
+		evt.Generation = r.state.changed(evt.GetPod().GetId(), evt.GetContainer().GetId())
+	}
+
+	result := collectPodSandboxResult(evt.Pod)
 	for _, plugin := range r.plugins {
-		err := plugin.StateChange(ctx, evt)
+		r.pluginData.inject(evt.Pod)
+		rpl, err := plugin.StateChange(ctx, evt)
 		if err != nil {
-			return err
+			if evt.Event == Event_RUN_POD_SANDBOX || evt.Event == Event_SETUP_NETWORK_FAILED {
+				err = rejectedObject(err, podObject(evt.Pod))
+			}
+			return nil, err
+		}
+		err = r.pluginData.apply(evt.Pod, plugin.name(), rpl.GetPluginData())
+		if err != nil {
+			return nil, err
+		}
+		if evt.Event == Event_RUN_POD_SANDBOX && rpl.GetAdjust() != nil {
+			if err = plugin.checkScopes(rpl.Adjust.Scopes()); err != nil {
+				return nil, err
+			}
+			if err = r.checkPodAdjustment(rpl.Adjust, plugin.name()); err != nil {
+				return nil, err
+			}
+			if err = result.adjust(rpl.Adjust, plugin.name()); err != nil {
+				return nil, err
+			}
+		}
+		if evt.Event == Event_SETUP_NETWORK_FAILED && rpl.GetRetry() != nil {
+			if err = plugin.checkScopes(rpl.Retry.Scopes()); err != nil {
+				return nil, err
+			}
+			if err = result.adjustRetry(rpl.Retry, plugin.name()); err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	return nil
+	if evt.Event == Event_REMOVE_POD_SANDBOX {
+		r.pluginData.remove(evt.Pod)
+	}
+
+	return result, nil
+}
+
+// SimulatePod relays dry-run RunPodSandbox and CreateContainer requests for
+// a hypothetical pod and its containers to the plugins which support dry
+// runs, and returns the response of each plugin to each request. Responses
+// are checked but not applied, and plugin failures are returned as results.
+func (r *Adaptation) SimulatePod(ctx context.Context, req *SimulatePodRequest) (*SimulatePodResponse, error) {
+	if req.GetPod() == nil {
+		return nil, errors.New("invalid pod simulation request, no pod given")
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	defer r.removeClosedPlugins()
+
+	rpl := &SimulatePodResponse{}
+	for _, plugin := range r.plugins {
+		if !plugin.dryRun {
+			rpl.Skipped = append(rpl.Skipped, plugin.name())
+			continue
+		}
+
+		if plugin.events.IsSet(Event_RUN_POD_SANDBOX) {
+			sim := &SimulatedRequest{
+				Plugin: plugin.name(),
+				Event:  Event_RUN_POD_SANDBOX,
+			}
+			evt := &StateChangeEvent{
+				Event:  Event_RUN_POD_SANDBOX,
+				Pod:    req.Pod,
+				DryRun: true,
+			}
+			prpl, err := plugin.StateChange(ctx, evt)
+			if err == nil {
+				err = plugin.checkScopes(prpl.GetAdjust().Scopes())
+			}
+			if err == nil {
+				err = r.checkPodAdjustment(prpl.GetAdjust(), plugin.name())
+			}
+			if err != nil {
+				sim.Error = rejectedObject(err, podObject(req.Pod)).Error()
+			} else {
+				sim.PodAdjust = prpl.GetAdjust()
+			}
+			rpl.Requests = append(rpl.Requests, sim)
+		}
+
+		if !plugin.events.IsSet(Event_CREATE_CONTAINER) {
+			continue
+		}
+		for _, ctr := range req.Containers {
+			sim := &SimulatedRequest{
+				Plugin:      plugin.name(),
+				Event:       Event_CREATE_CONTAINER,
+				ContainerId: ctr.GetId(),
+			}
+			crpl, err := plugin.createContainer(ctx, &CreateContainerRequest{
+				Pod:       req.Pod,
+				Container: ctr,
+				DryRun:    true,
+			})
+			if err == nil && crpl.GetDeferred() {
+				crpl, err = plugin.deferredCreateContainer(ctx, ctr.GetId())
+			}
+			if err == nil {
+				err = plugin.checkContainerScopes(crpl.GetAdjust(), crpl.GetUpdate())
+			}
+			if err == nil {
+				err = r.checkContainerAdjustment(crpl.GetAdjust(), plugin.name())
+			}
+			if err != nil {
+				sim.Error = rejectedObject(err, containerObject(req.Pod, ctr)).Error()
+			} else {
+				sim.Adjust = crpl.GetAdjust()
+				sim.Update = crpl.GetUpdate()
+			}
+			rpl.Requests = append(rpl.Requests, sim)
+		}
+	}
+
+	return rpl, nil
+}
+
+// ListPlugins returns the active plugins in invocation order.
+func (r *Adaptation) ListPlugins() []*PluginInfo {
+	r.chainLock.RLock()
+	defer r.chainLock.RUnlock()
+
+	plugins := make([]*PluginInfo, 0, len(r.chain))
+	for _, p := range r.chain {
+		plugins = append(plugins, &PluginInfo{
+			Name:      p.info.Name,
+			Index:     p.info.Index,
+			Events:    p.info.Events,
+			BuildInfo: p.info.BuildInfo,
+			Stats:     p.stats.snapshot(),
+		})
+	}
+
+	return plugins
 }
 
 // Perform a set of unsolicited container updates requested by a plugin.
@@ -301,16 +825,43 @@ func (r *Adaptation) updateContainers(ctx context.Context, req []*ContainerUpdat
 	r.Lock()
 	defer r.Unlock()
 
+	r.state.changed("", updatedContainers(req)...)
+
 	return r.updateFn(ctx, req)
 }
 
+// Get the resource usage of a container or pod sandbox for a plugin. This
+// does not take the lock, as plugins can query stats while handling events.
+func (r *Adaptation) getStats(ctx context.Context, id string) (*Stats, error) {
+	if r.statsFn == nil {
+		return nil, fmt.Errorf("runtime %s does not provide stats", r.name)
+	}
+	return r.statsFn(ctx, id)
+}
+
+// Restart or stop a container for a plugin. This does not take the lock, as
+// the runtime relays the resulting lifecycle events to plugins.
+func (r *Adaptation) restartContainer(ctx context.Context, plugin string, req *RestartContainerRequest) error {
+	if r.restartFn == nil {
+		return fmt.Errorf("plugin %q: restarting containers is not allowed", plugin)
+	}
+	if req.ContainerId == "" {
+		return fmt.Errorf("plugin %q: no container to restart", plugin)
+	}
+	if req.Reason == "" {
+		return fmt.Errorf("plugin %q: no reason given to restart container %s",
+			plugin, req.ContainerId)
+	}
+	return r.restartFn(ctx, plugin, req)
+}
+
 // Start up pre-installed plugins.
 func (r *Adaptation) startPlugins() (retErr error) {
 	var plugins []*plugin
 
 	log.Infof(noCtx, "starting plugins...")
 
-	ids, names, configs, err := r.discoverPlugins()
+	ids, names, configs, cfgTypes, err := r.discoverPlugins()
 	if err != nil {
 		return err
 	}
@@ -328,7 +879,7 @@ func (r *Adaptation) startPlugins() (retErr error) {
 
 		id := ids[i]
 
-		p, err := r.newLaunchedPlugin(r.pluginPath, id, name, configs[i])
+		p, err := r.newLaunchedPlugin(r.pluginPath, id, name, configs[i], cfgTypes[i])
 		if err != nil {
 			return fmt.Errorf("failed to start NRI plugin %q: %w", name, err)
 		}
@@ -351,9 +902,11 @@ func (r *Adaptation) stopPlugins() {
 	log.Infof(noCtx, "stopping plugins...")
 
 	for _, p := range r.plugins {
+		p.close()
 		p.stop()
 	}
 	r.plugins = nil
+	r.updatePluginChain()
 }
 
 func (r *Adaptation) removeClosedPlugins() {
@@ -363,7 +916,30 @@ func (r *Adaptation) removeClosedPlugins() {
 			active = append(active, p)
 		}
 	}
-	r.plugins = active
+	if len(active) != len(r.plugins) {
+		r.plugins = active
+		r.updatePluginChain()
+	}
+}
+
+// Update the plugin chain snapshot used for ListPlugins.
+func (r *Adaptation) updatePluginChain() {
+	chain := make([]*chainEntry, 0, len(r.plugins))
+	for _, p := range r.plugins {
+		chain = append(chain, &chainEntry{
+			info: &PluginInfo{
+				Name:      p.base,
+				Index:     p.idx,
+				Events:    int32(p.events),
+				BuildInfo: p.build,
+			},
+			stats: &p.stats,
+		})
+	}
+
+	r.chainLock.Lock()
+	defer r.chainLock.Unlock()
+	r.chain = chain
 }
 
 func (r *Adaptation) startListener() error {
@@ -408,6 +984,12 @@ func (r *Adaptation) acceptPluginConnections(l net.Listener) error {
 				return
 			}
 
+			if err := r.checkPeer(conn); err != nil {
+				log.Errorf(ctx, "%v", err)
+				conn.Close()
+				continue
+			}
+
 			p, err := r.newExternalPlugin(conn)
 			if err != nil {
 				log.Errorf(ctx, "failed to create external plugin: %v", err)
@@ -415,7 +997,14 @@ func (r *Adaptation) acceptPluginConnections(l net.Listener) error {
 			}
 
 			if err := p.start(r.name, r.version); err != nil {
-				log.Errorf(ctx, "failed to start external plugin: %v", err)
+				switch {
+				case errors.Is(err, errClientRegistered):
+					// Introspection clients are served, but are not plugins.
+				case errors.Is(err, errClosedUnregistered):
+					log.Debugf(ctx, "connection closed without registering")
+				default:
+					log.Errorf(ctx, "failed to start external plugin: %v", err)
+				}
 				continue
 			}
 
@@ -438,21 +1027,22 @@ func (r *Adaptation) acceptPluginConnections(l net.Listener) error {
 	return nil
 }
 
-func (r *Adaptation) discoverPlugins() ([]string, []string, []string, error) {
+func (r *Adaptation) discoverPlugins() ([]string, []string, []string, []string, error) {
 	var (
-		plugins []string
-		indices []string
-		configs []string
-		entries []os.DirEntry
-		info    fs.FileInfo
-		err     error
+		plugins  []string
+		indices  []string
+		configs  []string
+		cfgTypes []string
+		entries  []os.DirEntry
+		info     fs.FileInfo
+		err      error
 	)
 
 	if entries, err = os.ReadDir(r.pluginPath); err != nil {
 		if os.IsNotExist(err) {
-			return nil, nil, nil, nil
+			return nil, nil, nil, nil, nil
 		}
-		return nil, nil, nil, fmt.Errorf("failed to discover plugins in %s: %w",
+		return nil, nil, nil, nil, fmt.Errorf("failed to discover plugins in %s: %w",
 			r.pluginPath, err)
 	}
 
@@ -470,13 +1060,13 @@ func (r *Adaptation) discoverPlugins() ([]string, []string, []string, error) {
 		name := e.Name()
 		idx, base, err := api.ParsePluginName(name)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to discover plugins in %s: %w",
+			return nil, nil, nil, nil, fmt.Errorf("failed to discover plugins in %s: %w",
 				r.pluginPath, err)
 		}
 
-		cfg, err := r.getPluginConfig(idx, base)
+		cfg, cfgType, err := r.getPluginConfig(idx, base)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to discover plugins in %s: %w",
+			return nil, nil, nil, nil, fmt.Errorf("failed to discover plugins in %s: %w",
 				r.pluginPath, err)
 		}
 
@@ -485,9 +1075,10 @@ func (r *Adaptation) discoverPlugins() ([]string, []string, []string, error) {
 		indices = append(indices, idx)
 		plugins = append(plugins, base)
 		configs = append(configs, cfg)
+		cfgTypes = append(cfgTypes, cfgType)
 	}
 
-	return indices, plugins, configs, nil
+	return indices, plugins, configs, cfgTypes, nil
 }
 
 func (r *Adaptation) sortPlugins() {
@@ -495,6 +1086,7 @@ func (r *Adaptation) sortPlugins() {
 	sort.Slice(r.plugins, func(i, j int) bool {
 		return r.plugins[i].idx < r.plugins[j].idx
 	})
+	r.updatePluginChain()
 	if len(r.plugins) > 0 {
 		log.Infof(noCtx, "plugin invocation order")
 		for i, p := range r.plugins {
@@ -18,6 +18,11 @@ package adaptation_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -27,9 +32,13 @@ import (
 	"sigs.k8s.io/yaml"
 
 	nri "github.com/containerd/nri/pkg/adaptation"
+	"github.com/containerd/nri/pkg/adaptation/adaptationtest"
 	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	rspec "github.com/opencontainers/runtime-spec/specs-go"
+	"google.golang.org/protobuf/proto"
 )
 
 var _ = Describe("Configuration", func() {
@@ -85,6 +94,1095 @@ var _ = Describe("Configuration", func() {
 			Expect(plugin.Start(s.dir)).ToNot(Succeed())
 		})
 	})
+
+	When("a plugin registers handler functions", func() {
+		BeforeEach(func() {
+			s.Prepare(&mockRuntime{})
+		})
+
+		It("should invoke the registered handlers", func() {
+			var (
+				ctx     = context.Background()
+				synced  = make(chan struct{})
+				started []string
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err := stub.New(nil,
+				stub.WithPluginName("func"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleStartContainer(func(pod *api.PodSandbox, ctr *api.Container) error {
+					started = append(started, pod.Id+"/"+ctr.Id)
+					return nil
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			Expect(s.runtime.runtime.StartContainer(ctx, &api.StateChangeEvent{
+				Pod:       &api.PodSandbox{Id: "pod0"},
+				Container: &api.Container{Id: "ctr0", PodSandboxId: "pod0"},
+			})).To(Succeed())
+			Expect(started).To(Equal([]string{"pod0/ctr0"}))
+		})
+
+		It("should call interceptors with request information", func() {
+			var (
+				ctx    = context.Background()
+				synced = make(chan struct{})
+				infos  []stub.RequestInfo
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err := stub.New(nil,
+				stub.WithPluginName("func"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.WithInterceptor(func(ctx context.Context, handle func() error) error {
+					info := stub.GetRequestInfo(ctx)
+					Expect(info).ToNot(BeNil())
+					infos = append(infos, *info)
+					return handle()
+				}),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error {
+					return fmt.Errorf("failed")
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			Expect(s.runtime.runtime.StartContainer(ctx, &api.StateChangeEvent{
+				Pod:       &api.PodSandbox{Id: "pod0"},
+				Container: &api.Container{Id: "ctr0", PodSandboxId: "pod0"},
+			})).ToNot(Succeed())
+
+			Expect(infos).To(HaveLen(2))
+			Expect(infos[0].Method).To(Equal("Synchronize"))
+			Expect(infos[0].Seq).To(Equal(uint64(1)))
+			Expect(infos[0].Runtime).To(Equal("mockRuntime"))
+			Expect(infos[0].RuntimeVersion).To(Equal("0.0.1"))
+			Expect(infos[1].Method).To(Equal("StateChange"))
+			Expect(infos[1].Event).To(Equal(api.Event_START_CONTAINER))
+			Expect(infos[1].Seq).To(Equal(uint64(2)))
+			Expect(infos[1].Deadline.IsZero()).To(BeFalse())
+		})
+
+		It("should deduplicate repeated events if asked to", func() {
+			var (
+				ctx     = context.Background()
+				synced  = make(chan struct{})
+				started []string
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err := stub.New(nil,
+				stub.WithPluginName("func"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.WithEventDeduplication(api.Event_START_CONTAINER),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleStartContainer(func(pod *api.PodSandbox, ctr *api.Container) error {
+					started = append(started, ctr.Id)
+					return nil
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			for _, id := range []string{"ctr0", "ctr0", "ctr1", "ctr0"} {
+				Expect(s.runtime.runtime.StartContainer(ctx, &api.StateChangeEvent{
+					Pod:       &api.PodSandbox{Id: "pod0", Uid: "uid0"},
+					Container: &api.Container{Id: id, PodSandboxId: "pod0"},
+				})).To(Succeed())
+			}
+			Expect(started).To(Equal([]string{"ctr0", "ctr1"}))
+
+			_, err = stub.New(nil,
+				stub.WithEventDeduplication(api.Event_CREATE_CONTAINER),
+				stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error { return nil }),
+			)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("should only remember successfully handled events for deduplication", func() {
+			var (
+				ctx     = context.Background()
+				store   = stub.NewDedupStore(0)
+				started []string
+				ooms    int
+				fail    = true
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			start := func() stub.Stub {
+				synced := make(chan struct{})
+				plugin, err := stub.New(nil,
+					stub.WithPluginName("func"),
+					stub.WithPluginIdx("00"),
+					stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+					stub.WithOnClose(func() {}),
+					stub.WithEventDeduplication(),
+					stub.WithDedupStore(store),
+					stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+						close(synced)
+						return nil, nil
+					}),
+					stub.HandleStartContainer(func(pod *api.PodSandbox, ctr *api.Container) error {
+						if fail {
+							fail = false
+							return fmt.Errorf("failed to start %s", ctr.Id)
+						}
+						started = append(started, ctr.Id)
+						return nil
+					}),
+					stub.HandleContainerOOM(func(pod *api.PodSandbox, ctr *api.Container) error {
+						ooms++
+						return nil
+					}),
+				)
+				Expect(err).To(BeNil())
+				Expect(plugin.Start(ctx)).To(Succeed())
+				Eventually(synced).Should(BeClosed())
+				return plugin
+			}
+
+			evt := func() *api.StateChangeEvent {
+				return &api.StateChangeEvent{
+					Pod:       &api.PodSandbox{Id: "pod0", Uid: "uid0"},
+					Container: &api.Container{Id: "ctr0", PodSandboxId: "pod0"},
+				}
+			}
+
+			plugin := start()
+			Expect(s.runtime.runtime.StartContainer(ctx, evt())).ToNot(Succeed())
+			Expect(s.runtime.runtime.StartContainer(ctx, evt())).To(Succeed())
+			Expect(s.runtime.runtime.StartContainer(ctx, evt())).To(Succeed())
+			Expect(started).To(Equal([]string{"ctr0"}))
+
+			Expect(s.runtime.runtime.ContainerOOM(ctx, evt())).To(Succeed())
+			Expect(s.runtime.runtime.ContainerOOM(ctx, evt())).To(Succeed())
+			Expect(ooms).To(Equal(2))
+
+			plugin.Stop()
+			plugin.Wait()
+
+			plugin = start()
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Expect(s.runtime.runtime.StartContainer(ctx, evt())).To(Succeed())
+			Expect(started).To(Equal([]string{"ctr0"}))
+
+			_, err := stub.New(nil,
+				stub.WithEventDeduplication(api.Event_CONTAINER_OOM),
+				stub.HandleContainerOOM(func(*api.PodSandbox, *api.Container) error { return nil }),
+			)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("should accept requests within handler limits", func() {
+			var (
+				ctx     = context.Background()
+				synced  = make(chan struct{})
+				started []string
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err := stub.New(nil,
+				stub.WithPluginName("func"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.WithHandlerLimit(api.Event_START_CONTAINER, 1, 0),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleStartContainer(func(pod *api.PodSandbox, ctr *api.Container) error {
+					started = append(started, ctr.Id)
+					return nil
+				}),
+				stub.HandleRemoveContainer(func(*api.PodSandbox, *api.Container) error {
+					return stub.ErrBusy
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			for _, id := range []string{"ctr0", "ctr1"} {
+				Expect(s.runtime.runtime.StartContainer(ctx, &api.StateChangeEvent{
+					Pod:       &api.PodSandbox{Id: "pod0"},
+					Container: &api.Container{Id: id, PodSandboxId: "pod0"},
+				})).To(Succeed())
+			}
+			Expect(started).To(Equal([]string{"ctr0", "ctr1"}))
+
+			err = s.runtime.runtime.RemoveContainer(ctx, &api.StateChangeEvent{
+				Pod:       &api.PodSandbox{Id: "pod0"},
+				Container: &api.Container{Id: "ctr0", PodSandboxId: "pod0"},
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(api.IsRetryable(err)).To(BeTrue())
+
+			for _, o := range []stub.Option{
+				stub.WithHandlerLimit(api.Event_UNKNOWN, 1, 0),
+				stub.WithHandlerLimit(api.Event_START_CONTAINER, 0, 0),
+				stub.WithHandlerLimit(api.Event_START_CONTAINER, 1, -1),
+			} {
+				_, err = stub.New(nil, o,
+					stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error { return nil }),
+				)
+				Expect(err).ToNot(BeNil())
+			}
+		})
+
+		It("should wait for deferred CreateContainer responses", func() {
+			var (
+				ctx    = context.Background()
+				synced = make(chan struct{})
+				plugin stub.Stub
+				err    error
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err = stub.New(nil,
+				stub.WithPluginName("func"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleCreateContainer(func(pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					if ctr.Id == "ctr1" {
+						return nil, nil, stub.ErrDeferred
+					}
+					go func() {
+						adjust := &api.ContainerAdjustment{}
+						adjust.AddEnv("DEFERRED", ctr.Id)
+						Expect(plugin.DeliverCreateContainerResponse(ctr.Id, adjust, nil, nil)).To(Succeed())
+					}()
+					return nil, nil, stub.ErrDeferred
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			rpl, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       &api.PodSandbox{Id: "pod0"},
+				Container: &api.Container{Id: "ctr0", PodSandboxId: "pod0"},
+			})
+			Expect(err).To(BeNil())
+			Expect(rpl.Adjust.Env).To(ConsistOf(&api.KeyValue{Key: "DEFERRED", Value: "ctr0"}))
+
+			nri.SetPluginDeferredResponseTimeout(50 * time.Millisecond)
+			defer nri.SetPluginDeferredResponseTimeout(nri.DefaultPluginDeferredResponseTimeout)
+
+			_, err = s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       &api.PodSandbox{Id: "pod0"},
+				Container: &api.Container{Id: "ctr1", PodSandboxId: "pod0"},
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(plugin.DeliverCreateContainerResponse("ctr1", nil, nil, nil)).ToNot(Succeed())
+		})
+
+		It("should carry structured errors in deferred CreateContainer responses", func() {
+			var (
+				ctx       = context.Background()
+				synced    = make(chan struct{})
+				delivered = make(chan error, 1)
+				plugin    stub.Stub
+				err       error
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err = stub.New(nil,
+				stub.WithPluginName("func"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleCreateContainer(func(pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					go func() {
+						reject := &api.ErrRejected{
+							Reason:    "NoCapacity",
+							Message:   "out of capacity",
+							Retryable: true,
+						}
+						delivered <- plugin.DeliverCreateContainerResponse(ctr.Id, nil, nil, reject)
+					}()
+					return nil, nil, stub.ErrDeferred
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			_, err = s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       &api.PodSandbox{Id: "pod0", Namespace: "ns", Name: "pod"},
+				Container: &api.Container{Id: "ctr0", PodSandboxId: "pod0", Name: "ctr"},
+			})
+			Expect(err).ToNot(BeNil())
+
+			rejected := &api.ErrRejected{}
+			Expect(errors.As(err, &rejected)).To(BeTrue())
+			Expect(rejected.Plugin).To(Equal("00-func"))
+			Expect(rejected.Reason).To(Equal("NoCapacity"))
+			Expect(rejected.Message).To(Equal("out of capacity"))
+			Expect(rejected.Object).To(Equal("container ns/pod/ctr"))
+			Expect(api.IsRetryable(err)).To(BeTrue())
+			Eventually(delivered).Should(Receive(BeNil()))
+		})
+
+		It("should exchange version and build information", func() {
+			var (
+				ctx    = context.Background()
+				synced = make(chan struct{})
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err := stub.New(nil,
+				stub.WithPluginName("func"),
+				stub.WithPluginIdx("00"),
+				stub.WithPluginVersion("v1.2.3"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error { return nil }),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			Expect(plugin.RuntimeBuildInfo().GetVersion()).To(Equal("0.0.1"))
+			Expect(plugin.RuntimeBuildInfo().GetGoVersion()).ToNot(BeEmpty())
+
+			chain := s.runtime.runtime.ListPlugins()
+			Expect(chain).To(HaveLen(1))
+			Expect(chain[0].BuildInfo.GetVersion()).To(Equal("v1.2.3"))
+			Expect(chain[0].BuildInfo.Summary()).To(HavePrefix("v1.2.3 ("))
+		})
+
+		It("should pass typed plugin errors to the runtime", func() {
+			var (
+				ctx    = context.Background()
+				synced = make(chan struct{})
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err := stub.New(nil,
+				stub.WithPluginName("func"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleCreateContainer(func(*api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					return nil, nil, fmt.Errorf("policy check failed: %w",
+						&api.ErrRejected{Reason: "PrivilegedContainer", Message: "privileged containers not allowed"})
+				}),
+				stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error {
+					return &api.ErrRetryable{Message: "controller unavailable"}
+				}),
+				stub.HandleStopContainer(func(*api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error) {
+					return nil, fmt.Errorf("plain error")
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			var (
+				pod         = &api.PodSandbox{Id: "pod0"}
+				ctr         = &api.Container{Id: "ctr0", PodSandboxId: "pod0"}
+				rejected    *api.ErrRejected
+				retryable   *api.ErrRetryable
+				unsupported *api.ErrUnsupported
+			)
+
+			_, err = s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{Pod: pod, Container: ctr})
+			Expect(errors.As(err, &rejected)).To(BeTrue())
+			Expect(rejected.Plugin).To(Equal("00-func"))
+			Expect(rejected.Reason).To(Equal("PrivilegedContainer"))
+			Expect(rejected.Message).To(Equal("privileged containers not allowed"))
+
+			err = s.runtime.runtime.StartContainer(ctx, &api.StateChangeEvent{Pod: pod, Container: ctr})
+			Expect(errors.As(err, &retryable)).To(BeTrue())
+			Expect(retryable.Message).To(Equal("controller unavailable"))
+
+			_, err = s.runtime.runtime.StopContainer(ctx, &api.StopContainerRequest{Pod: pod, Container: ctr})
+			Expect(err).ToNot(BeNil())
+			Expect(errors.As(err, &rejected)).To(BeFalse())
+			Expect(errors.As(err, &retryable)).To(BeFalse())
+			Expect(errors.As(err, &unsupported)).To(BeFalse())
+		})
+
+		It("should let plugins veto pods and containers", func() {
+			var (
+				ctx    = context.Background()
+				synced = make(chan struct{})
+			)
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+			plugin, err := stub.New(nil,
+				stub.WithPluginName("admission"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+				stub.WithOnClose(func() {}),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					close(synced)
+					return nil, nil
+				}),
+				stub.HandleRunPodSandbox(func(*api.PodSandbox) error {
+					return &api.ErrRejected{
+						Reason:    "QuotaExceeded",
+						Message:   "too many pods",
+						Retryable: true,
+					}
+				}),
+				stub.HandleCreateContainer(func(*api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					return nil, nil, &api.ErrRejected{
+						Reason:  "PrivilegedContainer",
+						Message: "privileged containers not allowed",
+					}
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer func() {
+				plugin.Stop()
+				plugin.Wait()
+			}()
+			Eventually(synced).Should(BeClosed())
+
+			var (
+				pod      = &api.PodSandbox{Id: "pod0", Name: "nginx", Namespace: "default"}
+				ctr      = &api.Container{Id: "ctr0", PodSandboxId: "pod0", Name: "app"}
+				rejected *api.ErrRejected
+			)
+
+			err = s.runtime.runtime.RunPodSandbox(ctx, &api.StateChangeEvent{Pod: pod})
+			Expect(errors.As(err, &rejected)).To(BeTrue())
+			Expect(rejected.Object).To(Equal("pod default/nginx"))
+			Expect(rejected.Retryable).To(BeTrue())
+			Expect(api.IsRetryable(err)).To(BeTrue())
+			Expect(err.Error()).To(Equal("plugin 00-admission rejected pod default/nginx " +
+				"(QuotaExceeded, retryable): too many pods"))
+
+			_, err = s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{Pod: pod, Container: ctr})
+			Expect(errors.As(err, &rejected)).To(BeTrue())
+			Expect(api.IsRetryable(err)).To(BeFalse())
+			Expect(err.Error()).To(Equal("plugin 00-admission rejected container default/nginx/app " +
+				"(PrivilegedContainer): privileged containers not allowed"))
+		})
+
+		It("should fail without any handlers", func() {
+			_, err := stub.New(nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	When("a plugin subscribes to events it does not handle", func() {
+		BeforeEach(func() {
+			s.Prepare(&mockRuntime{})
+		})
+
+		It("should tell which handlers are missing", func() {
+			plugin := &partialPlugin{}
+			defer plugin.Stop()
+
+			Expect(s.runtime.Start(s.dir)).To(Succeed())
+			err := plugin.Start(s.dir)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("STOP_CONTAINER (needs StopContainerInterface)"))
+			Expect(err.Error()).ToNot(ContainSubstring("START_CONTAINER"))
+		})
+	})
+})
+
+var _ = Describe("Fake runtime", func() {
+	It("should drive plugins through pod and container lifecycles", func() {
+		var (
+			ctx    = context.Background()
+			synced = make(chan []*api.PodSandbox, 1)
+			closed = make(chan struct{})
+			events []string
+		)
+
+		runtime, err := adaptationtest.New(GinkgoT().TempDir())
+		Expect(err).To(BeNil())
+		runtime.AddPod(&api.PodSandbox{Id: "pod0", Name: "pod0", Namespace: "default"})
+		Expect(runtime.Start()).To(Succeed())
+		defer runtime.Stop()
+
+		plugin, err := stub.New(nil,
+			stub.WithPluginName("test"),
+			stub.WithPluginIdx("00"),
+			stub.WithSocketPath(runtime.SocketPath()),
+			stub.WithOnClose(func() { close(closed) }),
+			stub.HandleSynchronize(func(pods []*api.PodSandbox, _ []*api.Container) ([]*api.ContainerUpdate, error) {
+				synced <- pods
+				return nil, nil
+			}),
+			stub.HandleCreateContainer(func(_ *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				events = append(events, "create "+ctr.Id)
+				adjust := &api.ContainerAdjustment{}
+				adjust.AddEnv("PLUGIN", "test")
+				return adjust, nil, nil
+			}),
+			stub.HandleStartContainer(func(_ *api.PodSandbox, ctr *api.Container) error {
+				events = append(events, "start "+ctr.Id)
+				return nil
+			}),
+			stub.HandleRemovePodSandbox(func(pod *api.PodSandbox) error {
+				events = append(events, "remove "+pod.Id)
+				return nil
+			}),
+		)
+		Expect(err).To(BeNil())
+		Expect(plugin.Start(ctx)).To(Succeed())
+		defer plugin.Stop()
+
+		var pods []*api.PodSandbox
+		Eventually(synced).Should(Receive(&pods))
+		Expect(pods).To(HaveLen(1))
+		Expect(runtime.WaitForPlugins(1, startupTimeout)).To(Succeed())
+
+		var (
+			pod = &api.PodSandbox{Id: "pod1", Name: "pod1", Namespace: "default"}
+			ctr = &api.Container{Id: "ctr1", PodSandboxId: "pod1", Name: "ctr1"}
+		)
+
+		adjust, err := runtime.RunPodAndContainer(ctx, pod, ctr)
+		Expect(err).To(BeNil())
+		Expect(adjust.GetEnv()).To(HaveLen(1))
+		Expect(runtime.StopPodAndContainer(ctx, pod, ctr)).To(Succeed())
+		Expect(events).To(Equal([]string{"create ctr1", "start ctr1", "remove pod1"}))
+
+		runtime.Stop()
+		Eventually(closed).Should(BeClosed())
+	})
+
+	It("should collect per-hook plugin request statistics", func() {
+		var (
+			ctx    = context.Background()
+			synced = make(chan struct{})
+		)
+
+		runtime, err := adaptationtest.New(GinkgoT().TempDir())
+		Expect(err).To(BeNil())
+		Expect(runtime.Start()).To(Succeed())
+		defer runtime.Stop()
+
+		plugin, err := stub.New(nil,
+			stub.WithPluginName("test"),
+			stub.WithPluginIdx("00"),
+			stub.WithSocketPath(runtime.SocketPath()),
+			stub.WithOnClose(func() {}),
+			stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+				close(synced)
+				return nil, nil
+			}),
+			stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error {
+				return errors.New("start failed")
+			}),
+		)
+		Expect(err).To(BeNil())
+		Expect(plugin.Start(ctx)).To(Succeed())
+		defer plugin.Stop()
+		Eventually(synced).Should(BeClosed())
+		Expect(runtime.WaitForPlugins(1, startupTimeout)).To(Succeed())
+
+		var (
+			pod = &api.PodSandbox{Id: "pod1", Name: "pod1", Namespace: "default"}
+			ctr = &api.Container{Id: "ctr1", PodSandboxId: "pod1", Name: "ctr1"}
+		)
+		_, err = runtime.RunPodAndContainer(ctx, pod, ctr)
+		Expect(err).ToNot(BeNil())
+
+		plugins := runtime.ListPlugins()
+		Expect(plugins).To(HaveLen(1))
+		stats := plugins[0].GetStats()
+		Expect(stats.GetHooks()).To(HaveLen(2))
+		Expect(stats.GetHooks()[0].GetHook()).To(Equal("StartContainer"))
+		Expect(stats.GetHooks()[0].GetCalls()).To(Equal(uint64(1)))
+		Expect(stats.GetHooks()[0].GetErrors()).To(Equal(uint64(1)))
+		Expect(stats.GetHooks()[1].GetHook()).To(Equal("Synchronize"))
+		Expect(stats.GetHooks()[1].GetErrors()).To(Equal(uint64(0)))
+		Expect(stats.GetLastError()).To(ContainSubstring("start failed"))
+		Expect(stats.LastErrorAt().IsZero()).To(BeFalse())
+	})
+
+	It("should simulate pods with plugins which support dry runs", func() {
+		var (
+			ctx     = context.Background()
+			synced  = make(chan struct{}, 2)
+			created []string
+		)
+
+		runtime, err := adaptationtest.New(GinkgoT().TempDir())
+		Expect(err).To(BeNil())
+		Expect(runtime.Start()).To(Succeed())
+		defer runtime.Stop()
+
+		for _, p := range []struct {
+			idx    string
+			name   string
+			dryRun bool
+		}{
+			{"10", "dry", true},
+			{"20", "wet", false},
+		} {
+			name := p.name
+			opts := []stub.Option{
+				stub.WithPluginName(name),
+				stub.WithPluginIdx(p.idx),
+				stub.WithSocketPath(runtime.SocketPath()),
+				stub.WithOnClose(func() {}),
+				stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+					synced <- struct{}{}
+					return nil, nil
+				}),
+				stub.HandleCreateContainer(func(_ *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					created = append(created, name+":"+ctr.Name)
+					if ctr.Name == "privileged" {
+						return nil, nil, &api.ErrRejected{Reason: "Privileged"}
+					}
+					adjust := &api.ContainerAdjustment{}
+					adjust.AddEnv("PLUGIN", name)
+					return adjust, nil, nil
+				}),
+			}
+			if p.dryRun {
+				opts = append(opts, stub.WithDryRun())
+			}
+			plugin, err := stub.New(nil, opts...)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			defer plugin.Stop()
+			Eventually(synced).Should(Receive())
+		}
+		Expect(runtime.WaitForPlugins(2, startupTimeout)).To(Succeed())
+
+		rpl, err := runtime.SimulatePod(ctx, &api.SimulatePodRequest{
+			Pod: &api.PodSandbox{Id: "pod1", Name: "pod1", Namespace: "default"},
+			Containers: []*api.Container{
+				{Id: "ctr1", PodSandboxId: "pod1", Name: "app"},
+				{Id: "ctr2", PodSandboxId: "pod1", Name: "privileged"},
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(created).To(Equal([]string{"dry:app", "dry:privileged"}))
+		Expect(rpl.GetSkipped()).To(Equal([]string{"20-wet"}))
+		Expect(rpl.GetRequests()).To(HaveLen(2))
+		Expect(rpl.GetRequests()[0].GetPlugin()).To(Equal("10-dry"))
+		Expect(rpl.GetRequests()[0].GetContainerId()).To(Equal("ctr1"))
+		Expect(rpl.GetRequests()[0].GetAdjust().GetEnv()).To(HaveLen(1))
+		Expect(rpl.GetRequests()[1].GetError()).To(ContainSubstring("rejected container default/pod1/privileged"))
+	})
+
+	It("should serve clients without registering them as plugins", func() {
+		var (
+			ctx    = context.Background()
+			synced = make(chan struct{}, 1)
+		)
+
+		runtime, err := adaptationtest.New(GinkgoT().TempDir())
+		Expect(err).To(BeNil())
+		Expect(runtime.Start()).To(Succeed())
+		defer runtime.Stop()
+
+		client, disconnect := connectRuntime(runtime.SocketPath())
+		defer disconnect()
+
+		_, err = client.RegisterClient(ctx, &api.RegisterClientRequest{ClientName: "nri"})
+		Expect(err).To(BeNil())
+		_, err = client.RegisterClient(ctx, &api.RegisterClientRequest{ClientName: "nri"})
+		Expect(err).ToNot(BeNil())
+
+		plugin, err := stub.New(nil,
+			stub.WithPluginName("test"),
+			stub.WithPluginIdx("00"),
+			stub.WithSocketPath(runtime.SocketPath()),
+			stub.WithOnClose(func() {}),
+			stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+				synced <- struct{}{}
+				return nil, nil
+			}),
+			stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error { return nil }),
+		)
+		Expect(err).To(BeNil())
+		Expect(plugin.Start(ctx)).To(Succeed())
+		defer plugin.Stop()
+		Eventually(synced).Should(Receive())
+		Expect(runtime.WaitForPlugins(1, startupTimeout)).To(Succeed())
+
+		rpl, err := client.ListPlugins(ctx, &api.ListPluginsRequest{})
+		Expect(err).To(BeNil())
+		Expect(rpl.GetPlugins()).To(HaveLen(1))
+		Expect(rpl.GetPlugins()[0].GetName()).To(Equal("test"))
+
+		sim, err := client.SimulatePod(ctx, &api.SimulatePodRequest{
+			Pod: &api.PodSandbox{Id: "pod0", Name: "pod0", Namespace: "default"},
+		})
+		Expect(err).To(BeNil())
+		Expect(sim.GetSkipped()).To(Equal([]string{"00-test"}))
+	})
+
+	It("should not simulate pods for registered plugins", func() {
+		ctx := context.Background()
+
+		runtime, err := adaptationtest.New(GinkgoT().TempDir())
+		Expect(err).To(BeNil())
+		Expect(runtime.Start()).To(Succeed())
+		defer runtime.Stop()
+
+		plugin, disconnect := connectRuntime(runtime.SocketPath())
+		defer disconnect()
+
+		_, err = plugin.RegisterPlugin(ctx, &api.RegisterPluginRequest{
+			PluginName: "test",
+			PluginIdx:  "00",
+		})
+		Expect(err).To(BeNil())
+
+		_, err = plugin.SimulatePod(ctx, &api.SimulatePodRequest{
+			Pod: &api.PodSandbox{Id: "pod0", Name: "pod0", Namespace: "default"},
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("only available to clients"))
+	})
+
+	It("should omit optional fields plugins do not need", func() {
+		var (
+			ctx     = context.Background()
+			synced  = make(chan struct{})
+			started = make(chan struct{}, 1)
+			syncPod *api.PodSandbox
+			syncCtr *api.Container
+			created *api.Container
+			runPod  *api.PodSandbox
+		)
+
+		runtime, err := adaptationtest.New(GinkgoT().TempDir())
+		Expect(err).To(BeNil())
+
+		pod := &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Namespace: "default",
+			Linux:     &api.LinuxPodSandbox{CgroupParent: "/kubepods/pod0"},
+			Network: &api.PodSandboxNetworkStatus{
+				Ips:       []string{"10.0.0.1"},
+				CniResult: `{"cniVersion": "1.0.0"}`,
+			},
+		}
+		ctr := &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			Args:         []string{"/bin/sh"},
+			Env:          []string{"FOO=bar"},
+			Mounts:       []*api.Mount{{Destination: "/data", Source: "/data", Type: "bind"}},
+			Linux:        &api.LinuxContainer{CgroupsPath: "/kubepods/pod0/ctr0"},
+		}
+		runtime.AddPod(pod)
+		runtime.AddContainer(ctr)
+
+		Expect(runtime.Start()).To(Succeed())
+		defer runtime.Stop()
+
+		plugin, err := stub.New(nil,
+			stub.WithPluginName("fields"),
+			stub.WithPluginIdx("00"),
+			stub.WithSocketPath(runtime.SocketPath()),
+			stub.WithOnClose(func() {}),
+			stub.WithFields(api.Field_CONTAINER_ENV, api.Field_POD_LINUX),
+			stub.HandleSynchronize(func(pods []*api.PodSandbox, ctrs []*api.Container) ([]*api.ContainerUpdate, error) {
+				syncPod, syncCtr = pods[0], ctrs[0]
+				close(synced)
+				return nil, nil
+			}),
+			stub.HandleRunPodSandbox(func(pod *api.PodSandbox) error {
+				runPod = pod
+				return nil
+			}),
+			stub.HandleCreateContainer(func(_ *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				created = ctr
+				return nil, nil, nil
+			}),
+			stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error {
+				started <- struct{}{}
+				return nil
+			}),
+		)
+		Expect(err).To(BeNil())
+		Expect(plugin.Start(ctx)).To(Succeed())
+		defer plugin.Stop()
+		Eventually(synced).Should(BeClosed())
+		Expect(runtime.WaitForPlugins(1, startupTimeout)).To(Succeed())
+
+		Expect(syncCtr.GetEnv()).To(Equal([]string{"FOO=bar"}))
+		Expect(syncCtr.GetArgs()).To(BeEmpty())
+		Expect(syncCtr.GetMounts()).To(BeEmpty())
+		Expect(syncCtr.GetLinux()).To(BeNil())
+		Expect(syncPod.GetLinux().GetCgroupParent()).To(Equal("/kubepods/pod0"))
+		Expect(syncPod.GetNetwork().GetCniResult()).To(BeEmpty())
+
+		runtime.DeleteContainer("ctr0")
+		runtime.DeletePod("pod0")
+		_, err = runtime.RunPodAndContainer(ctx, pod, ctr)
+		Expect(err).To(BeNil())
+		Eventually(started).Should(Receive())
+
+		Expect(runPod.GetNetwork().GetIps()).To(Equal([]string{"10.0.0.1"}))
+		Expect(runPod.GetNetwork().GetCniResult()).To(BeEmpty())
+		Expect(created.GetName()).To(Equal("ctr0"))
+		Expect(created.GetEnv()).To(Equal([]string{"FOO=bar"}))
+		Expect(created.GetArgs()).To(BeEmpty())
+		Expect(created.GetMounts()).To(BeEmpty())
+
+		// The runtime's own pod and container must be left intact.
+		Expect(pod.GetNetwork().GetCniResult()).ToNot(BeEmpty())
+		Expect(ctr.GetArgs()).To(Equal([]string{"/bin/sh"}))
+		Expect(ctr.GetMounts()).To(HaveLen(1))
+		Expect(ctr.GetLinux()).ToNot(BeNil())
+	})
+
+	It("should only synchronize changes with reconnecting plugins", func() {
+		type syncResult struct {
+			pods              []string
+			containers        []string
+			removedPods       []string
+			removedContainers []string
+			delta             bool
+		}
+
+		var (
+			ctx     = context.Background()
+			results = make(chan *syncResult, 1)
+		)
+
+		runtime, err := adaptationtest.New(GinkgoT().TempDir())
+		Expect(err).To(BeNil())
+
+		ids := func(pods []*api.PodSandbox, ctrs []*api.Container) ([]string, []string) {
+			var podIDs, ctrIDs []string
+			for _, pod := range pods {
+				podIDs = append(podIDs, pod.Id)
+			}
+			for _, ctr := range ctrs {
+				ctrIDs = append(ctrIDs, ctr.Id)
+			}
+			return podIDs, ctrIDs
+		}
+
+		startPlugin := func(lastGen uint64) stub.Stub {
+			plugin, err := stub.New(nil,
+				stub.WithPluginName("delta"),
+				stub.WithPluginIdx("00"),
+				stub.WithSocketPath(runtime.SocketPath()),
+				stub.WithOnClose(func() {}),
+				stub.WithLastGeneration(lastGen),
+				stub.HandleSynchronize(func(pods []*api.PodSandbox, ctrs []*api.Container) ([]*api.ContainerUpdate, error) {
+					r := &syncResult{}
+					r.pods, r.containers = ids(pods, ctrs)
+					results <- r
+					return nil, nil
+				}),
+				stub.HandleSynchronizeDelta(func(pods []*api.PodSandbox, ctrs []*api.Container, removedPods, removedCtrs []string) ([]*api.ContainerUpdate, error) {
+					r := &syncResult{
+						removedPods:       removedPods,
+						removedContainers: removedCtrs,
+						delta:             true,
+					}
+					r.pods, r.containers = ids(pods, ctrs)
+					results <- r
+					return nil, nil
+				}),
+				stub.HandleStartContainer(func(*api.PodSandbox, *api.Container) error {
+					return nil
+				}),
+			)
+			Expect(err).To(BeNil())
+			Expect(plugin.Start(ctx)).To(Succeed())
+			return plugin
+		}
+
+		var (
+			pods = []*api.PodSandbox{
+				{Id: "pod0", Name: "pod0", Namespace: "default"},
+				{Id: "pod1", Name: "pod1", Namespace: "default"},
+				{Id: "pod2", Name: "pod2", Namespace: "default"},
+			}
+			ctrs = []*api.Container{
+				{Id: "ctr0", PodSandboxId: "pod0", Name: "ctr0"},
+				{Id: "ctr1", PodSandboxId: "pod1", Name: "ctr1"},
+				{Id: "ctr2", PodSandboxId: "pod2", Name: "ctr2"},
+			}
+		)
+
+		for i := 0; i < 2; i++ {
+			runtime.AddPod(pods[i])
+			runtime.AddContainer(ctrs[i])
+		}
+
+		Expect(runtime.Start()).To(Succeed())
+		defer runtime.Stop()
+
+		plugin := startPlugin(0)
+		Expect(runtime.WaitForPlugins(1, startupTimeout)).To(Succeed())
+		Expect(<-results).To(Equal(&syncResult{
+			pods:       []string{"pod0", "pod1"},
+			containers: []string{"ctr0", "ctr1"},
+		}))
+
+		Expect(runtime.StartContainer(ctx, &api.StateChangeEvent{Pod: pods[0], Container: ctrs[0]})).To(Succeed())
+		lastGen := plugin.Generation()
+		Expect(lastGen).ToNot(BeZero())
+		plugin.Stop()
+
+		_, err = runtime.RunPodAndContainer(ctx, pods[2], ctrs[2])
+		Expect(err).To(BeNil())
+		Expect(runtime.StopPodAndContainer(ctx, pods[1], ctrs[1])).To(Succeed())
+
+		plugin = startPlugin(lastGen)
+		Expect(<-results).To(Equal(&syncResult{
+			pods:              []string{"pod2"},
+			containers:        []string{"ctr2"},
+			removedPods:       []string{"pod1"},
+			removedContainers: []string{"ctr1"},
+			delta:             true,
+		}))
+		Expect(plugin.Generation()).To(BeNumerically(">", lastGen))
+		plugin.Stop()
+
+		// Generations unknown to the runtime fall back to full synchronization.
+		plugin = startPlugin(1)
+		Expect(<-results).To(Equal(&syncResult{
+			pods:       []string{"pod0", "pod2"},
+			containers: []string{"ctr0", "ctr2"},
+		}))
+		plugin.Stop()
+	})
+
+	It("should replay traces and check results against golden files", func() {
+		var (
+			ctx    = context.Background()
+			synced = make(chan struct{})
+		)
+
+		trace, err := adaptationtest.LoadTrace(filepath.Join("testdata", "trace.yaml"))
+		Expect(err).To(BeNil())
+
+		runtime, err := adaptationtest.New(GinkgoT().TempDir())
+		Expect(err).To(BeNil())
+		runtime.AddTraceState(trace)
+		Expect(runtime.Start()).To(Succeed())
+		defer runtime.Stop()
+
+		plugin, err := stub.New(nil,
+			stub.WithPluginName("test"),
+			stub.WithPluginIdx("00"),
+			stub.WithSocketPath(runtime.SocketPath()),
+			stub.WithOnClose(func() {}),
+			stub.HandleSynchronize(func(pods []*api.PodSandbox, ctrs []*api.Container) ([]*api.ContainerUpdate, error) {
+				close(synced)
+				return nil, nil
+			}),
+			stub.HandleCreateContainer(func(pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				adjust := &api.ContainerAdjustment{}
+				adjust.AddEnv("POD_UID", pod.Uid)
+				adjust.AddAnnotation("test.nri.io/container", ctr.Name)
+				return adjust, nil, nil
+			}),
+			stub.HandleUpdateContainer(func(*api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error) {
+				return nil, &api.ErrUnsupported{Message: "updates not supported"}
+			}),
+			stub.HandleRemovePodSandbox(func(*api.PodSandbox) error { return nil }),
+		)
+		Expect(err).To(BeNil())
+		Expect(plugin.Start(ctx)).To(Succeed())
+		defer plugin.Stop()
+		Eventually(synced).Should(BeClosed())
+
+		results, err := runtime.Replay(ctx, trace)
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(len(trace.Steps)))
+		Expect(adaptationtest.CheckGolden(results, filepath.Join("testdata", "trace.golden.json"))).To(Succeed())
+	})
 })
 
 var _ = Describe("Adaptation", func() {
@@ -231,7 +1329,7 @@ var _ = Describe("Plugin connection", func() {
 	})
 })
 
-var _ = Describe("Pod and container requests and events", func() {
+var _ = Describe("Plugin peer policy", func() {
 	var (
 		s = &Suite{}
 	)
@@ -240,13 +1338,91 @@ var _ = Describe("Pod and container requests and events", func() {
 		s.Cleanup()
 	})
 
-	When("there are no plugins", func() {
-		BeforeEach(func() {
-			s.Prepare(&mockRuntime{})
-		})
+	selfDigest := func() string {
+		exe, err := os.Executable()
+		Expect(err).To(BeNil())
+		buf, err := os.ReadFile(exe)
+		Expect(err).To(BeNil())
+		sum := sha256.Sum256(buf)
+		return hex.EncodeToString(sum[:])
+	}
 
-		It("should always succeed", func() {
-			var (
+	DescribeTable("should be enforced for connecting plugins",
+		func(policy func() *nri.PeerPolicy, shouldFail bool) {
+			s.Prepare(
+				&mockRuntime{
+					options: []nri.Option{
+						nri.WithPeerPolicy(policy()),
+					},
+				},
+				&mockPlugin{
+					name: "test",
+					idx:  "00",
+				},
+			)
+			s.StartRuntime()
+
+			err := s.plugins[0].Start(s.Dir())
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			s.WaitForPluginsToSync()
+		},
+		Entry("accepting allowed user and group IDs",
+			func() *nri.PeerPolicy {
+				return &nri.PeerPolicy{
+					UIDs: []uint32{uint32(os.Getuid())},
+					GIDs: []uint32{uint32(os.Getgid())},
+				}
+			}, false),
+		Entry("rejecting other user IDs",
+			func() *nri.PeerPolicy {
+				return &nri.PeerPolicy{
+					UIDs: []uint32{uint32(os.Getuid()) + 1},
+				}
+			}, true),
+		Entry("accepting pinned binaries",
+			func() *nri.PeerPolicy {
+				return &nri.PeerPolicy{
+					Digests: []string{strings.ToUpper(selfDigest())},
+				}
+			}, false),
+		Entry("rejecting other binaries",
+			func() *nri.PeerPolicy {
+				return &nri.PeerPolicy{
+					Digests: []string{strings.Repeat("0", 64)},
+				}
+			}, true),
+	)
+
+	It("should reject invalid digests", func() {
+		_, err := nri.New("mock", "0.0.1",
+			func(context.Context, nri.SyncCB) error { return nil },
+			func(context.Context, []*nri.ContainerUpdate) ([]*nri.ContainerUpdate, error) { return nil, nil },
+			nri.WithPeerPolicy(&nri.PeerPolicy{Digests: []string{"sha256:1234"}}),
+		)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Pod and container requests and events", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	When("there are no plugins", func() {
+		BeforeEach(func() {
+			s.Prepare(&mockRuntime{})
+		})
+
+		It("should always succeed", func() {
+			var (
 				ctx  = context.Background()
 				pod0 = &api.PodSandbox{
 					Id:        "pod0",
@@ -484,6 +1660,11 @@ var _ = Describe("Plugin container creation adjustments", func() {
 			}
 			a.AddDevice(dev)
 
+		case "cdi-device":
+			a.AddCDIDevice(&api.CDIDevice{
+				Name: "vendor.com/net=vf0",
+			})
+
 		case "resources/cpu":
 			a.SetLinuxCPUShares(123)
 			a.SetLinuxCPUQuota(456)
@@ -505,6 +1686,7 @@ var _ = Describe("Plugin container creation adjustments", func() {
 
 		case "resources/classes":
 			a.SetLinuxRDTClass(plugin)
+			a.SetLinuxRDTClosID(plugin)
 			a.SetLinuxBlockIOClass(plugin)
 
 		case "resources/hugepagelimits":
@@ -515,8 +1697,22 @@ var _ = Describe("Plugin container creation adjustments", func() {
 			a.AddLinuxUnified("resource.1", "value1")
 			a.AddLinuxUnified("resource.2", "value2")
 
+		case "resources/blockio":
+			a.SetLinuxBlockIOWeight(500)
+			a.AddLinuxBlockIOThrottleReadBps(8, 0, 1048576)
+			a.AddLinuxBlockIOThrottleWriteIOPS(8, 0, 1000)
+
 		case "cgroupspath":
 			a.SetLinuxCgroupsPath("/" + plugin)
+
+		case "security-profiles":
+			a.SetLinuxSeccompProfile(&api.SecurityProfile{
+				ProfileType:  api.SecurityProfile_LOCALHOST,
+				LocalhostRef: "profiles/" + plugin + ".json",
+			})
+			a.SetLinuxAppArmorProfile(&api.SecurityProfile{
+				ProfileType: api.SecurityProfile_RUNTIME_DEFAULT,
+			})
 		}
 
 		return a, nil, nil
@@ -623,6 +1819,15 @@ var _ = Describe("Plugin container creation adjustments", func() {
 					},
 				},
 			),
+			Entry("adjust CDI devices", "cdi-device",
+				&api.ContainerAdjustment{
+					CDIDevices: []*api.CDIDevice{
+						{
+							Name: "vendor.com/net=vf0",
+						},
+					},
+				},
+			),
 			Entry("adjust CPU resources", "resources/cpu",
 				&api.ContainerAdjustment{
 					Linux: &api.LinuxContainerAdjustment{
@@ -663,6 +1868,7 @@ var _ = Describe("Plugin container creation adjustments", func() {
 					Linux: &api.LinuxContainerAdjustment{
 						Resources: &api.LinuxResources{
 							RdtClass:     api.String("00-test"),
+							RdtClosId:    api.String("00-test"),
 							BlockioClass: api.String("00-test"),
 						},
 					},
@@ -698,6 +1904,23 @@ var _ = Describe("Plugin container creation adjustments", func() {
 					},
 				},
 			),
+			Entry("adjust block I/O parameters", "resources/blockio",
+				&api.ContainerAdjustment{
+					Linux: &api.LinuxContainerAdjustment{
+						Resources: &api.LinuxResources{
+							Blockio: &api.LinuxBlockIO{
+								Weight: api.UInt32(uint32(500)),
+								ThrottleReadBpsDevice: []*api.LinuxThrottleDevice{
+									{Major: 8, Minor: 0, Rate: 1048576},
+								},
+								ThrottleWriteIopsDevice: []*api.LinuxThrottleDevice{
+									{Major: 8, Minor: 0, Rate: 1000},
+								},
+							},
+						},
+					},
+				},
+			),
 			Entry("adjust cgroups path", "cgroupspath",
 				&api.ContainerAdjustment{
 					Linux: &api.LinuxContainerAdjustment{
@@ -705,6 +1928,19 @@ var _ = Describe("Plugin container creation adjustments", func() {
 					},
 				},
 			),
+			Entry("adjust security profiles", "security-profiles",
+				&api.ContainerAdjustment{
+					Linux: &api.LinuxContainerAdjustment{
+						SeccompProfile: &api.SecurityProfile{
+							ProfileType:  api.SecurityProfile_LOCALHOST,
+							LocalhostRef: "profiles/00-test.json",
+						},
+						ApparmorProfile: &api.SecurityProfile{
+							ProfileType: api.SecurityProfile_RUNTIME_DEFAULT,
+						},
+					},
+				},
+			),
 		)
 	})
 
@@ -783,6 +2019,8 @@ var _ = Describe("Plugin container creation adjustments", func() {
 					},
 				},
 			),
+			Entry("adjust CDI devices (conflicts)", "cdi-device", false, true, nil),
+			Entry("adjust security profiles (conflicts)", "security-profiles", false, true, nil),
 			Entry("adjust environment (conflicts)", "environment", false, true, nil),
 			Entry("adjust environment", "environment", true, false,
 				&api.ContainerAdjustment{
@@ -824,6 +2062,7 @@ var _ = Describe("Plugin container creation adjustments", func() {
 				},
 			),
 			Entry("adjust resources", "resources/classes", false, true, nil),
+			Entry("adjust block I/O parameters (conflicts)", "resources/blockio", false, true, nil),
 		)
 	})
 
@@ -871,6 +2110,7 @@ var _ = Describe("Plugin container updates during creation", func() {
 
 		case "resources/classes":
 			u.SetLinuxRDTClass(plugin)
+			u.SetLinuxRDTClosID(plugin)
 			u.SetLinuxBlockIOClass(plugin)
 
 		case "resources/hugepagelimits":
@@ -880,6 +2120,10 @@ var _ = Describe("Plugin container updates during creation", func() {
 		case "resources/unified":
 			u.AddLinuxUnified("resource.1", "value1")
 			u.AddLinuxUnified("resource.2", "value2")
+
+		case "resources/blockio":
+			u.SetLinuxBlockIOWeight(500)
+			u.AddLinuxBlockIOWeightDevice(8, 0, 200)
 		}
 
 		return nil, []*api.ContainerUpdate{u}, nil
@@ -1001,6 +2245,7 @@ var _ = Describe("Plugin container updates during creation", func() {
 					Linux: &api.LinuxContainerUpdate{
 						Resources: &api.LinuxResources{
 							RdtClass:     api.String("00-test"),
+							RdtClosId:    api.String("00-test"),
 							BlockioClass: api.String("00-test"),
 						},
 					},
@@ -1036,169 +2281,4014 @@ var _ = Describe("Plugin container updates during creation", func() {
 					},
 				},
 			),
+			Entry("update block I/O parameters", "resources/blockio",
+				&api.ContainerUpdate{
+					Linux: &api.LinuxContainerUpdate{
+						Resources: &api.LinuxResources{
+							Blockio: &api.LinuxBlockIO{
+								Weight: api.UInt32(uint32(500)),
+								WeightDevice: []*api.LinuxWeightDevice{
+									{Major: 8, Minor: 0, Weight: 200},
+								},
+							},
+						},
+					},
+				},
+			),
+		)
+	})
+
+	When("there are multiple plugins", func() {
+		BeforeEach(func() {
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{idx: "10", name: "foo"},
+				&mockPlugin{idx: "00", name: "bar"},
+			)
+		})
+
+		DescribeTable("should fail with conflicts, successfully collected otherwise",
+			func(subject string, which string, expected *api.ContainerUpdate) {
+				var (
+					runtime = s.runtime
+					plugins = s.plugins
+					ctx     = context.Background()
+
+					pod0 = &api.PodSandbox{
+						Id:        "pod0",
+						Name:      "pod0",
+						Uid:       "uid0",
+						Namespace: "default",
+					}
+					ctr0 = &api.Container{
+						Id:           "ctr0",
+						PodSandboxId: "pod0",
+						Name:         "ctr0",
+						State:        api.ContainerState_CONTAINER_CREATED, // XXX FIXME-kludge
+					}
+					pod1 = &api.PodSandbox{
+						Id:        "pod1",
+						Name:      "pod1",
+						Uid:       "uid1",
+						Namespace: "default",
+					}
+					ctr1 = &api.Container{
+						Id:           "ctr1",
+						PodSandboxId: "pod1",
+						Name:         "ctr1",
+						State:        api.ContainerState_CONTAINER_CREATED, // XXX FIXME-kludge
+					}
+
+					reply *api.CreateContainerResponse
+				)
+
+				create := func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					return update(subject, which, p, pod, ctr)
+				}
+
+				plugins[0].createContainer = create
+				plugins[1].createContainer = create
+
+				s.Startup()
+
+				podReq := &api.RunPodSandboxRequest{Pod: pod0}
+				Expect(runtime.runtime.RunPodSandbox(ctx, podReq)).To(Succeed())
+				ctrReq := &api.CreateContainerRequest{
+					Pod:       pod0,
+					Container: ctr0,
+				}
+				_, err := runtime.runtime.CreateContainer(ctx, ctrReq)
+				Expect(err).To(BeNil())
+
+				podReq = &api.RunPodSandboxRequest{Pod: pod1}
+				Expect(runtime.runtime.RunPodSandbox(ctx, podReq)).To(Succeed())
+				ctrReq = &api.CreateContainerRequest{
+					Pod:       pod1,
+					Container: ctr1,
+				}
+				reply, err = runtime.runtime.CreateContainer(ctx, ctrReq)
+				if which == "both" {
+					Expect(err).ToNot(BeNil())
+				} else {
+					Expect(err).To(BeNil())
+					Expect(len(reply.Update)).To(Equal(1))
+					expected.ContainerId = reply.Update[0].ContainerId
+					Expect(stripUpdate(reply.Update[0])).Should(Equal(stripUpdate(expected)))
+				}
+			},
+
+			Entry("update CPU resources", "resources/cpu", "both", nil),
+			Entry("update CPU resources", "resources/cpu", "10-foo",
+				&api.ContainerUpdate{
+					Linux: &api.LinuxContainerUpdate{
+						Resources: &api.LinuxResources{
+							Cpu: &api.LinuxCPU{
+								Shares:          api.UInt64(123),
+								Quota:           api.Int64(456),
+								Period:          api.UInt64(789),
+								RealtimeRuntime: api.Int64(321),
+								RealtimePeriod:  api.UInt64(654),
+								Cpus:            "0-1",
+								Mems:            "2-3",
+							},
+						},
+					},
+				},
+			),
+			Entry("update memory resources", "resources/memory", "both", nil),
+			Entry("update memory resources", "resources/memory", "10-foo",
+				&api.ContainerUpdate{
+					Linux: &api.LinuxContainerUpdate{
+						Resources: &api.LinuxResources{
+							Memory: &api.LinuxMemory{
+								Limit:            api.Int64(1234000),
+								Reservation:      api.Int64(4000),
+								Swap:             api.Int64(34000),
+								Kernel:           api.Int64(30000),
+								KernelTcp:        api.Int64(2000),
+								Swappiness:       api.UInt64(987),
+								DisableOomKiller: api.Bool(true),
+								UseHierarchy:     api.Bool(true),
+							},
+						},
+					},
+				},
+			),
+			Entry("update class-based resources", "resources/classes", "both", nil),
+			Entry("update class-based resources", "resources/classes", "10-foo",
+				&api.ContainerUpdate{
+					Linux: &api.LinuxContainerUpdate{
+						Resources: &api.LinuxResources{
+							RdtClass:     api.String("10-foo"),
+							RdtClosId:    api.String("10-foo"),
+							BlockioClass: api.String("10-foo"),
+						},
+					},
+				},
+			),
+			Entry("update hugepage limits", "resources/hugepagelimits", "both", nil),
+			Entry("update hugepage limits", "resources/hugepagelimits", "10-foo",
+				&api.ContainerUpdate{
+					Linux: &api.LinuxContainerUpdate{
+						Resources: &api.LinuxResources{
+							HugepageLimits: []*api.HugepageLimit{
+								{
+									PageSize: "1M",
+									Limit:    4096,
+								},
+								{
+									PageSize: "4M",
+									Limit:    1024,
+								},
+							},
+						},
+					},
+				},
+			),
+			Entry("update cgroupv2 unified resources", "resources/unified", "both", nil),
+			Entry("update cgroupv2 unified resources", "resources/unified", "10-foo",
+				&api.ContainerUpdate{
+					Linux: &api.LinuxContainerUpdate{
+						Resources: &api.LinuxResources{
+							Unified: map[string]string{
+								"resource.1": "value1",
+								"resource.2": "value2",
+							},
+						},
+					},
+				},
+			),
 		)
 	})
+})
+
+var _ = Describe("Plugin transports", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should interoperate",
+		func(transport0, transport1 api.Transport) {
+			var (
+				ctx = context.Background()
+
+				pod = &api.PodSandbox{
+					Id:        "pod0",
+					Name:      "pod0",
+					Uid:       "uid0",
+					Namespace: "default",
+				}
+				ctr = &api.Container{
+					Id:           "ctr0",
+					PodSandboxId: "pod0",
+					Name:         "ctr0",
+					State:        api.ContainerState_CONTAINER_CREATED,
+				}
+
+				annotate = func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddAnnotation(p.name, string(p.transport))
+					return a, nil, nil
+				}
+			)
+
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{idx: "00", name: "foo", transport: transport0, createContainer: annotate},
+				&mockPlugin{idx: "10", name: "bar", transport: transport1, createContainer: annotate},
+			)
+
+			s.Startup()
+
+			rpl, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			Expect(err).To(BeNil())
+			Expect(rpl.Adjust.Annotations).To(Equal(map[string]string{
+				"foo": string(transport0),
+				"bar": string(transport1),
+			}))
+
+			Expect(s.runtime.startStopPodAndContainer(ctx, pod, ctr)).To(Succeed())
+			for _, plugin := range s.plugins {
+				Expect(plugin.EventQ().Has(PodSandboxEvent(pod, RemovePodSandbox))).To(BeTrue())
+				_, err := plugin.stub.UpdateContainers(nil)
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("with ttRPC and ttRPC", api.TransportTTRPC, api.TransportTTRPC),
+		Entry("with gRPC and gRPC", api.TransportGRPC, api.TransportGRPC),
+		Entry("with ttRPC and gRPC", api.TransportTTRPC, api.TransportGRPC),
+		Entry("with gRPC and ttRPC", api.TransportGRPC, api.TransportTTRPC),
+	)
+})
+
+var _ = Describe("Plugin checkpoint and restore", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should relay checkpoint and restore events", func() {
+		var (
+			ctx = context.Background()
+
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_RUNNING,
+			}
+
+			restored string
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				checkpointPodSandbox: func(p *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+					pod.SetPluginData("tc-class", "1:10")
+					return nil
+				},
+				restorePodSandbox: func(p *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+					restored, _ = pod.LookupPluginData("tc-class")
+					return nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		runtime := s.runtime.runtime
+		plugin := s.plugins[0]
+
+		Expect(runtime.CheckpointContainer(ctx, &api.StateChangeEvent{
+			Pod:       pod,
+			Container: ctr,
+		})).To(Succeed())
+		Expect(runtime.CheckpointPodSandbox(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})).To(Succeed())
+		Expect(runtime.RestorePodSandbox(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})).To(Succeed())
+		Expect(runtime.RestoreContainer(ctx, &api.StateChangeEvent{
+			Pod:       pod,
+			Container: ctr,
+		})).To(Succeed())
+
+		Expect(plugin.EventQ().Has(ContainerEvent(ctr, CheckpointContainer))).To(BeTrue())
+		Expect(plugin.EventQ().Has(PodSandboxEvent(pod, CheckpointPodSandbox))).To(BeTrue())
+		Expect(plugin.EventQ().Has(PodSandboxEvent(pod, RestorePodSandbox))).To(BeTrue())
+		Expect(plugin.EventQ().Has(ContainerEvent(ctr, RestoreContainer))).To(BeTrue())
+		Expect(restored).To(Equal("1:10"))
+	})
+})
+
+var _ = Describe("Plugin image events", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should relay image events to subscribed plugins", func() {
+		var (
+			ctx = context.Background()
+
+			img = &api.Image{
+				Name:   "docker.io/library/busybox:latest",
+				Digest: "sha256:3fbc632167424a6d997e74f52b878d7cc478225cffac6bc977eedfe51c7f4e79",
+				Size:   2595920,
+			}
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{idx: "00", name: "all"},
+			&mockPlugin{idx: "10", name: "pulls", mask: api.MustParseEventMask("ImagePulled")},
+		)
+
+		s.Startup()
+
+		runtime := s.runtime.runtime
+		Expect(runtime.ImagePulled(ctx, &api.StateChangeEvent{Image: img})).To(Succeed())
+		Expect(runtime.ImageRemoved(ctx, &api.StateChangeEvent{Image: img})).To(Succeed())
+
+		all, pulls := s.plugins[0], s.plugins[1]
+		Expect(all.EventQ().Has(ImageEvent(img, ImagePulled))).To(BeTrue())
+		Expect(all.EventQ().Has(ImageEvent(img, ImageRemoved))).To(BeTrue())
+		Expect(pulls.EventQ().Has(ImageEvent(img, ImagePulled))).To(BeTrue())
+		Expect(pulls.EventQ().Has(ImageEvent(img, ImageRemoved))).To(BeFalse())
+	})
+})
+
+var _ = Describe("Plugin exec events", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should relay exec sessions to plugins", func() {
+		var (
+			ctx = context.Background()
+
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_RUNNING,
+			}
+			exec = &api.ExecSession{
+				Args:  []string{"/bin/sh"},
+				User:  "root",
+				Tty:   true,
+				Stdin: true,
+			}
+
+			audited []string
+			audit   = func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container, exec *api.ExecSession) error {
+				audited = append(audited, ctr.Id+":"+exec.User+":"+strings.Join(exec.Args, " "))
+				return nil
+			}
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{idx: "00", name: "audit", preExec: audit},
+		)
+
+		s.Startup()
+
+		runtime := s.runtime.runtime
+		Expect(runtime.PreExec(ctx, &api.StateChangeEvent{
+			Pod:       pod,
+			Container: ctr,
+			Exec:      exec,
+		})).To(Succeed())
+		Expect(runtime.PostExec(ctx, &api.StateChangeEvent{
+			Pod:       pod,
+			Container: ctr,
+			Exec:      exec,
+		})).To(Succeed())
+
+		plugin := s.plugins[0]
+		Expect(plugin.EventQ().Has(ContainerEvent(ctr, PreExec))).To(BeTrue())
+		Expect(plugin.EventQ().Has(ContainerEvent(ctr, PostExec))).To(BeTrue())
+		Expect(audited).To(Equal([]string{"ctr0:root:/bin/sh"}))
+	})
+})
+
+var _ = Describe("Plugin OOM and exit events", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should relay OOM kills and exit status to plugins", func() {
+		var (
+			ctx = context.Background()
+
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_STOPPED,
+			}
+			exit = &api.ContainerExit{
+				ExitCode:  137,
+				Reason:    "OOMKilled",
+				OomKilled: true,
+			}
+
+			exited *api.ContainerExit
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				containerExit: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container, exit *api.ContainerExit) error {
+					exited = exit
+					return nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		runtime := s.runtime.runtime
+		Expect(runtime.ContainerOOM(ctx, &api.StateChangeEvent{
+			Pod:       pod,
+			Container: ctr,
+		})).To(Succeed())
+		Expect(runtime.ContainerExit(ctx, &api.StateChangeEvent{
+			Pod:       pod,
+			Container: ctr,
+			Exit:      exit,
+		})).To(Succeed())
+
+		plugin := s.plugins[0]
+		Expect(plugin.EventQ().Has(ContainerEvent(ctr, ContainerOOM))).To(BeTrue())
+		Expect(plugin.EventQ().Has(ContainerEvent(ctr, ContainerExit))).To(BeTrue())
+		Expect(exited.GetExitCode()).To(Equal(int32(137)))
+		Expect(exited.GetReason()).To(Equal("OOMKilled"))
+		Expect(exited.GetOomKilled()).To(BeTrue())
+	})
+})
+
+var _ = Describe("Plugin pod update events", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should relay pod resource updates to plugins", func() {
+		var (
+			ctx = context.Background()
+
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+				Linux: &api.LinuxPodSandbox{
+					PodResources: &api.LinuxResources{
+						Memory: &api.LinuxMemory{
+							Limit: api.Int64(1 << 30),
+						},
+					},
+				},
+			}
+			resources = &api.LinuxResources{
+				Memory: &api.LinuxMemory{
+					Limit: api.Int64(2 << 30),
+				},
+			}
+
+			oldLimit, newLimit int64
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				updatePodSandbox: func(p *mockPlugin, pod *api.PodSandbox, res *api.LinuxResources) error {
+					oldLimit = pod.Linux.PodResources.Memory.Limit.GetValue()
+					newLimit = res.Memory.Limit.GetValue()
+					return nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		runtime := s.runtime.runtime
+		Expect(runtime.UpdatePodSandbox(ctx, &api.StateChangeEvent{
+			Pod:          pod,
+			PodResources: resources,
+		})).To(Succeed())
+		Expect(runtime.PostUpdatePodSandbox(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})).To(Succeed())
+
+		plugin := s.plugins[0]
+		Expect(plugin.EventQ().Has(PodSandboxEvent(pod, UpdatePodSandbox))).To(BeTrue())
+		Expect(plugin.EventQ().Has(PodSandboxEvent(pod, PostUpdatePodSandbox))).To(BeTrue())
+		Expect(oldLimit).To(Equal(int64(1 << 30)))
+		Expect(newLimit).To(Equal(int64(2 << 30)))
+	})
+})
+
+var _ = Describe("Plugin sysctl adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+
+		runtime = func() *mockRuntime {
+			return &mockRuntime{
+				options: []nri.Option{
+					nri.WithSysctlAllowlist("net.core.somaxconn", "net.ipv4.tcp_*"),
+				},
+			}
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be allowed for containers according to the allowlist",
+		func(key string, shouldFail bool) {
+			ctx := context.Background()
+
+			s.Prepare(
+				runtime(),
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						a := &api.ContainerAdjustment{}
+						a.AddLinuxSysctl(key, "1")
+						return a, nil, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			rpl, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+				Expect(rpl.Adjust.Linux.Sysctl).To(Equal(map[string]string{key: "1"}))
+			}
+		},
+		Entry("with an allowed sysctl", "net.core.somaxconn", false),
+		Entry("with an allowed sysctl prefix", "net.ipv4.tcp_keepalive_time", false),
+		Entry("with a disallowed sysctl", "kernel.shm_rmid_forced", true),
+	)
+
+	It("should be combined for pods", func() {
+		ctx := context.Background()
+
+		adjust := func(key, value string) func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+			return func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+				a := &api.PodSandboxAdjustment{}
+				a.AddLinuxSysctl(key, value)
+				return a, nil
+			}
+		}
+
+		s.Prepare(
+			runtime(),
+			&mockPlugin{idx: "00", name: "foo", adjustPodSandbox: adjust("net.core.somaxconn", "1024")},
+			&mockPlugin{idx: "10", name: "bar", adjustPodSandbox: adjust("net.ipv4.tcp_fin_timeout", "15")},
+		)
+
+		s.Startup()
+
+		adjustment, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).To(BeNil())
+		Expect(adjustment.Linux.Sysctl).To(Equal(map[string]string{
+			"net.core.somaxconn":       "1024",
+			"net.ipv4.tcp_fin_timeout": "15",
+		}))
+	})
+
+	It("should conflict for pods if set by multiple plugins", func() {
+		ctx := context.Background()
+
+		adjust := func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+			a := &api.PodSandboxAdjustment{}
+			a.AddLinuxSysctl("net.core.somaxconn", "1024")
+			return a, nil
+		}
+
+		s.Prepare(
+			runtime(),
+			&mockPlugin{idx: "00", name: "foo", adjustPodSandbox: adjust},
+			&mockPlugin{idx: "10", name: "bar", adjustPodSandbox: adjust},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Plugin rlimit adjustments", func() {
+	var (
+		s = &Suite{}
+
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+
+		newPod = func() *api.PodSandbox {
+			return &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should apply pod defaults to containers, overridden by container adjustments", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				adjustPodSandbox: func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					a := &api.PodSandboxAdjustment{}
+					a.AddRlimit("RLIMIT_NOFILE", 4096, 1024)
+					a.AddRlimit("RLIMIT_NPROC", 512, 512)
+					return a, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bar",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddRlimit("RLIMIT_NOFILE", 65536, 65536)
+					return a, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		pod := newPod()
+		adjustment, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).To(BeNil())
+		Expect(len(adjustment.Rlimits)).To(Equal(2))
+		Expect(len(pod.Rlimits)).To(Equal(2))
+
+		reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).To(BeNil())
+
+		rlimits := map[string][2]uint64{}
+		for _, rl := range reply.Adjust.Rlimits {
+			rlimits[rl.Type] = [2]uint64{rl.Hard, rl.Soft}
+		}
+		Expect(rlimits).To(Equal(map[string][2]uint64{
+			"RLIMIT_NOFILE": {65536, 65536},
+			"RLIMIT_NPROC":  {512, 512},
+		}))
+	})
+
+	DescribeTable("should be validated",
+		func(typ string, hard, soft uint64, shouldFail bool) {
+			ctx := context.Background()
+
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						a := &api.ContainerAdjustment{}
+						a.AddRlimit(typ, hard, soft)
+						return a, nil, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       newPod(),
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("with a valid rlimit", "RLIMIT_MEMLOCK", uint64(1<<20), uint64(1<<20), false),
+		Entry("with an unknown rlimit type", "RLIMIT_FOO", uint64(1), uint64(1), true),
+		Entry("with a soft limit above the hard limit", "RLIMIT_CORE", uint64(1), uint64(2), true),
+	)
+
+	It("should conflict if set by multiple plugins for a container", func() {
+		ctx := context.Background()
+
+		create := func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+			a := &api.ContainerAdjustment{}
+			a.AddRlimit("RLIMIT_NOFILE", 1024, 1024)
+			return a, nil, nil
+		}
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{idx: "00", name: "foo", createContainer: create},
+			&mockPlugin{idx: "10", name: "bar", createContainer: create},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       newPod(),
+			Container: ctr,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Plugin I/O priority and scheduling adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be collected for created and updated containers", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.SetLinuxIOPriority(api.IOPrioClass_IOPRIO_CLASS_RT, 0)
+					a.SetLinuxScheduler(&api.LinuxScheduler{
+						Policy:   api.LinuxSchedulerPolicy_SCHED_FIFO,
+						Priority: 50,
+					})
+					u := &api.ContainerUpdate{}
+					u.SetContainerId("ctr1")
+					u.SetLinuxIOPriority(api.IOPrioClass_IOPRIO_CLASS_IDLE, 7)
+					u.SetLinuxScheduler(&api.LinuxScheduler{
+						Policy: api.LinuxSchedulerPolicy_SCHED_BATCH,
+						Nice:   10,
+					})
+					return a, []*api.ContainerUpdate{u}, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).To(BeNil())
+
+		Expect(reply.Adjust.Linux.IoPriority.Class).To(Equal(api.IOPrioClass_IOPRIO_CLASS_RT))
+		Expect(reply.Adjust.Linux.Scheduler.Policy).To(Equal(api.LinuxSchedulerPolicy_SCHED_FIFO))
+		Expect(reply.Adjust.Linux.Scheduler.Priority).To(Equal(int32(50)))
+
+		Expect(len(reply.Update)).To(Equal(1))
+		Expect(reply.Update[0].ContainerId).To(Equal("ctr1"))
+		Expect(reply.Update[0].Linux.IoPriority.Class).To(Equal(api.IOPrioClass_IOPRIO_CLASS_IDLE))
+		Expect(reply.Update[0].Linux.IoPriority.Priority).To(Equal(int32(7)))
+		Expect(reply.Update[0].Linux.Scheduler.Policy).To(Equal(api.LinuxSchedulerPolicy_SCHED_BATCH))
+		Expect(reply.Update[0].Linux.Scheduler.Nice).To(Equal(int32(10)))
+	})
+
+	DescribeTable("should be validated",
+		func(prio *api.LinuxIOPriority, sched *api.LinuxScheduler, shouldFail bool) {
+			ctx := context.Background()
+
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						a := &api.ContainerAdjustment{}
+						if prio != nil {
+							a.SetLinuxIOPriority(prio.Class, prio.Priority)
+						}
+						if sched != nil {
+							a.SetLinuxScheduler(sched)
+						}
+						return a, nil, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("with a valid best-effort I/O priority",
+			&api.LinuxIOPriority{Class: api.IOPrioClass_IOPRIO_CLASS_BE, Priority: 4}, nil, false),
+		Entry("with an out of range I/O priority",
+			&api.LinuxIOPriority{Class: api.IOPrioClass_IOPRIO_CLASS_BE, Priority: 8}, nil, true),
+		Entry("with a valid nice value",
+			nil, &api.LinuxScheduler{Policy: api.LinuxSchedulerPolicy_SCHED_OTHER, Nice: -5}, false),
+		Entry("with an out of range nice value",
+			nil, &api.LinuxScheduler{Policy: api.LinuxSchedulerPolicy_SCHED_OTHER, Nice: 20}, true),
+		Entry("with a realtime policy without priority",
+			nil, &api.LinuxScheduler{Policy: api.LinuxSchedulerPolicy_SCHED_RR}, true),
+		Entry("with valid deadline parameters",
+			nil, &api.LinuxScheduler{
+				Policy:   api.LinuxSchedulerPolicy_SCHED_DEADLINE,
+				Runtime:  1000000,
+				Deadline: 5000000,
+				Period:   10000000,
+			}, false),
+		Entry("with a deadline shorter than the runtime",
+			nil, &api.LinuxScheduler{
+				Policy:   api.LinuxSchedulerPolicy_SCHED_DEADLINE,
+				Runtime:  5000000,
+				Deadline: 1000000,
+			}, true),
+	)
+})
+
+var _ = Describe("Plugin rootfs and path hardening adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be combined from multiple plugins", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.SetReadonlyRootfs(true)
+					a.AddLinuxMaskedPath("/proc/kcore")
+					a.AddLinuxMaskedPath("/proc/acpi")
+					return a, nil, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bar",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.RemoveLinuxMaskedPath("/proc/acpi")
+					a.AddLinuxReadonlyPath("/proc/sys")
+					return a, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).To(BeNil())
+		Expect(reply.Adjust.ReadonlyRootfs.GetValue()).To(BeTrue())
+		Expect(reply.Adjust.Linux.MaskedPaths).To(Equal([]string{"/proc/kcore", "-/proc/acpi"}))
+		Expect(reply.Adjust.Linux.ReadonlyPaths).To(Equal([]string{"/proc/sys"}))
+	})
+
+	DescribeTable("should be rejected",
+		func(adjust func(*api.ContainerAdjustment), twice bool) {
+			ctx := context.Background()
+
+			fn := func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				a := &api.ContainerAdjustment{}
+				adjust(a)
+				return a, nil, nil
+			}
+
+			plugins := []*mockPlugin{{idx: "00", name: "foo", createContainer: fn}}
+			if twice {
+				plugins = append(plugins, &mockPlugin{idx: "10", name: "bar", createContainer: fn})
+			}
+
+			s.Prepare(&mockRuntime{}, plugins...)
+			s.Startup()
+
+			_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("if read-only rootfs is set by multiple plugins",
+			func(a *api.ContainerAdjustment) { a.SetReadonlyRootfs(true) }, true),
+		Entry("if the same path is masked by multiple plugins",
+			func(a *api.ContainerAdjustment) { a.AddLinuxMaskedPath("/proc/kcore") }, true),
+		Entry("for relative paths",
+			func(a *api.ContainerAdjustment) { a.AddLinuxReadonlyPath("proc/sys") }, false),
+	)
+})
+
+var _ = Describe("Plugin OCI Spec snapshots", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should only be passed to plugins which asked for them", func() {
+		var (
+			ctx = context.Background()
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_CREATED,
+			}
+			seen     *rspec.Spec
+			withSpec bool
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					return nil, nil, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "validator",
+				createContainerSpec: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container, spec *rspec.Spec) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					seen, withSpec = spec, true
+					if spec.Process != nil && len(spec.Process.Args) > 0 && spec.Process.Args[0] == "/bin/evil" {
+						return nil, nil, fmt.Errorf("rejecting container %s", ctr.Name)
+					}
+					return nil, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		req := &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		}
+		Expect(req.SetOCISpec(&rspec.Spec{
+			Version: rspec.Version,
+			Process: &rspec.Process{Args: []string{"/bin/sh"}},
+		})).To(Succeed())
+
+		_, err := s.runtime.runtime.CreateContainer(ctx, req)
+		Expect(err).To(BeNil())
+		Expect(withSpec).To(BeTrue())
+		Expect(seen).ToNot(BeNil())
+		Expect(seen.Process.Args).To(Equal([]string{"/bin/sh"}))
+
+		Expect(req.SetOCISpec(&rspec.Spec{
+			Version: rspec.Version,
+			Process: &rspec.Process{Args: []string{"/bin/evil"}},
+		})).To(Succeed())
+
+		_, err = s.runtime.runtime.CreateContainer(ctx, req)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Plugin capability adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be validated",
+		func(adjust func(*api.ContainerAdjustment), twice, shouldFail bool) {
+			ctx := context.Background()
+
+			fn := func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				a := &api.ContainerAdjustment{}
+				adjust(a)
+				return a, nil, nil
+			}
+
+			plugins := []*mockPlugin{{idx: "00", name: "foo", createContainer: fn}}
+			if twice {
+				plugins = append(plugins, &mockPlugin{idx: "10", name: "bar", createContainer: fn})
+			}
+
+			s.Prepare(
+				&mockRuntime{
+					options: []nri.Option{
+						nri.WithCapabilityAllowlist("CAP_NET_ADMIN", "CAP_NET_RAW"),
+					},
+				},
+				plugins...,
+			)
+			s.Startup()
+
+			reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+
+			expected := &api.ContainerAdjustment{}
+			adjust(expected)
+			Expect(reply.Adjust.Linux.Capabilities.Add).To(Equal(expected.Linux.Capabilities.Add))
+			Expect(reply.Adjust.Linux.Capabilities.Drop).To(Equal(expected.Linux.Capabilities.Drop))
+		},
+		Entry("adding allowed capabilities",
+			func(a *api.ContainerAdjustment) {
+				a.AddLinuxCapability("CAP_NET_ADMIN")
+				a.AddLinuxCapability("CAP_NET_RAW")
+			}, false, false),
+		Entry("dropping capabilities",
+			func(a *api.ContainerAdjustment) { a.DropLinuxCapability("CAP_SYS_ADMIN") }, false, false),
+		Entry("adding disallowed capabilities",
+			func(a *api.ContainerAdjustment) { a.AddLinuxCapability("CAP_SYS_ADMIN") }, false, true),
+		Entry("invalid capability names",
+			func(a *api.ContainerAdjustment) { a.DropLinuxCapability("sys_admin") }, false, true),
+		Entry("conflicting adjustments by multiple plugins",
+			func(a *api.ContainerAdjustment) { a.AddLinuxCapability("CAP_NET_ADMIN") }, true, true),
+	)
+})
+
+var _ = Describe("Plugin adjustment scopes", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be enforced",
+		func(scopes []api.Scope, grants map[string][]string, adjust func(*api.ContainerAdjustment), shouldFail bool) {
+			ctx := context.Background()
+
+			var options []nri.Option
+			if grants != nil {
+				options = append(options, nri.WithScopeGrants(grants))
+			}
+
+			s.Prepare(
+				&mockRuntime{
+					options: options,
+				},
+				&mockPlugin{
+					idx:    "00",
+					name:   "foo",
+					scopes: scopes,
+					createContainer: func(*mockPlugin, *api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						a := &api.ContainerAdjustment{}
+						adjust(a)
+						return a, nil, nil
+					},
+				},
+			)
+			s.Startup()
+
+			_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("without declared scopes",
+			nil, nil,
+			func(a *api.ContainerAdjustment) { a.AddEnv("FOO", "bar") }, false),
+		Entry("adjusting declared scopes",
+			[]api.Scope{api.Scope_SCOPE_ENV, api.Scope_SCOPE_MOUNTS}, nil,
+			func(a *api.ContainerAdjustment) {
+				a.AddEnv("FOO", "bar")
+				a.AddMount(&api.Mount{Destination: "/data", Source: "/data", Type: "bind"})
+			}, false),
+		Entry("adjusting undeclared scopes",
+			[]api.Scope{api.Scope_SCOPE_ENV}, nil,
+			func(a *api.ContainerAdjustment) { a.SetLinuxMemoryLimit(1 << 20) }, true),
+		Entry("adjusting granted scopes",
+			[]api.Scope{api.Scope_SCOPE_ENV}, map[string][]string{"foo": {"env", "mounts"}},
+			func(a *api.ContainerAdjustment) { a.AddEnv("FOO", "bar") }, false),
+		Entry("adding device cgroup rules with only the resources scope",
+			[]api.Scope{api.Scope_SCOPE_RESOURCES}, map[string][]string{"foo": {"resources"}},
+			func(a *api.ContainerAdjustment) { a.AddLinuxDeviceCgroupRule(true, "c", nil, nil, "rwm") }, true),
+		Entry("adding device cgroup rules with the devices scope",
+			[]api.Scope{api.Scope_SCOPE_RESOURCES, api.Scope_SCOPE_DEVICES},
+			map[string][]string{"foo": {"resources", "devices"}},
+			func(a *api.ContainerAdjustment) { a.AddLinuxDeviceCgroupRule(true, "c", nil, nil, "rwm") }, false),
+	)
+
+	DescribeTable("should be granted before plugins are synchronized",
+		func(scopes []api.Scope, grants map[string][]string) {
+			s.Prepare(
+				&mockRuntime{
+					options: []nri.Option{
+						nri.WithScopeGrants(grants),
+					},
+				},
+				&mockPlugin{
+					idx:    "00",
+					name:   "foo",
+					scopes: scopes,
+				},
+			)
+			s.StartRuntime()
+			s.StartPlugins()
+
+			Expect(s.plugins[0].Wait(PluginDisconnected, time.After(startupTimeout))).To(Succeed())
+			Expect(s.plugins[0].Events()).ToNot(ContainElement(PluginSynchronized))
+		},
+		Entry("without declared scopes",
+			nil, map[string][]string{"foo": {"env"}}),
+		Entry("with ungranted scopes",
+			[]api.Scope{api.Scope_SCOPE_ENV, api.Scope_SCOPE_HOOKS}, map[string][]string{"foo": {"env"}}),
+		Entry("with scopes granted to other plugins",
+			[]api.Scope{api.Scope_SCOPE_ENV}, map[string][]string{"bar": {"env"}}),
+	)
+
+	It("should reject unknown scopes in grants", func() {
+		_, err := nri.New("mock", "0.0.1",
+			func(context.Context, nri.SyncCB) error { return nil },
+			func(context.Context, []*nri.ContainerUpdate) ([]*nri.ContainerUpdate, error) { return nil, nil },
+			nri.WithScopeGrants(map[string][]string{"foo": {"everything"}}),
+		)
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Plugin response signatures", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	newKey := func() (ed25519.PublicKey, ed25519.PrivateKey) {
+		pub, priv, err := ed25519.GenerateKey(nil)
+		Expect(err).To(BeNil())
+		return pub, priv
+	}
+
+	DescribeTable("should be verified",
+		func(signed, verified, matching, shouldFail bool) {
+			ctx := context.Background()
+
+			pub, priv := newKey()
+			if !matching {
+				pub, _ = newKey()
+			}
+
+			var options []nri.Option
+			if verified {
+				options = append(options, nri.WithResponseVerification(map[string]ed25519.PublicKey{
+					"foo": pub,
+				}))
+			}
+			plugin := &mockPlugin{
+				idx:  "00",
+				name: "foo",
+				createContainer: func(*mockPlugin, *api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddEnv("FOO", "bar")
+					return a, nil, nil
+				},
+			}
+			if signed {
+				plugin.key = priv
+			}
+
+			s.Prepare(
+				&mockRuntime{
+					options: options,
+				},
+				plugin,
+			)
+			s.Startup()
+
+			reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+				Expect(errors.Is(err, api.ErrInvalidSignature)).To(BeTrue())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(reply.Adjust.Env).To(HaveLen(1))
+			Expect(reply.Adjust.Env[0].GetValue()).To(Equal("bar"))
+		},
+		Entry("signed by the plugin", true, true, true, false),
+		Entry("signed without verification", true, false, true, false),
+		Entry("unsigned without verification", false, false, true, false),
+		Entry("unsigned but verified", false, true, true, true),
+		Entry("signed with another key", true, true, false, true),
+	)
+
+	It("should bind signatures to their subject", func() {
+		pub, priv := newKey()
+		rpl := &api.CreateContainerResponse{
+			Adjust: &api.ContainerAdjustment{},
+		}
+		rpl.Adjust.AddEnv("FOO", "bar")
+
+		Expect(api.SignResponse(priv, "ctr0", rpl)).To(Succeed())
+		Expect(api.VerifyResponse(pub, "ctr0", rpl)).To(Succeed())
+		Expect(api.VerifyResponse(pub, "ctr1", rpl)).ToNot(Succeed())
+
+		rpl.Adjust.AddEnv("BAR", "foo")
+		Expect(api.VerifyResponse(pub, "ctr0", rpl)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Plugin device cgroup rule adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+		major = int64(195)
+		minor = int64(0)
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be added without device nodes", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddLinuxDeviceCgroupRule(true, "c", &major, &minor, "rw")
+					return a, nil, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bar",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddLinuxDeviceCgroupRule(true, "c", &major, nil, "r")
+					return a, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).To(BeNil())
+		Expect(reply.Adjust.Linux.Devices).To(BeEmpty())
+
+		rules := reply.Adjust.Linux.Resources.Devices
+		Expect(len(rules)).To(Equal(2))
+		Expect(rules[0].Key()).To(Equal("c 195:0"))
+		Expect(rules[0].Access).To(Equal("rw"))
+		Expect(rules[1].Key()).To(Equal("c 195:*"))
+		Expect(rules[1].Access).To(Equal("r"))
+	})
+
+	DescribeTable("should be rejected",
+		func(adjust func(*api.ContainerAdjustment), twice bool) {
+			ctx := context.Background()
+
+			fn := func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				a := &api.ContainerAdjustment{}
+				adjust(a)
+				return a, nil, nil
+			}
+
+			plugins := []*mockPlugin{{idx: "00", name: "foo", createContainer: fn}}
+			if twice {
+				plugins = append(plugins, &mockPlugin{idx: "10", name: "bar", createContainer: fn})
+			}
+
+			s.Prepare(&mockRuntime{}, plugins...)
+			s.Startup()
+
+			_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("for invalid device types",
+			func(a *api.ContainerAdjustment) { a.AddLinuxDeviceCgroupRule(true, "x", &major, &minor, "rw") }, false),
+		Entry("for invalid access",
+			func(a *api.ContainerAdjustment) { a.AddLinuxDeviceCgroupRule(true, "c", &major, &minor, "rwx") }, false),
+		Entry("if the same device is adjusted by multiple plugins",
+			func(a *api.ContainerAdjustment) { a.AddLinuxDeviceCgroupRule(false, "c", &major, &minor, "rwm") }, true),
+	)
+})
+
+var _ = Describe("Plugin pod resource adjustments", func() {
+	var (
+		s = &Suite{}
+
+		newPod = func() *api.PodSandbox {
+			return &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be collected and visible to subsequent plugins", func() {
+		var (
+			ctx  = context.Background()
+			pod  = newPod()
+			seen *api.LinuxResources
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				adjustPodSandbox: func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					a := &api.PodSandboxAdjustment{}
+					a.SetLinuxMemoryLimit(1 << 30)
+					a.SetLinuxCPUQuota(200000)
+					a.SetLinuxCPUPeriod(100000)
+					return a, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bar",
+				adjustPodSandbox: func(_ *mockPlugin, pod *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					seen = pod.GetLinux().GetPodResources()
+					a := &api.PodSandboxAdjustment{}
+					a.SetLinuxCPUSetCPUs("0-3")
+					a.AddLinuxUnified("memory.high", "900M")
+					return a, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		adjustment, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).To(BeNil())
+
+		resources := adjustment.Linux.Resources
+		Expect(resources.Memory.Limit.GetValue()).To(Equal(int64(1 << 30)))
+		Expect(resources.Cpu.Quota.GetValue()).To(Equal(int64(200000)))
+		Expect(resources.Cpu.Period.GetValue()).To(Equal(uint64(100000)))
+		Expect(resources.Cpu.Cpus).To(Equal("0-3"))
+		Expect(resources.Unified).To(Equal(map[string]string{"memory.high": "900M"}))
+
+		Expect(seen.GetMemory().GetLimit().GetValue()).To(Equal(int64(1 << 30)))
+		Expect(pod.Linux.PodResources.Cpu.Cpus).To(Equal("0-3"))
+	})
+
+	DescribeTable("should be rejected",
+		func(adjust func(*api.PodSandboxAdjustment), twice bool) {
+			ctx := context.Background()
+
+			fn := func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+				a := &api.PodSandboxAdjustment{}
+				adjust(a)
+				return a, nil
+			}
+
+			plugins := []*mockPlugin{{idx: "00", name: "foo", adjustPodSandbox: fn}}
+			if twice {
+				plugins = append(plugins, &mockPlugin{idx: "10", name: "bar", adjustPodSandbox: fn})
+			}
+
+			s.Prepare(&mockRuntime{}, plugins...)
+			s.Startup()
+
+			_, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+				Pod: newPod(),
+			})
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("if set by multiple plugins",
+			func(a *api.PodSandboxAdjustment) { a.SetLinuxMemoryLimit(1 << 30) }, true),
+		Entry("for resources which do not apply to pods",
+			func(a *api.PodSandboxAdjustment) {
+				a.SetLinuxMemoryLimit(1 << 30)
+				a.Linux.Resources.RdtClass = api.String("gold")
+			}, false),
+	)
+})
+
+var _ = Describe("Plugin hugepage limit adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should replace existing limits of the same page size", func() {
+		var (
+			ctx = context.Background()
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_CREATED,
+				Linux: &api.LinuxContainer{
+					Resources: &api.LinuxResources{
+						HugepageLimits: []*api.HugepageLimit{
+							{PageSize: "2MB", Limit: 1 << 21},
+							{PageSize: "1GB", Limit: 1 << 30},
+						},
+					},
+				},
+			}
+			seen []*api.HugepageLimit
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddLinuxHugepageLimit("2MB", 1<<22)
+					return a, nil, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bar",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					seen = ctr.GetLinux().GetResources().GetHugepageLimits()
+					return nil, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).To(BeNil())
+		Expect(len(reply.Adjust.Linux.Resources.HugepageLimits)).To(Equal(1))
+		Expect(reply.Adjust.Linux.Resources.HugepageLimits[0].Limit).To(Equal(uint64(1 << 22)))
+
+		limits := map[string]uint64{}
+		for _, l := range seen {
+			limits[l.PageSize] = l.Limit
+		}
+		Expect(limits).To(Equal(map[string]uint64{"2MB": 1 << 22, "1GB": 1 << 30}))
+	})
+
+	It("should reject invalid page sizes", func() {
+		var (
+			ctx = context.Background()
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_CREATED,
+			}
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddLinuxHugepageLimit("2 megs", 1<<22)
+					return a, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Plugin NUMA and topology adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should bind containers to NUMA nodes and pass on hints", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.SetLinuxNUMANodes("1")
+					a.AddLinuxPreferredNetworkDevice("0000:3b:00.1")
+					a.SetLinuxMemoryPolicy(api.MemoryPolicyMode_MPOL_INTERLEAVE, "0-1",
+						api.MemoryPolicyFlag_MPOL_F_STATIC_NODES)
+					return a, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).To(BeNil())
+		Expect(reply.Adjust.Linux.Resources.Cpu.Mems).To(Equal("1"))
+		Expect(reply.Adjust.Linux.TopologyHints.NumaNodes).To(Equal("1"))
+		Expect(reply.Adjust.Linux.TopologyHints.PreferredNetworkDevices).To(Equal([]string{"0000:3b:00.1"}))
+		Expect(reply.Adjust.Linux.MemoryPolicy.Mode).To(Equal(api.MemoryPolicyMode_MPOL_INTERLEAVE))
+		Expect(reply.Adjust.Linux.MemoryPolicy.Nodes).To(Equal("0-1"))
+	})
+
+	It("should conflict with cpuset memory set by another plugin", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.SetLinuxCPUSetMems("0")
+					return a, nil, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bar",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.SetLinuxNUMANodes("1")
+					return a, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+
+	DescribeTable("should validate memory policies",
+		func(mode api.MemoryPolicyMode, nodes string, shouldFail bool) {
+			ctx := context.Background()
+
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						a := &api.ContainerAdjustment{}
+						a.SetLinuxMemoryPolicy(mode, nodes)
+						return a, nil, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+		},
+		Entry("with bind to a node range", api.MemoryPolicyMode_MPOL_BIND, "0-1,3", false),
+		Entry("with bind without nodes", api.MemoryPolicyMode_MPOL_BIND, "", true),
+		Entry("with local and nodes", api.MemoryPolicyMode_MPOL_LOCAL, "0", true),
+		Entry("with an invalid node list", api.MemoryPolicyMode_MPOL_INTERLEAVE, "1-0", true),
+	)
+})
+
+var _ = Describe("Plugin templated hook adjustments", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be checked for valid templates",
+		func(arg string, shouldFail bool) {
+			var (
+				ctx = context.Background()
+				pod = &api.PodSandbox{
+					Id:        "pod0",
+					Name:      "pod0",
+					Uid:       "uid0",
+					Namespace: "default",
+				}
+				ctr = &api.Container{
+					Id:           "ctr0",
+					PodSandboxId: "pod0",
+					Name:         "ctr0",
+					State:        api.ContainerState_CONTAINER_CREATED,
+				}
+			)
+
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						a := &api.ContainerAdjustment{}
+						a.AddHooks(&api.Hooks{
+							CreateRuntime: []*api.Hook{
+								{
+									Path: "/usr/libexec/net-hook",
+									Args: []string{"net-hook", arg},
+								},
+							},
+						})
+						return a, nil, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			rpl, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+				Expect(rpl.Adjust.Hooks.CreateRuntime[0].Args).To(Equal([]string{"net-hook", arg}))
+			}
+		},
+		Entry("with a plain argument", "--verbose", false),
+		Entry("with a valid template", "{{.NetNSPath}}", false),
+		Entry("with an invalid template", "{{.NetNSPath", true),
+	)
+})
+
+var _ = Describe("Plugin user namespace ID mapping adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+
+		runtime = func() *mockRuntime {
+			return &mockRuntime{
+				options: []nri.Option{
+					nri.WithUserNamespaceRanges(
+						[]nri.IDRange{{Start: 100000, Size: 131072}},
+						[]nri.IDRange{{Start: 100000, Size: 65536}},
+					),
+				},
+			}
+		}
+
+		mapping = func(cid, hid, size uint32) *api.LinuxIDMapping {
+			return &api.LinuxIDMapping{ContainerId: cid, HostId: hid, Size: size}
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be validated against the configured ranges",
+		func(uids, gids []*api.LinuxIDMapping, shouldFail bool) {
+			ctx := context.Background()
+
+			s.Prepare(
+				runtime(),
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						a := &api.ContainerAdjustment{}
+						a.SetLinuxIDMappings(uids, gids)
+						return a, nil, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			rpl, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+				Expect(len(rpl.Adjust.Linux.UidMappings)).To(Equal(len(uids)))
+				Expect(len(rpl.Adjust.Linux.GidMappings)).To(Equal(len(gids)))
+				for i, m := range uids {
+					Expect(rpl.Adjust.Linux.UidMappings[i].HostId).To(Equal(m.HostId))
+				}
+			}
+		},
+		Entry("with mappings inside the ranges",
+			[]*api.LinuxIDMapping{mapping(0, 165536, 65536)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			false,
+		),
+		Entry("with multiple disjoint mappings",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 1000), mapping(1000, 101000, 1000)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 2000)},
+			false,
+		),
+		Entry("with a UID mapping outside the ranges",
+			[]*api.LinuxIDMapping{mapping(0, 0, 65536)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			true,
+		),
+		Entry("with a GID mapping crossing the end of the ranges",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			[]*api.LinuxIDMapping{mapping(0, 100001, 65536)},
+			true,
+		),
+		Entry("with an empty mapping",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 0)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			true,
+		),
+		Entry("with overlapping container IDs",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 1000), mapping(500, 110000, 1000)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			true,
+		),
+		Entry("with only UID mappings",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			nil,
+			true,
+		),
+	)
+
+	It("should be rejected without configured ranges", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				adjustPodSandbox: func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					a := &api.PodSandboxAdjustment{}
+					a.SetLinuxIDMappings(
+						[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+						[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+					)
+					return a, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("should be visible to subsequent plugins and conflict for pods", func() {
+		ctx := context.Background()
+
+		var seen []*api.LinuxIDMapping
+
+		s.Prepare(
+			runtime(),
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				adjustPodSandbox: func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					a := &api.PodSandboxAdjustment{}
+					a.SetLinuxIDMappings(
+						[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+						[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+					)
+					return a, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bar",
+				adjustPodSandbox: func(_ *mockPlugin, pod *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					seen = pod.GetLinux().GetUidMappings()
+					a := &api.PodSandboxAdjustment{}
+					a.SetLinuxIDMappings(
+						[]*api.LinuxIDMapping{mapping(0, 165536, 65536)},
+						[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+					)
+					return a, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(len(seen)).To(Equal(1))
+		Expect(seen[0].HostId).To(Equal(uint32(100000)))
+	})
+})
+
+var _ = Describe("Plugin idmapped mount adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+
+		runtime = func() *mockRuntime {
+			return &mockRuntime{
+				options: []nri.Option{
+					nri.WithUserNamespaceRanges(
+						[]nri.IDRange{{Start: 100000, Size: 65536}},
+						[]nri.IDRange{{Start: 100000, Size: 65536}},
+					),
+				},
+			}
+		}
+
+		mapping = func(cid, hid, size uint32) *api.LinuxIDMapping {
+			return &api.LinuxIDMapping{ContainerId: cid, HostId: hid, Size: size}
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be validated",
+		func(uids, gids []*api.LinuxIDMapping, shouldFail bool) {
+			ctx := context.Background()
+
+			s.Prepare(
+				runtime(),
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						mnt := &api.Mount{
+							Destination: "/data",
+							Type:        "bind",
+							Source:      "/host/data",
+							Options:     []string{"rbind", "rshared"},
+							UidMappings: uids,
+							GidMappings: gids,
+						}
+						mnt.SetPropagation("slave")
+						a := &api.ContainerAdjustment{}
+						a.AddMount(mnt)
+						return a, nil, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			rpl, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(rpl.Adjust.Mounts).To(HaveLen(1))
+			mnt := rpl.Adjust.Mounts[0]
+			Expect(mnt.Options).To(Equal([]string{"rbind", "slave"}))
+			Expect(mnt.UidMappings).To(HaveLen(len(uids)))
+			Expect(mnt.GidMappings).To(HaveLen(len(gids)))
+		},
+		Entry("without mappings",
+			nil,
+			nil,
+			false,
+		),
+		Entry("with valid mappings",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 1000), mapping(1000, 101000, 1000)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			false,
+		),
+		Entry("with an empty mapping",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 0)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			true,
+		),
+		Entry("with an overflowing mapping",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			[]*api.LinuxIDMapping{mapping(0xffffff00, 100000, 65536)},
+			true,
+		),
+		Entry("with overlapping host IDs",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 1000), mapping(1000, 100500, 1000)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			true,
+		),
+		Entry("with only UID mappings",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			nil,
+			true,
+		),
+		Entry("with a UID mapping to host root",
+			[]*api.LinuxIDMapping{mapping(0, 0, 1)},
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			true,
+		),
+		Entry("with a GID mapping outside the ranges",
+			[]*api.LinuxIDMapping{mapping(0, 100000, 65536)},
+			[]*api.LinuxIDMapping{mapping(0, 200000, 65536)},
+			true,
+		),
+	)
+
+	It("should be rejected without configured ranges", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddMount(&api.Mount{
+						Destination: "/data",
+						Type:        "bind",
+						Source:      "/host/data",
+						Options:     []string{"rbind"},
+						UidMappings: []*api.LinuxIDMapping{mapping(0, 0, 65536)},
+						GidMappings: []*api.LinuxIDMapping{mapping(0, 0, 65536)},
+					})
+					return a, nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("outside of the allowed host ranges"))
+	})
+})
+
+var _ = Describe("Plugin conditional adjustments", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should only set absent container metadata and environment", func() {
+		var (
+			ctx = context.Background()
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_CREATED,
+				Env:          []string{"A=orig"},
+				Annotations:  map[string]string{"a": "orig"},
+				Labels:       map[string]string{"l": "orig", "gone": "orig"},
+			}
+		)
+
+		create := func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+			plugin := p.idx + "-" + p.name
+			a := &api.ContainerAdjustment{}
+			a.AddEnvIfAbsent("A", plugin)
+			a.AddEnvIfAbsent("B", plugin)
+			a.AddAnnotationIfAbsent("a", plugin)
+			a.AddAnnotationIfAbsent("b", plugin)
+			a.AddLabelIfAbsent("l", plugin)
+			a.AddLabelIfAbsent("m", plugin)
+			a.RemoveLabel("gone")
+			return a, nil, nil
+		}
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{idx: "00", name: "foo", createContainer: create},
+			&mockPlugin{idx: "10", name: "bar", createContainer: create},
+		)
+
+		s.Startup()
+
+		reply, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       pod,
+			Container: ctr,
+		})
+		Expect(err).To(BeNil())
+		Expect(reply.Adjust.Env).To(Equal([]*api.KeyValue{{Key: "B", Value: "00-foo"}}))
+		Expect(reply.Adjust.Annotations).To(Equal(map[string]string{"b": "00-foo"}))
+		Expect(reply.Adjust.Labels).To(Equal(map[string]string{"m": "00-foo", "-gone": ""}))
+	})
+
+	It("should only set absent pod annotations and labels", func() {
+		var (
+			ctx = context.Background()
+			pod = &api.PodSandbox{
+				Id:          "pod0",
+				Name:        "pod0",
+				Uid:         "uid0",
+				Namespace:   "default",
+				Annotations: map[string]string{"a": "orig"},
+				Labels:      map[string]string{"l": "orig"},
+			}
+		)
+
+		adjust := func(p *mockPlugin, pod *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+			plugin := p.idx + "-" + p.name
+			a := &api.PodSandboxAdjustment{}
+			a.AddAnnotationIfAbsent("a", plugin)
+			a.AddAnnotationIfAbsent("b", plugin)
+			a.AddLabelIfAbsent("l", plugin)
+			a.AddLabelIfAbsent("m", plugin)
+			return a, nil
+		}
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{idx: "00", name: "foo", adjustPodSandbox: adjust},
+			&mockPlugin{idx: "10", name: "bar", adjustPodSandbox: adjust},
+		)
+
+		s.Startup()
+
+		adjustment, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).To(BeNil())
+		Expect(adjustment.Annotations).To(Equal(map[string]string{"b": "00-foo"}))
+		Expect(adjustment.Labels).To(Equal(map[string]string{"m": "00-foo"}))
+	})
+})
+
+var _ = Describe("Plugin pod annotation adjustments", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be visible to subsequent plugins and in the pod", func() {
+		var (
+			ctx = context.Background()
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+			seen string
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "ipam",
+				adjustPodSandbox: func(_ *mockPlugin, _ *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					a := &api.PodSandboxAdjustment{}
+					a.AddAnnotation("example.com/ipam-pool", "pool-a")
+					return a, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "consumer",
+				adjustPodSandbox: func(_ *mockPlugin, pod *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					seen = pod.GetAnnotations()["example.com/ipam-pool"]
+					return nil, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		adjustment, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).To(BeNil())
+		Expect(seen).To(Equal("pool-a"))
+		Expect(pod.Annotations).To(Equal(map[string]string{"example.com/ipam-pool": "pool-a"}))
+		Expect(adjustment.Annotations).To(Equal(map[string]string{"example.com/ipam-pool": "pool-a"}))
+	})
+})
+
+var _ = Describe("Plugin pod hostname and hosts adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be combined", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				adjustPodSandbox: func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					a := &api.PodSandboxAdjustment{}
+					a.SetHostname("pod0-host")
+					a.AddExtraHost("169.254.169.254", "metadata")
+					return a, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bar",
+				adjustPodSandbox: func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					a := &api.PodSandboxAdjustment{}
+					a.SetDomainname("example.com")
+					a.AddExtraHost("10.0.0.1", "db", "db.example.com")
+					return a, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		adjustment, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).To(BeNil())
+		Expect(adjustment.Hostname).To(Equal("pod0-host"))
+		Expect(adjustment.Domainname).To(Equal("example.com"))
+		Expect(adjustment.ExtraHosts).To(Equal([]*api.HostEntry{
+			{Ip: "169.254.169.254", Hostnames: []string{"metadata"}},
+			{Ip: "10.0.0.1", Hostnames: []string{"db", "db.example.com"}},
+		}))
+	})
+
+	DescribeTable("should fail",
+		func(adjust func(*api.PodSandboxAdjustment)) {
+			ctx := context.Background()
+
+			plugin := func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+				a := &api.PodSandboxAdjustment{}
+				adjust(a)
+				return a, nil
+			}
+
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{idx: "00", name: "foo", adjustPodSandbox: plugin},
+				&mockPlugin{idx: "10", name: "bar", adjustPodSandbox: plugin},
+			)
+
+			s.Startup()
+
+			_, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+				Pod: pod,
+			})
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("with conflicting hostnames", func(a *api.PodSandboxAdjustment) {
+			a.SetHostname("pod0-host")
+		}),
+		Entry("with conflicting hosts entries", func(a *api.PodSandboxAdjustment) {
+			a.AddExtraHost("10.0.0.1", "db")
+		}),
+		Entry("with an invalid IP address", func(a *api.PodSandboxAdjustment) {
+			a.AddExtraHost("10.0.0.", "db")
+		}),
+	)
+})
+
+var _ = Describe("Plugin pod network namespace adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be validated",
+		func(join *api.LinuxNamespaceJoin, shouldFail bool) {
+			ctx := context.Background()
+
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					adjustPodSandbox: func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+						return &api.PodSandboxAdjustment{
+							Linux: &api.LinuxPodSandboxAdjustment{
+								NetworkNamespace: join,
+							},
+						}, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			adjustment, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+				Pod: pod,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+				Expect(adjustment.Linux.NetworkNamespace.GetPath()).To(Equal(join.Path))
+				Expect(adjustment.Linux.NetworkNamespace.GetPodUid()).To(Equal(join.PodUid))
+			}
+		},
+		Entry("with a path", &api.LinuxNamespaceJoin{Path: "/var/run/netns/mesh0"}, false),
+		Entry("with a pod UID", &api.LinuxNamespaceJoin{PodUid: "uid1"}, false),
+		Entry("with a relative path", &api.LinuxNamespaceJoin{Path: "mesh0"}, true),
+		Entry("with both a path and a pod UID",
+			&api.LinuxNamespaceJoin{Path: "/var/run/netns/mesh0", PodUid: "uid1"}, true),
+		Entry("with neither a path nor a pod UID", &api.LinuxNamespaceJoin{}, true),
+		Entry("with the UID of the pod itself", &api.LinuxNamespaceJoin{PodUid: "uid0"}, true),
+	)
+
+	It("should conflict if requested by multiple plugins", func() {
+		ctx := context.Background()
+
+		adjust := func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+			a := &api.PodSandboxAdjustment{}
+			a.JoinNetworkNamespace("mesh0")
+			return a, nil
+		}
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{idx: "00", name: "foo", adjustPodSandbox: adjust},
+			&mockPlugin{idx: "10", name: "bar", adjustPodSandbox: adjust},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Plugin unified resource adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be validated",
+		func(key, value string, shouldFail bool) {
+			ctx := context.Background()
+
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{
+					idx:  "00",
+					name: "test",
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						a := &api.ContainerAdjustment{}
+						a.AddLinuxUnified(key, value)
+						return a, nil, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			rpl, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+				Expect(rpl.Adjust.Linux.Resources.Unified).To(Equal(map[string]string{key: value}))
+			}
+		},
+		Entry("with cpu.idle", "cpu.idle", "1", false),
+		Entry("with memory.high", "memory.high", "1073741824", false),
+		Entry("with memory.oom.group", "memory.oom.group", "1", false),
+		Entry("with a core cgroup interface file", "cgroup.procs", "1", true),
+		Entry("without a controller", "memory", "1", true),
+		Entry("with a path", "../memory.max", "max", true),
+		Entry("with a multi-line value", "memory.max", "max\nmax", true),
+	)
+})
+
+var _ = Describe("Plugin resource pressure events", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should relay node and container pressure to plugins", func() {
+		var (
+			ctx = context.Background()
+
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+			}
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_RUNNING,
+			}
+
+			relayed []*api.Pressure
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				resourcePressure: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container, pressure *api.Pressure) error {
+					relayed = append(relayed, pressure)
+					return nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		runtime := s.runtime.runtime
+		Expect(runtime.ResourcePressure(ctx, &api.StateChangeEvent{
+			Pressure: &api.Pressure{
+				Resource:  api.PressureResource_PRESSURE_MEMORY,
+				SomeAvg10: 12.5,
+				Threshold: 10,
+			},
+		})).To(Succeed())
+		Expect(runtime.ResourcePressure(ctx, &api.StateChangeEvent{
+			Pod:       pod,
+			Container: ctr,
+			Pressure: &api.Pressure{
+				Resource: api.PressureResource_PRESSURE_MEMORY,
+				MemoryEvents: map[string]uint64{
+					"high": 3,
+				},
+			},
+		})).To(Succeed())
+
+		plugin := s.plugins[0]
+		Expect(plugin.EventQ().Has(&Event{Type: ResourcePressure})).To(BeTrue())
+		Expect(plugin.EventQ().Has(ContainerEvent(ctr, ResourcePressure))).To(BeTrue())
+		Expect(len(relayed)).To(Equal(2))
+		Expect(relayed[0].GetResource()).To(Equal(api.PressureResource_PRESSURE_MEMORY))
+		Expect(relayed[0].GetSomeAvg10()).To(Equal(12.5))
+		Expect(relayed[0].GetThreshold()).To(Equal(float64(10)))
+		Expect(relayed[1].GetMemoryEvents()).To(Equal(map[string]uint64{"high": 3}))
+	})
+})
+
+var _ = Describe("Plugin network status and garbage collection events", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should relay CNI network status and GC to plugins", func() {
+		var (
+			ctx = context.Background()
+
+			networks    []*api.NetworkStatus
+			attachments []*api.NetworkAttachment
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				networkStatus: func(_ *mockPlugin, n []*api.NetworkStatus) error {
+					networks = n
+					return nil
+				},
+				networkGC: func(_ *mockPlugin, a []*api.NetworkAttachment) error {
+					attachments = a
+					return nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		runtime := s.runtime.runtime
+		Expect(runtime.NetworkStatus(ctx, &api.StateChangeEvent{
+			Networks: []*api.NetworkStatus{
+				{Name: "pod-net", Ready: true},
+				{Name: "sdn", Code: 50, Message: "agent down"},
+			},
+		})).To(Succeed())
+		Expect(runtime.NetworkGC(ctx, &api.StateChangeEvent{
+			Attachments: []*api.NetworkAttachment{
+				{Network: "pod-net", ContainerId: "pod0", Ifname: "eth0"},
+			},
+		})).To(Succeed())
+
+		plugin := s.plugins[0]
+		Expect(plugin.EventQ().Has(&Event{Type: NetworkStatus})).To(BeTrue())
+		Expect(plugin.EventQ().Has(&Event{Type: NetworkGC})).To(BeTrue())
+		Expect(networks).To(HaveLen(2))
+		Expect(networks[0].GetReady()).To(BeTrue())
+		Expect(networks[1].GetReady()).To(BeFalse())
+		Expect(networks[1].GetMessage()).To(Equal("agent down"))
+		Expect(attachments).To(HaveLen(1))
+		Expect(attachments[0].GetContainerId()).To(Equal("pod0"))
+	})
+
+	It("should not deduplicate events with different network status", func() {
+		var (
+			ctx      = context.Background()
+			synced   = make(chan struct{})
+			networks []string
+		)
+
+		s.Prepare(&mockRuntime{})
+		Expect(s.runtime.Start(s.dir)).To(Succeed())
+
+		plugin, err := stub.New(nil,
+			stub.WithPluginName("func"),
+			stub.WithPluginIdx("00"),
+			stub.WithSocketPath(filepath.Join(s.dir, "nri.sock")),
+			stub.WithOnClose(func() {}),
+			stub.WithEventDeduplication(),
+			stub.HandleSynchronize(func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error) {
+				close(synced)
+				return nil, nil
+			}),
+			stub.HandleNetworkStatus(func(n []*api.NetworkStatus) error {
+				networks = append(networks, n[0].GetName())
+				return nil
+			}),
+		)
+		Expect(err).To(BeNil())
+		Expect(plugin.Start(ctx)).To(Succeed())
+		defer func() {
+			plugin.Stop()
+			plugin.Wait()
+		}()
+		Eventually(synced).Should(BeClosed())
+
+		for _, name := range []string{"pod-net", "sdn"} {
+			Expect(s.runtime.runtime.NetworkStatus(ctx, &api.StateChangeEvent{
+				Networks: []*api.NetworkStatus{{Name: name, Ready: true}},
+			})).To(Succeed())
+		}
+		Expect(networks).To(Equal([]string{"pod-net", "sdn"}))
+	})
+})
+
+var _ = Describe("Plugin network setup failure events", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	failure := func() *api.StateChangeEvent {
+		return &api.StateChangeEvent{
+			Pod: &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod",
+				Namespace: "ns",
+			},
+			NetworkFailure: &api.NetworkSetupFailure{
+				Network: "sdn",
+				Code:    11,
+				Message: "try again later",
+				Attempt: 2,
+			},
+		}
+	}
+
+	It("should relay failures and combine the requested retry changes", func() {
+		var (
+			ctx  = context.Background()
+			seen *api.NetworkSetupFailure
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "fallback",
+				setupNetworkFailed: func(_ *mockPlugin, _ *api.PodSandbox, f *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+					seen = f
+					return &api.NetworkSetupRetry{
+						Networks: []string{"pod-net"},
+					}, nil
+				},
+			},
+			&mockPlugin{
+				idx:  "10",
+				name: "bandwidth",
+				setupNetworkFailed: func(*mockPlugin, *api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+					return &api.NetworkSetupRetry{
+						CapabilityArgs: map[string]string{
+							"bandwidth": `{"ingressRate":1000000}`,
+						},
+					}, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		retry, err := s.runtime.runtime.SetupNetworkFailed(ctx, failure())
+		Expect(err).To(BeNil())
+		Expect(retry.GetNetworks()).To(Equal([]string{"pod-net"}))
+		Expect(retry.GetCapabilityArgs()).To(Equal(map[string]string{
+			"bandwidth": `{"ingressRate":1000000}`,
+		}))
+
+		Expect(seen.GetNetwork()).To(Equal("sdn"))
+		Expect(seen.GetAttempt()).To(Equal(uint32(2)))
+		for _, plugin := range s.plugins {
+			Expect(plugin.EventQ().Has(PodSandboxEvent(&api.PodSandbox{Id: "pod0"}, SetupNetworkFailed))).To(BeTrue())
+		}
+	})
+
+	DescribeTable("should reject invalid retry changes",
+		func(first, second *api.NetworkSetupRetry) {
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{
+					idx:  "00",
+					name: "first",
+					setupNetworkFailed: func(*mockPlugin, *api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+						return first, nil
+					},
+				},
+				&mockPlugin{
+					idx:  "10",
+					name: "second",
+					setupNetworkFailed: func(*mockPlugin, *api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+						return second, nil
+					},
+				},
+			)
+
+			s.Startup()
+
+			_, err := s.runtime.runtime.SetupNetworkFailed(context.Background(), failure())
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("conflicting networks",
+			&api.NetworkSetupRetry{Networks: []string{"pod-net"}},
+			&api.NetworkSetupRetry{Networks: []string{"backup-net"}},
+		),
+		Entry("conflicting capability arguments",
+			&api.NetworkSetupRetry{CapabilityArgs: map[string]string{"mac": `"c2:11:22:33:44:55"`}},
+			&api.NetworkSetupRetry{CapabilityArgs: map[string]string{"mac": `"c2:11:22:33:44:66"`}},
+		),
+		Entry("capability arguments which are not JSON",
+			nil,
+			&api.NetworkSetupRetry{CapabilityArgs: map[string]string{"mac": "c2:11:22:33:44:55"}},
+		),
+		Entry("empty network names",
+			&api.NetworkSetupRetry{Networks: []string{"pod-net", ""}},
+			nil,
+		),
+	)
+
+	It("should fail the pod if a plugin rejects it", func() {
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				setupNetworkFailed: func(*mockPlugin, *api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+					return nil, &api.ErrRejected{Reason: "NoFallbackNetwork"}
+				},
+			},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.SetupNetworkFailed(context.Background(), failure())
+		Expect(err).ToNot(BeNil())
+
+		rejected := &api.ErrRejected{}
+		Expect(errors.As(err, &rejected)).To(BeTrue())
+		Expect(rejected.Reason).To(Equal("NoFallbackNetwork"))
+		Expect(rejected.Object).To(Equal("pod ns/pod"))
+	})
+})
+var _ = Describe("Pod network status and runtime", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be relayed to plugins with the pod", func() {
+		var (
+			ctx = context.Background()
+
+			pod = &api.PodSandbox{
+				Id:        "pod0",
+				Name:      "pod0",
+				Uid:       "uid0",
+				Namespace: "default",
+				Network: &api.PodSandboxNetworkStatus{
+					Ips:       []string{"10.0.0.2", "fd00::2"},
+					Interface: "eth0",
+					CniResult: "/var/lib/cni/results/pod0-eth0",
+				},
+				RuntimeHandler: "kata",
+				RuntimeType:    "io.containerd.kata.v2",
+				Owner: &api.PodOwner{
+					Kind: api.WorkloadStatefulSet,
+					Name: "db",
+					Uid:  "uid1",
+				},
+			}
+			ctr = &api.Container{
+				Id:           "ctr0",
+				PodSandboxId: "pod0",
+				Name:         "ctr0",
+				State:        api.ContainerState_CONTAINER_CREATED,
+			}
+
+			seen *api.PodSandbox
+		)
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "test",
+				startContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) error {
+					seen = pod
+					return nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		Expect(s.runtime.runtime.StartContainer(ctx, &api.StateChangeEvent{
+			Pod:       pod,
+			Container: ctr,
+		})).To(Succeed())
+
+		Expect(seen).ToNot(BeNil())
+		Expect(seen.PrimaryIP()).To(Equal("10.0.0.2"))
+		Expect(seen.GetNetwork().GetIps()).To(Equal([]string{"10.0.0.2", "fd00::2"}))
+		Expect(seen.GetNetwork().GetInterface()).To(Equal("eth0"))
+		Expect(seen.GetNetwork().GetCniResult()).To(Equal("/var/lib/cni/results/pod0-eth0"))
+		Expect(seen.GetRuntimeHandler()).To(Equal("kata"))
+		Expect(seen.GetRuntimeType()).To(Equal("io.containerd.kata.v2"))
+		Expect(seen.Workload()).To(Equal(&api.PodOwner{
+			Kind: api.WorkloadStatefulSet,
+			Name: "db",
+			Uid:  "uid1",
+		}))
+	})
+})
+
+var _ = Describe("Pod IP family helpers", func() {
+	It("should select IP addresses by family", func() {
+		pod := &api.PodSandbox{
+			Network: &api.PodSandboxNetworkStatus{
+				Ips: []string{"fd00::2", "10.0.0.2", "10.0.1.2"},
+			},
+		}
+
+		Expect(pod.IsDualStack()).To(BeTrue())
+		Expect(pod.IPOfFamily(api.IPFamilyIPv4)).To(Equal("10.0.0.2"))
+		Expect(pod.IPOfFamily(api.IPFamilyIPv6)).To(Equal("fd00::2"))
+		Expect(pod.PreferredIP(api.IPFamilyIPv4, api.IPFamilyIPv6)).To(Equal("10.0.0.2"))
+		Expect(pod.PreferredIP()).To(Equal("fd00::2"))
+
+		pod.Network.Ips = []string{"10.0.0.2"}
+		Expect(pod.IsDualStack()).To(BeFalse())
+		Expect(pod.IPOfFamily(api.IPFamilyIPv6)).To(BeEmpty())
+		Expect(pod.PreferredIP(api.IPFamilyIPv6)).To(Equal("10.0.0.2"))
+
+		Expect(api.FamilyOf("::ffff:10.0.0.2")).To(Equal(api.IPFamilyIPv4))
+		Expect(api.FamilyOf("pod0")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Pod workload helpers", func() {
+	DescribeTable("should infer the workload owning a pod",
+		func(name string, labels map[string]string, kind, owner string) {
+			pod := &api.PodSandbox{
+				Name:   name,
+				Labels: labels,
+			}
+			workload := pod.Workload()
+			if kind == "" {
+				Expect(workload).To(BeNil())
+				return
+			}
+			Expect(workload.GetKind()).To(Equal(kind))
+			Expect(workload.GetName()).To(Equal(owner))
+			Expect(workload.GetUid()).To(BeEmpty())
+		},
+		Entry("Deployment pods", "web-7c5ddbdf54-x8lqz",
+			map[string]string{"pod-template-hash": "7c5ddbdf54"},
+			api.WorkloadDeployment, "web"),
+		Entry("StatefulSet pods", "db-0",
+			map[string]string{
+				"controller-revision-hash":           "db-5d8b9c",
+				"statefulset.kubernetes.io/pod-name": "db-0",
+			},
+			api.WorkloadStatefulSet, "db"),
+		Entry("DaemonSet pods", "agent-4kq2x",
+			map[string]string{
+				"controller-revision-hash": "6b9f8d",
+				"pod-template-generation":  "1",
+			},
+			api.WorkloadDaemonSet, "agent"),
+		Entry("Job pods", "backup-28211234-abcde",
+			map[string]string{"batch.kubernetes.io/job-name": "backup-28211234"},
+			api.WorkloadJob, "backup-28211234"),
+		Entry("legacy Job pods", "backup-abcde",
+			map[string]string{"job-name": "backup"},
+			api.WorkloadJob, "backup"),
+		Entry("bare pods", "pod0", map[string]string{"app": "test"}, "", ""),
+	)
+})
+
+var _ = Describe("Cgroup path helpers", func() {
+	DescribeTable("should resolve cgroup paths",
+		func(cgroupPath, expected string, shouldFail bool) {
+			resolved, err := api.ResolveCgroupPath(cgroupPath)
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(resolved).To(Equal(expected))
+		},
+		Entry("cgroupfs pod parent",
+			"/kubepods/burstable/pod123", "/kubepods/burstable/pod123", false),
+		Entry("relative cgroupfs path",
+			"kubepods/pod123/ctr0", "/kubepods/pod123/ctr0", false),
+		Entry("systemd pod slice",
+			"kubepods-burstable-pod123.slice",
+			"/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod123.slice", false),
+		Entry("systemd container scope",
+			"kubepods-besteffort-pod123.slice:cri-containerd:ctr0",
+			"/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod123.slice/cri-containerd-ctr0.scope", false),
+		Entry("systemd scope in the default slice",
+			":cri-containerd:ctr0", "/system.slice/cri-containerd-ctr0.scope", false),
+		Entry("invalid systemd slice",
+			"kubepods--pod123.slice", "", true),
+		Entry("invalid systemd cgroups path",
+			"kubepods.slice:ctr0", "", true),
+	)
+
+	It("should resolve pod and container cgroups", func() {
+		pod := &api.PodSandbox{
+			Id: "pod0",
+			Linux: &api.LinuxPodSandbox{
+				CgroupParent: "kubepods-pod123.slice",
+			},
+		}
+		ctr := &api.Container{
+			Id: "ctr0",
+			Linux: &api.LinuxContainer{
+				CgroupsPath: "kubepods-pod123.slice:cri-containerd:ctr0",
+			},
+		}
+
+		podPath, err := pod.CgroupPath()
+		Expect(err).To(BeNil())
+		Expect(podPath).To(Equal("/kubepods.slice/kubepods-pod123.slice"))
+
+		ctrPath, err := ctr.CgroupPath()
+		Expect(err).To(BeNil())
+		Expect(ctrPath).To(Equal("/kubepods.slice/kubepods-pod123.slice/cri-containerd-ctr0.scope"))
+
+		_, err = (&api.Container{Id: "ctr1"}).CgroupPath()
+		Expect(err).ToNot(BeNil())
+
+		Expect(api.CgroupFsPath("", "", ctrPath)).To(Equal(
+			"/sys/fs/cgroup/kubepods.slice/kubepods-pod123.slice/cri-containerd-ctr0.scope"))
+		Expect(api.CgroupFsPath("/cgroup", "memory", podPath)).To(Equal(
+			"/cgroup/memory/kubepods.slice/kubepods-pod123.slice"))
+	})
+
+	It("should detect the cgroup v2 unified hierarchy", func() {
+		mount := GinkgoT().TempDir()
+		Expect(api.IsCgroup2UnifiedMode(mount)).To(BeFalse())
+		Expect(os.WriteFile(filepath.Join(mount, "cgroup.controllers"), nil, 0644)).To(Succeed())
+		Expect(api.IsCgroup2UnifiedMode(mount)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Resource quantity helpers", func() {
+	DescribeTable("should parse CPU quantities",
+		func(quantity string, expected int64, shouldFail bool) {
+			milliCPU, err := api.ParseCPUQuantity(quantity)
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(milliCPU).To(Equal(expected))
+		},
+		Entry("milliCPUs", "500m", int64(500), false),
+		Entry("whole CPUs", "2", int64(2000), false),
+		Entry("fractional CPUs", "1.5", int64(1500), false),
+		Entry("fractions of milliCPUs", "100u", int64(1), false),
+		Entry("exponents", "1e-1", int64(100), false),
+		Entry("negative quantities", "-1", int64(0), true),
+		Entry("invalid suffixes", "1x", int64(0), true),
+	)
+
+	DescribeTable("should parse memory quantities",
+		func(quantity string, expected int64, shouldFail bool) {
+			bytes, err := api.ParseMemoryQuantity(quantity)
+			if shouldFail {
+				Expect(err).ToNot(BeNil())
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(bytes).To(Equal(expected))
+		},
+		Entry("bytes", "4096", int64(4096), false),
+		Entry("binary suffixes", "128Mi", int64(128<<20), false),
+		Entry("fractional binary suffixes", "1.5Gi", int64(3<<29), false),
+		Entry("decimal suffixes", "1G", int64(1000000000), false),
+		Entry("exa suffixes", "1E", int64(1000000000000000000), false),
+		Entry("exbi suffixes", "1Ei", int64(1<<60), false),
+		Entry("exponents", "2e3", int64(2000), false),
+		Entry("out of range quantities", "16Ei", int64(0), true),
+		Entry("empty quantities", "", int64(0), true),
+	)
+
+	It("should convert between milliCPUs and CPU shares", func() {
+		Expect(api.MilliCPUToShares(0)).To(Equal(api.MinCPUShares))
+		Expect(api.MilliCPUToShares(1)).To(Equal(api.MinCPUShares))
+		Expect(api.MilliCPUToShares(500)).To(Equal(uint64(512)))
+		Expect(api.MilliCPUToShares(1000)).To(Equal(uint64(1024)))
+		Expect(api.MilliCPUToShares(1000000)).To(Equal(api.MaxCPUShares))
+		Expect(api.SharesToMilliCPU(2)).To(Equal(int64(0)))
+		Expect(api.SharesToMilliCPU(512)).To(Equal(int64(500)))
+		Expect(api.SharesToMilliCPU(1024)).To(Equal(int64(1000)))
+		Expect(api.SharesToMilliCPU(api.MilliCPUToShares(250))).To(Equal(int64(250)))
+	})
+
+	It("should convert between milliCPUs and CFS quota", func() {
+		Expect(api.MilliCPUToQuota(0, 0)).To(Equal(int64(0)))
+		Expect(api.MilliCPUToQuota(500, 0)).To(Equal(int64(50000)))
+		Expect(api.MilliCPUToQuota(1500, 200000)).To(Equal(int64(300000)))
+		Expect(api.MilliCPUToQuota(1, 0)).To(Equal(api.MinCPUQuota))
+		Expect(api.QuotaToMilliCPU(-1, 0)).To(Equal(int64(0)))
+		Expect(api.QuotaToMilliCPU(50000, 0)).To(Equal(int64(500)))
+		Expect(api.QuotaToMilliCPU(300000, 200000)).To(Equal(int64(1500)))
+	})
+})
+
+var _ = Describe("Plugin configuration helpers", func() {
+	DescribeTable("should detect configuration formats",
+		func(path, expected string) {
+			Expect(api.ConfigTypeForFile(path)).To(Equal(expected))
+		},
+		Entry("traditional configuration", "/etc/nri/conf.d/10-test.conf", ""),
+		Entry("YAML configuration", "/etc/nri/conf.d/10-test.yaml", api.ConfigTypeYAML),
+		Entry("short YAML configuration", "/etc/nri/conf.d/test.yml", api.ConfigTypeYAML),
+		Entry("JSON configuration", "/etc/nri/conf.d/test.JSON", api.ConfigTypeJSON),
+		Entry("TOML configuration", "/etc/nri/conf.d/test.toml", api.ConfigTypeTOML),
+		Entry("HCL configuration", "/etc/nri/conf.d/test.hcl", api.ConfigTypeHCL),
+		Entry("unknown configuration", "/etc/nri/conf.d/test.ini", ""),
+	)
+})
+
+var _ = Describe("Object diff helpers", func() {
+	It("should report no changes for equal objects", func() {
+		ctr := &api.Container{
+			Id:   "ctr0",
+			Name: "ctr0",
+		}
+		changes, err := api.Diff(ctr, proto.Clone(ctr))
+		Expect(err).To(BeNil())
+		Expect(changes).To(BeEmpty())
+		Expect(changes.String()).To(Equal("<no changes>"))
+	})
+
+	It("should diff original and adjusted containers", func() {
+		var (
+			orig = &api.Container{
+				Id:   "ctr0",
+				Name: "ctr0",
+				Annotations: map[string]string{
+					"example.com/keep":   "value",
+					"example.com/remove": "value",
+				},
+				Env: []string{"A=1", "B=2"},
+				Linux: &api.LinuxContainer{
+					Resources: &api.LinuxResources{
+						Cpu: &api.LinuxCPU{
+							Shares: api.UInt64(512),
+						},
+					},
+				},
+			}
+			updated = &api.Container{
+				Id:   "ctr0",
+				Name: "ctr0",
+				Annotations: map[string]string{
+					"example.com/keep": "value",
+					"example.com/add":  "value",
+				},
+				Env: []string{"A=1"},
+				Linux: &api.LinuxContainer{
+					Resources: &api.LinuxResources{
+						Cpu: &api.LinuxCPU{
+							Shares: api.UInt64(1024),
+						},
+					},
+				},
+			}
+		)
+
+		changes, err := api.Diff(orig, updated)
+		Expect(err).To(BeNil())
+		Expect(changes.String()).To(Equal(strings.Join([]string{
+			`add /annotations/example.com~1add: "value"`,
+			`remove /annotations/example.com~1remove: "value"`,
+			`remove /env/1: "B=2"`,
+			`replace /linux/resources/cpu/shares/value: "512" -> "1024"`,
+		}, "\n")))
+
+		patch, err := changes.JSONPatch()
+		Expect(err).To(BeNil())
+		Expect(string(patch)).To(Equal(`[` +
+			`{"op":"add","path":"/annotations/example.com~1add","value":"value"},` +
+			`{"op":"remove","path":"/annotations/example.com~1remove"},` +
+			`{"op":"remove","path":"/env/1"},` +
+			`{"op":"replace","path":"/linux/resources/cpu/shares/value","value":"1024"}` +
+			`]`))
+	})
+
+	It("should treat nil objects as empty", func() {
+		changes, err := api.Diff(nil, &api.PodSandbox{Id: "pod0"})
+		Expect(err).To(BeNil())
+		Expect(changes.String()).To(Equal(`add /id: "pod0"`))
+
+		changes, err = api.Diff((*api.PodSandbox)(nil), nil)
+		Expect(err).To(BeNil())
+		Expect(changes).To(BeEmpty())
+
+		patch, err := api.Changes(nil).JSONPatch()
+		Expect(err).To(BeNil())
+		Expect(string(patch)).To(Equal("[]"))
+	})
+})
+
+var _ = Describe("Adjustment merge helpers", func() {
+	var (
+		first = func() *api.ContainerAdjustment {
+			a := &api.ContainerAdjustment{}
+			a.AddAnnotation("first", "value")
+			a.AddAnnotation("shared", "first")
+			a.AddEnv("SHARED", "first")
+			a.AddMount(&api.Mount{Destination: "/data", Source: "/first", Type: "bind"})
+			a.SetLinuxCPUShares(512)
+			a.AddLinuxSysctl("net.core.somaxconn", "1024")
+			a.AddHooks(&api.Hooks{Prestart: []*api.Hook{{Path: "/bin/first"}}})
+			return a
+		}
+		second = func() *api.ContainerAdjustment {
+			a := &api.ContainerAdjustment{}
+			a.AddAnnotation("second", "value")
+			a.AddAnnotation("shared", "second")
+			a.AddEnv("SHARED", "second")
+			a.AddMount(&api.Mount{Destination: "/data", Source: "/second", Type: "bind"})
+			a.SetLinuxCPUShares(1024)
+			a.SetLinuxMemoryLimit(1 << 30)
+			a.AddLinuxSysctl("net.core.somaxconn", "1024")
+			a.AddHooks(&api.Hooks{Prestart: []*api.Hook{{Path: "/bin/second"}}})
+			return a
+		}
+	)
+
+	It("should merge non-conflicting adjustments", func() {
+		a := &api.ContainerAdjustment{}
+		a.AddAnnotation("foo", "bar")
+		a.SetLinuxCPUShares(512)
+		b := &api.ContainerAdjustment{}
+		b.AddAnnotation("xyzzy", "plugh")
+		b.SetLinuxCPUShares(512)
+		b.SetLinuxMemoryLimit(1 << 30)
+
+		merged, err := api.MergeAdjustments(api.MergeFailOnConflict, a, nil, b)
+		Expect(err).To(BeNil())
+
+		expected := &api.ContainerAdjustment{}
+		expected.AddAnnotation("foo", "bar")
+		expected.AddAnnotation("xyzzy", "plugh")
+		expected.SetLinuxCPUShares(512)
+		expected.SetLinuxMemoryLimit(1 << 30)
+		Expect(proto.Equal(merged, expected)).Should(BeTrue())
+	})
+
+	It("should fail to merge conflicting adjustments", func() {
+		_, err := api.MergeAdjustments(api.MergeFailOnConflict, first(), second())
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("should keep the first conflicting values", func() {
+		merged, err := api.MergeAdjustments(api.MergeFirstWins, first(), second())
+		Expect(err).To(BeNil())
+		Expect(merged.Annotations).To(Equal(map[string]string{
+			"first":  "value",
+			"second": "value",
+			"shared": "first",
+		}))
+		Expect(merged.Env).To(HaveLen(1))
+		Expect(merged.Env[0].Value).To(Equal("first"))
+		Expect(merged.Mounts).To(HaveLen(1))
+		Expect(merged.Mounts[0].Source).To(Equal("/first"))
+		Expect(merged.Linux.Resources.Cpu.Shares.GetValue()).To(Equal(uint64(512)))
+		Expect(merged.Linux.Resources.Memory.Limit.GetValue()).To(Equal(int64(1 << 30)))
+		Expect(merged.Linux.Sysctl).To(Equal(map[string]string{"net.core.somaxconn": "1024"}))
+		Expect(merged.Hooks.Prestart).To(HaveLen(2))
+	})
+
+	It("should keep the last conflicting values", func() {
+		merged, err := api.MergeAdjustments(api.MergeLastWins, first(), second())
+		Expect(err).To(BeNil())
+		Expect(merged.Annotations["shared"]).To(Equal("second"))
+		Expect(merged.Env).To(HaveLen(1))
+		Expect(merged.Env[0].Value).To(Equal("second"))
+		Expect(merged.Mounts).To(HaveLen(1))
+		Expect(merged.Mounts[0].Source).To(Equal("/second"))
+		Expect(merged.Linux.Resources.Cpu.Shares.GetValue()).To(Equal(uint64(1024)))
+	})
+
+	It("should handle removal and set-if-absent markers", func() {
+		a := &api.ContainerAdjustment{}
+		a.RemoveAnnotation("foo")
+		a.AddEnvIfAbsent("FOO", "a")
+		b := &api.ContainerAdjustment{}
+		b.AddAnnotation("foo", "bar")
+		b.AddEnv("FOO", "b")
+
+		_, err := api.MergeAdjustments(api.MergeFailOnConflict, a, b)
+		Expect(err).ToNot(BeNil())
+
+		merged, err := api.MergeAdjustments(api.MergeLastWins, a, b)
+		Expect(err).To(BeNil())
+		Expect(merged.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+		Expect(merged.Env).To(HaveLen(1))
+		Expect(merged.Env[0].Key).To(Equal("FOO"))
+		Expect(merged.Env[0].Value).To(Equal("b"))
+	})
+
+	It("should not modify the merged adjustments", func() {
+		a, b := first(), second()
+		_, err := api.MergeAdjustments(api.MergeLastWins, a, b)
+		Expect(err).To(BeNil())
+		Expect(proto.Equal(a, first())).Should(BeTrue())
+		Expect(proto.Equal(b, second())).Should(BeTrue())
+	})
+})
+
+var _ = Describe("Generated deep copy and equality helpers", func() {
+	It("should deep copy and compare objects", func() {
+		pod := &api.PodSandbox{
+			Id:   "pod0",
+			Name: "pod0",
+			Labels: map[string]string{
+				"app": "test",
+			},
+			Linux: &api.LinuxPodSandbox{
+				CgroupParent: "/kubepods/pod0",
+			},
+		}
+
+		cp := pod.DeepCopy()
+		Expect(cp).ToNot(BeIdenticalTo(pod))
+		Expect(cp.Equal(pod)).To(BeTrue())
+
+		cp.Labels["app"] = "changed"
+		cp.Linux.CgroupParent = "/kubepods/pod1"
+		Expect(cp.Equal(pod)).To(BeFalse())
+		Expect(pod.Labels["app"]).To(Equal("test"))
+		Expect(pod.Linux.CgroupParent).To(Equal("/kubepods/pod0"))
+	})
+
+	It("should handle nil objects", func() {
+		var ctr *api.Container
+		Expect(ctr.DeepCopy()).To(BeNil())
+		Expect(ctr.Equal(nil)).To(BeTrue())
+		Expect(ctr.Equal(&api.Container{})).To(BeFalse())
+	})
+})
+
+var _ = Describe("Plugin compression", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should pass large messages",
+		func(runtimeCompress, pluginCompress int) {
+			var (
+				ctx = context.Background()
+
+				large = strings.Repeat("a fairly compressible annotation value, ", 4096)
+
+				pod = &api.PodSandbox{
+					Id:        "pod0",
+					Name:      "pod0",
+					Uid:       "uid0",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"large": large,
+					},
+				}
+				ctr = &api.Container{
+					Id:           "ctr0",
+					PodSandboxId: "pod0",
+					Name:         "ctr0",
+					State:        api.ContainerState_CONTAINER_CREATED,
+				}
+
+				annotate = func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+					a := &api.ContainerAdjustment{}
+					a.AddAnnotation(p.name, pod.Annotations["large"])
+					return a, nil, nil
+				}
+			)
+
+			s.Prepare(
+				&mockRuntime{
+					options: []nri.Option{
+						nri.WithCompression(runtimeCompress),
+					},
+				},
+				&mockPlugin{idx: "00", name: "foo", compress: pluginCompress, createContainer: annotate},
+			)
+
+			s.Startup()
+
+			rpl, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			Expect(err).To(BeNil())
+			Expect(rpl.Adjust.Annotations).To(Equal(map[string]string{
+				"foo": large,
+			}))
+		},
+		Entry("without compression", 0, 0),
+		Entry("with runtime compression", 1024, 0),
+		Entry("with plugin compression", 0, 1024),
+		Entry("with runtime and plugin compression", 1024, 1024),
+	)
+})
+
+var _ = Describe("Plugin stats queries", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+		ctr = &api.Container{
+			Id:           "ctr0",
+			PodSandboxId: "pod0",
+			Name:         "ctr0",
+			State:        api.ContainerState_CONTAINER_CREATED,
+		}
+
+		getStats = func(ctx context.Context, id string) (*api.Stats, error) {
+			if id != "pod0" && id != "ctr0" {
+				return nil, fmt.Errorf("unknown container or pod %q", id)
+			}
+			return &api.Stats{
+				Id: id,
+				Cpu: &api.CPUStats{
+					UsageNanoseconds: 1000,
+				},
+				Memory: &api.MemoryStats{
+					UsageBytes: 4096,
+				},
+				Network: []*api.NetworkStats{
+					{
+						Name:    "eth0",
+						RxBytes: 100,
+						TxBytes: 200,
+					},
+				},
+			}, nil
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	DescribeTable("should be relayed to the runtime",
+		func(transport api.Transport) {
+			var (
+				ctx   = context.Background()
+				stats *api.Stats
+			)
+
+			s.Prepare(
+				&mockRuntime{
+					options: []nri.Option{
+						nri.WithStatsFn(getStats),
+					},
+				},
+				&mockPlugin{
+					idx:       "00",
+					name:      "qos",
+					transport: transport,
+					createContainer: func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+						var err error
+						stats, err = p.stub.GetStats(pod.Id)
+						return nil, nil, err
+					},
+				},
+			)
+
+			s.Startup()
+
+			_, err := s.runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			Expect(err).To(BeNil())
+			Expect(stats.Id).To(Equal("pod0"))
+
+			stats, err = s.plugins[0].stub.GetStats("ctr0")
+			Expect(err).To(BeNil())
+			Expect(stats.Id).To(Equal("ctr0"))
+			Expect(stats.Cpu.UsageNanoseconds).To(Equal(uint64(1000)))
+			Expect(stats.Memory.UsageBytes).To(Equal(uint64(4096)))
+			Expect(len(stats.Network)).To(Equal(1))
+			Expect(stats.Network[0].Name).To(Equal("eth0"))
+			Expect(stats.Network[0].RxBytes).To(Equal(uint64(100)))
+			Expect(stats.Network[0].TxBytes).To(Equal(uint64(200)))
+
+			_, err = s.plugins[0].stub.GetStats("ctr1")
+			Expect(err).ToNot(BeNil())
+		},
+		Entry("with ttRPC", api.TransportTTRPC),
+		Entry("with gRPC", api.TransportGRPC),
+	)
+
+	It("should fail if the runtime does not provide stats", func() {
+		s.Prepare(&mockRuntime{}, &mockPlugin{idx: "00", name: "qos"})
+		s.Startup()
+
+		_, err := s.plugins[0].stub.GetStats("ctr0")
+		Expect(err).ToNot(BeNil())
+	})
+})
+
+var _ = Describe("Plugin container restart requests", func() {
+	var (
+		s = &Suite{}
+
+		restarted []*api.RestartContainerRequest
+		restartFn = func(ctx context.Context, plugin string, req *api.RestartContainerRequest) error {
+			if plugin != "00-remedy" {
+				return fmt.Errorf("plugin %s is not authorized to restart containers", plugin)
+			}
+			restarted = append(restarted, req)
+			return nil
+		}
+	)
+
+	BeforeEach(func() {
+		restarted = nil
+	})
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be relayed to the runtime if authorized", func() {
+		s.Prepare(
+			&mockRuntime{
+				options: []nri.Option{
+					nri.WithRestartFn(restartFn),
+				},
+			},
+			&mockPlugin{idx: "00", name: "remedy"},
+			&mockPlugin{idx: "10", name: "other"},
+		)
+		s.Startup()
+
+		Expect(s.plugins[0].stub.RestartContainer("ctr0", "broken network", false)).To(Succeed())
+		Expect(s.plugins[0].stub.RestartContainer("ctr1", "broken network", true)).To(Succeed())
+		Expect(len(restarted)).To(Equal(2))
+		Expect(restarted[0].ContainerId).To(Equal("ctr0"))
+		Expect(restarted[0].Reason).To(Equal("broken network"))
+		Expect(restarted[0].Stop).To(BeFalse())
+		Expect(restarted[1].ContainerId).To(Equal("ctr1"))
+		Expect(restarted[1].Stop).To(BeTrue())
+
+		Expect(s.plugins[0].stub.RestartContainer("ctr0", "", false)).ToNot(Succeed())
+		Expect(s.plugins[1].stub.RestartContainer("ctr0", "broken network", false)).ToNot(Succeed())
+		Expect(len(restarted)).To(Equal(2))
+	})
+
+	It("should fail if the runtime does not allow restarts", func() {
+		s.Prepare(&mockRuntime{}, &mockPlugin{idx: "00", name: "remedy"})
+		s.Startup()
+
+		Expect(s.plugins[0].stub.RestartContainer("ctr0", "broken network", false)).ToNot(Succeed())
+	})
+})
+
+var _ = Describe("Plugin network readiness", func() {
+	var (
+		s = &Suite{}
+
+		reported []bool
+		readyFn  = func(_ context.Context, ready bool, _, _ string) {
+			reported = append(reported, ready)
+		}
+	)
+
+	BeforeEach(func() {
+		reported = nil
+	})
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should combine the readiness reported by plugins", func() {
+		s.Prepare(
+			&mockRuntime{
+				options: []nri.Option{
+					nri.WithNetworkReadyFn(readyFn),
+				},
+			},
+			&mockPlugin{idx: "00", name: "sdn"},
+			&mockPlugin{idx: "10", name: "ipam"},
+		)
+		s.Startup()
+
+		runtime := s.runtime.runtime
+		ready, _, _ := runtime.NetworkReady()
+		Expect(ready).To(BeTrue())
+
+		Expect(s.plugins[1].stub.SetNetworkReadiness(false, "IPPoolExhausted", "no free addresses")).To(Succeed())
+		Expect(s.plugins[0].stub.SetNetworkReadiness(false, "AgentDown", "SDN agent is down")).To(Succeed())
+		ready, reason, message := runtime.NetworkReady()
+		Expect(ready).To(BeFalse())
+		Expect(reason).To(Equal("AgentDown"))
+		Expect(message).To(Equal("plugin 00-sdn: SDN agent is down; plugin 10-ipam: no free addresses"))
+
+		Expect(s.plugins[0].stub.SetNetworkReadiness(false, "", "")).ToNot(Succeed())
+		Expect(s.plugins[0].stub.SetNetworkReadiness(true, "", "")).To(Succeed())
+		ready, reason, _ = runtime.NetworkReady()
+		Expect(ready).To(BeFalse())
+		Expect(reason).To(Equal("IPPoolExhausted"))
+		Expect(reported).To(Equal([]bool{false, false, false}))
+
+		s.plugins[1].Stop()
+		Eventually(func() bool {
+			ready, _, _ := runtime.NetworkReady()
+			return ready
+		}).Should(BeTrue())
+	})
+
+	It("should let the runtime query the readiness from its callback", func() {
+		var (
+			runtime *nri.Adaptation
+			queried = make(chan string, 4)
+		)
+
+		s.Prepare(
+			&mockRuntime{
+				options: []nri.Option{
+					nri.WithNetworkReadyFn(func(context.Context, bool, string, string) {
+						_, reason, _ := runtime.NetworkReady()
+						queried <- reason
+					}),
+				},
+			},
+			&mockPlugin{idx: "00", name: "sdn"},
+		)
+		s.Startup()
+		runtime = s.runtime.runtime
+
+		Expect(s.plugins[0].stub.SetNetworkReadiness(false, "AgentDown", "SDN agent is down")).To(Succeed())
+		Eventually(queried).Should(Receive(Equal("AgentDown")))
+
+		s.plugins[0].Stop()
+		Eventually(queried).Should(Receive(Equal("")))
+	})
+})
+
+var _ = Describe("Plugin invocation order", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	When("there are multiple plugins", func() {
+		BeforeEach(func() {
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{idx: "20", name: "qos"},
+				&mockPlugin{idx: "10", name: "ipam", mask: api.MustParseEventMask("RunPodSandbox")},
+			)
+		})
+
+		It("should list plugins in invocation order", func() {
+			var (
+				plugins []*api.PluginInfo
+				err     error
+			)
+
+			s.Startup()
+
+			Eventually(func() int {
+				plugins, err = s.plugins[0].stub.ListPlugins()
+				Expect(err).To(BeNil())
+				return len(plugins)
+			}).Should(Equal(2))
+			Expect(plugins[0].FullName()).To(Equal("10-ipam"))
+			Expect(plugins[0].EventMask()).To(Equal(api.MustParseEventMask("RunPodSandbox")))
+			Expect(plugins[1].FullName()).To(Equal("20-qos"))
+			Expect(plugins[1].EventMask()).To(Equal(api.ValidEvents))
+
+			Expect(api.CheckPluginOrder(plugins, "ipam", "20-qos")).To(Succeed())
+			Expect(api.CheckPluginOrder(plugins, "qos", "ipam")).ToNot(Succeed())
+			Expect(api.CheckPluginOrder(plugins, "ipam", "dns")).ToNot(Succeed())
+		})
+	})
+})
+
+var _ = Describe("Plugin pod data", func() {
+	var (
+		s = &Suite{}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	When("there are multiple plugins", func() {
+		BeforeEach(func() {
+			s.Prepare(
+				&mockRuntime{},
+				&mockPlugin{idx: "00", name: "foo"},
+				&mockPlugin{idx: "10", name: "bar"},
+			)
+		})
+
+		It("should pass data to later plugins", func() {
+			var (
+				runtime = s.runtime
+				plugins = s.plugins
+				ctx     = context.Background()
+
+				pod = &api.PodSandbox{
+					Id:        "pod0",
+					Name:      "pod0",
+					Uid:       "uid0",
+					Namespace: "default",
+				}
+				ctr = &api.Container{
+					Id:           "ctr0",
+					PodSandboxId: "pod0",
+					Name:         "ctr0",
+					State:        api.ContainerState_CONTAINER_CREATED,
+				}
+
+				created, removed string
+			)
+
+			plugins[0].runPodSandbox = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+				pod.SetPluginData("pool", "pool0")
+				return nil
+			}
+			plugins[0].removeContainer = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+				pod.RemovePluginData("pool")
+				return nil
+			}
+			plugins[1].createContainer = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				created, _ = pod.LookupPluginData("pool")
+				return nil, nil, nil
+			}
+			plugins[1].stopPodSandbox = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+				removed, _ = pod.LookupPluginData("pool")
+				return nil
+			}
+
+			s.Startup()
+			Expect(runtime.startStopPodAndContainer(ctx, pod, ctr)).To(Succeed())
+			Expect(created).To(Equal("pool0"))
+			Expect(removed).To(Equal(""))
+		})
 
-	When("there are multiple plugins", func() {
-		BeforeEach(func() {
-			s.Prepare(
-				&mockRuntime{},
-				&mockPlugin{idx: "10", name: "foo"},
-				&mockPlugin{idx: "00", name: "bar"},
+		It("should reject conflicting data", func() {
+			var (
+				runtime = s.runtime
+				plugins = s.plugins
+				ctx     = context.Background()
+
+				pod = &api.PodSandbox{
+					Id:        "pod0",
+					Name:      "pod0",
+					Uid:       "uid0",
+					Namespace: "default",
+				}
+				ctr = &api.Container{
+					Id:           "ctr0",
+					PodSandboxId: "pod0",
+					Name:         "ctr0",
+					State:        api.ContainerState_CONTAINER_CREATED,
+				}
 			)
+
+			plugins[0].runPodSandbox = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+				pod.SetPluginData("pool", "pool0")
+				return nil
+			}
+			plugins[1].createContainer = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				pod.SetPluginData("pool", "pool1")
+				return nil, nil, nil
+			}
+
+			s.Startup()
+			Expect(runtime.runtime.RunPodSandbox(ctx, &api.StateChangeEvent{
+				Pod: pod,
+			})).To(Succeed())
+			_, err := runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			Expect(err).ToNot(BeNil())
 		})
 
-		DescribeTable("should fail with conflicts, successfully collected otherwise",
-			func(subject string, which string, expected *api.ContainerUpdate) {
-				var (
-					runtime = s.runtime
-					plugins = s.plugins
-					ctx     = context.Background()
+		It("should leave data untouched by rejected changes", func() {
+			var (
+				runtime = s.runtime
+				plugins = s.plugins
+				ctx     = context.Background()
 
-					pod0 = &api.PodSandbox{
-						Id:        "pod0",
-						Name:      "pod0",
-						Uid:       "uid0",
-						Namespace: "default",
-					}
-					ctr0 = &api.Container{
-						Id:           "ctr0",
-						PodSandboxId: "pod0",
-						Name:         "ctr0",
-						State:        api.ContainerState_CONTAINER_CREATED, // XXX FIXME-kludge
-					}
-					pod1 = &api.PodSandbox{
-						Id:        "pod1",
-						Name:      "pod1",
-						Uid:       "uid1",
-						Namespace: "default",
-					}
-					ctr1 = &api.Container{
-						Id:           "ctr1",
-						PodSandboxId: "pod1",
-						Name:         "ctr1",
-						State:        api.ContainerState_CONTAINER_CREATED, // XXX FIXME-kludge
-					}
+				pod = &api.PodSandbox{
+					Id:        "pod0",
+					Name:      "pod0",
+					Uid:       "uid0",
+					Namespace: "default",
+				}
+				ctr = &api.Container{
+					Id:           "ctr0",
+					PodSandboxId: "pod0",
+					Name:         "ctr0",
+					State:        api.ContainerState_CONTAINER_CREATED,
+				}
 
-					reply *api.CreateContainerResponse
-				)
+				seen map[string]string
+			)
 
-				create := func(p *mockPlugin, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
-					return update(subject, which, p, pod, ctr)
+			plugins[0].runPodSandbox = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+				pod.SetPluginData("pool", "pool0")
+				return nil
+			}
+			plugins[1].createContainer = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				for _, k := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+					pod.SetPluginData(k, "bar")
 				}
+				pod.SetPluginData("pool", "pool1")
+				return nil, nil, nil
+			}
+			plugins[1].startContainer = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+				seen = api.DupStringMap(pod.GetPluginData())
+				return nil
+			}
 
-				plugins[0].createContainer = create
-				plugins[1].createContainer = create
+			s.Startup()
+			Expect(runtime.runtime.RunPodSandbox(ctx, &api.StateChangeEvent{
+				Pod: pod,
+			})).To(Succeed())
+			_, err := runtime.runtime.CreateContainer(ctx, &api.CreateContainerRequest{
+				Pod:       pod,
+				Container: ctr,
+			})
+			Expect(err).ToNot(BeNil())
+			Expect(runtime.runtime.StartContainer(ctx, &api.StateChangeEvent{
+				Pod:       pod,
+				Container: ctr,
+			})).To(Succeed())
+			Expect(seen).To(Equal(map[string]string{"pool": "pool0"}))
+		})
 
-				s.Startup()
+		It("should drop the data of pods which fail to start", func() {
+			var (
+				runtime = s.runtime
+				plugins = s.plugins
+				ctx     = context.Background()
 
-				podReq := &api.RunPodSandboxRequest{Pod: pod0}
-				Expect(runtime.runtime.RunPodSandbox(ctx, podReq)).To(Succeed())
-				ctrReq := &api.CreateContainerRequest{
-					Pod:       pod0,
-					Container: ctr0,
+				pod = &api.PodSandbox{
+					Id:        "pod0",
+					Name:      "pod0",
+					Uid:       "uid0",
+					Namespace: "default",
 				}
-				_, err := runtime.runtime.CreateContainer(ctx, ctrReq)
-				Expect(err).To(BeNil())
 
-				podReq = &api.RunPodSandboxRequest{Pod: pod1}
-				Expect(runtime.runtime.RunPodSandbox(ctx, podReq)).To(Succeed())
-				ctrReq = &api.CreateContainerRequest{
-					Pod:       pod1,
-					Container: ctr1,
-				}
-				reply, err = runtime.runtime.CreateContainer(ctx, ctrReq)
-				if which == "both" {
-					Expect(err).ToNot(BeNil())
-				} else {
-					Expect(err).To(BeNil())
-					Expect(len(reply.Update)).To(Equal(1))
-					expected.ContainerId = reply.Update[0].ContainerId
-					Expect(stripUpdate(reply.Update[0])).Should(Equal(stripUpdate(expected)))
+				seen map[string]string
+				fail = true
+			)
+
+			plugins[0].runPodSandbox = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+				seen = api.DupStringMap(pod.GetPluginData())
+				pod.SetPluginData("pool", "pool0")
+				return nil
+			}
+			plugins[1].runPodSandbox = func(_ *mockPlugin, pod *api.PodSandbox, _ *api.Container) error {
+				if fail {
+					return fmt.Errorf("no network")
 				}
-			},
+				return nil
+			}
 
-			Entry("update CPU resources", "resources/cpu", "both", nil),
-			Entry("update CPU resources", "resources/cpu", "10-foo",
-				&api.ContainerUpdate{
-					Linux: &api.LinuxContainerUpdate{
-						Resources: &api.LinuxResources{
-							Cpu: &api.LinuxCPU{
-								Shares:          api.UInt64(123),
-								Quota:           api.Int64(456),
-								Period:          api.UInt64(789),
-								RealtimeRuntime: api.Int64(321),
-								RealtimePeriod:  api.UInt64(654),
-								Cpus:            "0-1",
-								Mems:            "2-3",
-							},
-						},
-					},
-				},
-			),
-			Entry("update memory resources", "resources/memory", "both", nil),
-			Entry("update memory resources", "resources/memory", "10-foo",
-				&api.ContainerUpdate{
-					Linux: &api.LinuxContainerUpdate{
-						Resources: &api.LinuxResources{
-							Memory: &api.LinuxMemory{
-								Limit:            api.Int64(1234000),
-								Reservation:      api.Int64(4000),
-								Swap:             api.Int64(34000),
-								Kernel:           api.Int64(30000),
-								KernelTcp:        api.Int64(2000),
-								Swappiness:       api.UInt64(987),
-								DisableOomKiller: api.Bool(true),
-								UseHierarchy:     api.Bool(true),
-							},
-						},
-					},
-				},
-			),
-			Entry("update class-based resources", "resources/classes", "both", nil),
-			Entry("update class-based resources", "resources/classes", "10-foo",
-				&api.ContainerUpdate{
-					Linux: &api.LinuxContainerUpdate{
-						Resources: &api.LinuxResources{
-							RdtClass:     api.String("10-foo"),
-							BlockioClass: api.String("10-foo"),
-						},
-					},
-				},
-			),
-			Entry("update hugepage limits", "resources/hugepagelimits", "both", nil),
-			Entry("update hugepage limits", "resources/hugepagelimits", "10-foo",
-				&api.ContainerUpdate{
-					Linux: &api.LinuxContainerUpdate{
-						Resources: &api.LinuxResources{
-							HugepageLimits: []*api.HugepageLimit{
-								{
-									PageSize: "1M",
-									Limit:    4096,
-								},
-								{
-									PageSize: "4M",
-									Limit:    1024,
-								},
-							},
-						},
-					},
-				},
-			),
-			Entry("update cgroupv2 unified resources", "resources/unified", "both", nil),
-			Entry("update cgroupv2 unified resources", "resources/unified", "10-foo",
-				&api.ContainerUpdate{
-					Linux: &api.LinuxContainerUpdate{
-						Resources: &api.LinuxResources{
-							Unified: map[string]string{
-								"resource.1": "value1",
-								"resource.2": "value2",
-							},
-						},
-					},
-				},
-			),
-		)
+			s.Startup()
+			Expect(runtime.runtime.RunPodSandbox(ctx, &api.StateChangeEvent{
+				Pod: pod,
+			})).ToNot(Succeed())
+			fail = false
+			Expect(runtime.runtime.RunPodSandbox(ctx, &api.StateChangeEvent{
+				Pod: pod,
+			})).To(Succeed())
+			Expect(seen).To(BeEmpty())
+		})
 	})
 })
 
@@ -1306,19 +6396,33 @@ func strip(obj interface{}, ptr interface{}) interface{} {
 
 func stripAdjustment(a *api.ContainerAdjustment) *api.ContainerAdjustment {
 	stripAnnotations(a)
+	stripLabels(a)
 	stripMounts(a)
 	stripEnv(a)
 	stripHooks(a)
+	stripCDIDevices(a)
 	stripLinuxAdjustment(a)
 	return a
 }
 
+func stripCDIDevices(a *api.ContainerAdjustment) {
+	if len(a.CDIDevices) == 0 {
+		a.CDIDevices = nil
+	}
+}
+
 func stripAnnotations(a *api.ContainerAdjustment) {
 	if len(a.Annotations) == 0 {
 		a.Annotations = nil
 	}
 }
 
+func stripLabels(a *api.ContainerAdjustment) {
+	if len(a.Labels) == 0 {
+		a.Labels = nil
+	}
+}
+
 func stripMounts(a *api.ContainerAdjustment) {
 	if len(a.Mounts) == 0 {
 		a.Mounts = nil
@@ -1352,12 +6456,20 @@ func stripLinuxAdjustment(a *api.ContainerAdjustment) {
 		return
 	}
 	stripLinuxDevices(a)
+	stripLinuxSysctl(a)
 	a.Linux.Resources = stripLinuxResources(a.Linux.Resources)
-	if a.Linux.Devices == nil && a.Linux.Resources == nil && a.Linux.CgroupsPath == "" {
+	if a.Linux.Devices == nil && a.Linux.Resources == nil && a.Linux.CgroupsPath == "" &&
+		a.Linux.SeccompProfile == nil && a.Linux.ApparmorProfile == nil && a.Linux.Sysctl == nil {
 		a.Linux = nil
 	}
 }
 
+func stripLinuxSysctl(a *api.ContainerAdjustment) {
+	if len(a.Linux.Sysctl) == 0 {
+		a.Linux.Sysctl = nil
+	}
+}
+
 func stripLinuxDevices(a *api.ContainerAdjustment) {
 	if len(a.Linux.Devices) == 0 {
 		a.Linux.Devices = nil
@@ -1387,6 +6499,10 @@ func stripLinuxResources(r *api.LinuxResources) *api.LinuxResources {
 		return r
 	case r.RdtClass.GetValue() != "":
 		return r
+	case r.RdtClosId.GetValue() != "":
+		return r
+	case r.Blockio != nil:
+		return r
 	}
 
 	return nil
@@ -1480,3 +6596,71 @@ func stripUpdateLinux(l *api.LinuxContainerUpdate) *api.LinuxContainerUpdate {
 
 	return l
 }
+
+var _ = Describe("Plugin pod connection tracking limit adjustments", func() {
+	var (
+		s = &Suite{}
+
+		pod = &api.PodSandbox{
+			Id:        "pod0",
+			Name:      "pod0",
+			Uid:       "uid0",
+			Namespace: "default",
+		}
+	)
+
+	AfterEach(func() {
+		s.Cleanup()
+	})
+
+	It("should be collected", func() {
+		ctx := context.Background()
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{
+				idx:  "00",
+				name: "foo",
+				adjustPodSandbox: func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+					a := &api.PodSandboxAdjustment{}
+					a.SetLinuxConntrackMax(4096)
+					return a, nil
+				},
+			},
+		)
+
+		s.Startup()
+
+		adjustment, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).To(BeNil())
+		Expect(adjustment.Linux.ConntrackMax).To(Equal(uint64(4096)))
+		Expect(adjustment.Scopes().IsSet(api.Scope_SCOPE_RESOURCES)).To(BeTrue())
+		Expect(api.ConntrackRuleset(adjustment.Linux.ConntrackMax)).To(
+			ContainSubstring("ct state new ct count over 4096 drop"))
+	})
+
+	It("should conflict", func() {
+		ctx := context.Background()
+
+		plugin := func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+			a := &api.PodSandboxAdjustment{}
+			a.SetLinuxConntrackMax(4096)
+			return a, nil
+		}
+
+		s.Prepare(
+			&mockRuntime{},
+			&mockPlugin{idx: "00", name: "foo", adjustPodSandbox: plugin},
+			&mockPlugin{idx: "10", name: "bar", adjustPodSandbox: plugin},
+		)
+
+		s.Startup()
+
+		_, err := s.runtime.runtime.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: pod,
+		})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,268 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package adaptationtest provides an in-process fake runtime for unit
+// testing NRI plugins without a real container runtime.
+//
+// The fake runtime listens on a socket in a directory of the caller's
+// choosing. Plugins connect to it like to any runtime, for instance by
+// creating their stub with stub.WithSocketPath(runtime.SocketPath()).
+// Tests then drive any sequence of pod and container lifecycle requests
+// and events using the embedded Adaptation, inspect the responses, and
+// simulate runtime restarts with Stop and Start.
+package adaptationtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	nri "github.com/containerd/nri/pkg/adaptation"
+	"github.com/containerd/nri/pkg/api"
+)
+
+const (
+	// DefaultName is the runtime name reported to plugins.
+	DefaultName = "adaptationtest"
+	// DefaultVersion is the runtime version reported to plugins.
+	DefaultVersion = "0.0.0"
+)
+
+// Runtime is a fake runtime which plugins under test can connect to.
+type Runtime struct {
+	lock sync.Mutex
+	*nri.Adaptation
+
+	dir     string
+	options []nri.Option
+	pods    map[string]*api.PodSandbox
+	ctrs    map[string]*api.Container
+	updates []*api.ContainerUpdate
+
+	// UpdateFn, if set, is called for unsolicited container updates
+	// requested by plugins, after they have been recorded.
+	UpdateFn nri.UpdateFn
+}
+
+// New creates a fake runtime using dir for its socket and plugin directories.
+// The given options are passed on to the underlying Adaptation.
+func New(dir string, options ...nri.Option) (*Runtime, error) {
+	for _, d := range []string{"plugins", "conf.d"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create fake runtime directory: %w", err)
+		}
+	}
+
+	return &Runtime{
+		dir:     dir,
+		options: options,
+		pods:    make(map[string]*api.PodSandbox),
+		ctrs:    make(map[string]*api.Container),
+	}, nil
+}
+
+// SocketPath returns the path of the socket plugins should connect to.
+func (r *Runtime) SocketPath() string {
+	return filepath.Join(r.dir, "nri.sock")
+}
+
+// Start the fake runtime, letting plugins connect to it.
+func (r *Runtime) Start() error {
+	if r.Adaptation != nil {
+		return errors.New("fake runtime already started")
+	}
+
+	options := append([]nri.Option{
+		nri.WithPluginPath(filepath.Join(r.dir, "plugins")),
+		nri.WithPluginConfigPath(filepath.Join(r.dir, "conf.d")),
+		nri.WithSocketPath(r.SocketPath()),
+	}, r.options...)
+
+	a, err := nri.New(DefaultName, DefaultVersion, r.synchronize, r.update, options...)
+	if err != nil {
+		return err
+	}
+	if err = a.Start(); err != nil {
+		return err
+	}
+
+	r.Adaptation = a
+	return nil
+}
+
+// Stop the fake runtime, disconnecting all plugins. The runtime can be
+// started again, which simulates a runtime restart.
+func (r *Runtime) Stop() {
+	if r.Adaptation != nil {
+		r.Adaptation.Stop()
+		r.Adaptation = nil
+	}
+}
+
+// WaitForPlugins waits until at least n plugins have connected and been
+// synchronized, or the timeout expires.
+func (r *Runtime) WaitForPlugins(n int, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if r.Adaptation != nil && len(r.ListPlugins()) >= n {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for %d plugins to connect", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// AddPod adds a pod to the fake runtime, to be passed to plugins during
+// synchronization.
+func (r *Runtime) AddPod(pod *api.PodSandbox) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.pods[pod.Id] = pod
+}
+
+// DeletePod deletes a pod from the fake runtime.
+func (r *Runtime) DeletePod(id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.pods, id)
+}
+
+// AddContainer adds a container to the fake runtime, to be passed to
+// plugins during synchronization.
+func (r *Runtime) AddContainer(ctr *api.Container) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.ctrs[ctr.Id] = ctr
+}
+
+// DeleteContainer deletes a container from the fake runtime.
+func (r *Runtime) DeleteContainer(id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.ctrs, id)
+}
+
+// Updates returns the unsolicited container updates requested by plugins.
+func (r *Runtime) Updates() []*api.ContainerUpdate {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]*api.ContainerUpdate(nil), r.updates...)
+}
+
+// RunPodAndContainer relays the full sequence of requests and events for
+// creating and starting a pod and a container in it to plugins, adding them
+// to the fake runtime. It returns the adjustments requested by plugins.
+func (r *Runtime) RunPodAndContainer(ctx context.Context, pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, error) {
+	if r.Adaptation == nil {
+		return nil, errors.New("fake runtime not started")
+	}
+
+	if err := r.RunPodSandbox(ctx, &api.StateChangeEvent{Pod: pod}); err != nil {
+		return nil, err
+	}
+	r.AddPod(pod)
+
+	rpl, err := r.CreateContainer(ctx, &api.CreateContainerRequest{
+		Pod:       pod,
+		Container: ctr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.AddContainer(ctr)
+
+	for _, fn := range []func(context.Context, *api.StateChangeEvent) error{
+		r.PostCreateContainer,
+		r.StartContainer,
+		r.PostStartContainer,
+	} {
+		if err = fn(ctx, &api.StateChangeEvent{Pod: pod, Container: ctr}); err != nil {
+			return nil, err
+		}
+	}
+
+	return rpl.GetAdjust(), nil
+}
+
+// StopPodAndContainer relays the full sequence of requests and events for
+// stopping and removing a container and its pod to plugins, removing them
+// from the fake runtime.
+func (r *Runtime) StopPodAndContainer(ctx context.Context, pod *api.PodSandbox, ctr *api.Container) error {
+	if r.Adaptation == nil {
+		return errors.New("fake runtime not started")
+	}
+
+	if _, err := r.StopContainer(ctx, &api.StopContainerRequest{
+		Pod:       pod,
+		Container: ctr,
+	}); err != nil {
+		return err
+	}
+
+	for _, fn := range []func(context.Context, *api.StateChangeEvent) error{
+		r.Adaptation.RemoveContainer,
+		r.StopPodSandbox,
+		r.RemovePodSandbox,
+	} {
+		if err := fn(ctx, &api.StateChangeEvent{Pod: pod, Container: ctr}); err != nil {
+			return err
+		}
+	}
+
+	r.DeleteContainer(ctr.Id)
+	r.DeletePod(pod.Id)
+
+	return nil
+}
+
+func (r *Runtime) synchronize(ctx context.Context, cb nri.SyncCB) error {
+	r.lock.Lock()
+	var (
+		pods = make([]*api.PodSandbox, 0, len(r.pods))
+		ctrs = make([]*api.Container, 0, len(r.ctrs))
+	)
+	for _, pod := range r.pods {
+		pods = append(pods, pod)
+	}
+	for _, ctr := range r.ctrs {
+		ctrs = append(ctrs, ctr)
+	}
+	r.lock.Unlock()
+
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Id < pods[j].Id })
+	sort.Slice(ctrs, func(i, j int) bool { return ctrs[i].Id < ctrs[j].Id })
+
+	_, err := cb(ctx, pods, ctrs)
+	return err
+}
+
+func (r *Runtime) update(ctx context.Context, updates []*api.ContainerUpdate) ([]*api.ContainerUpdate, error) {
+	r.lock.Lock()
+	r.updates = append(r.updates, updates...)
+	r.lock.Unlock()
+
+	if r.UpdateFn != nil {
+		return r.UpdateFn(ctx, updates)
+	}
+	return nil, nil
+}
@@ -0,0 +1,274 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptationtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+const (
+	// UpdateGoldenEnvVar, if set to a non-empty value, makes CheckGolden
+	// write the results it is given to the golden file instead of checking
+	// them against it.
+	UpdateGoldenEnvVar = "NRI_UPDATE_GOLDEN"
+)
+
+// Trace is a recorded sequence of NRI requests and events, together with
+// the pods and containers which existed before the first one. Traces are
+// stored as YAML or JSON files.
+type Trace struct {
+	// Pods existing before the trace, passed to plugins in synchronization.
+	Pods []*api.PodSandbox `json:"pods,omitempty"`
+	// Containers existing before the trace.
+	Containers []*api.Container `json:"containers,omitempty"`
+	// Steps of the trace, in order.
+	Steps []*TraceStep `json:"steps"`
+}
+
+// TraceStep is a single request or event in a trace.
+type TraceStep struct {
+	// Time the request or event was recorded at, if known.
+	Time *time.Time `json:"time,omitempty"`
+	// Event is the name of the request or event, like CreateContainer.
+	Event string `json:"event"`
+	// Pod the request or event is for.
+	Pod *api.PodSandbox `json:"pod,omitempty"`
+	// Container the request or event is for, if any.
+	Container *api.Container `json:"container,omitempty"`
+	// Resources are the requested new resources for UpdateContainer.
+	Resources *api.LinuxResources `json:"resources,omitempty"`
+}
+
+// TraceResult is the combined plugin response to a trace step.
+type TraceResult struct {
+	Event     string                    `json:"event"`
+	Pod       string                    `json:"pod,omitempty"`
+	Container string                    `json:"container,omitempty"`
+	PodAdjust *api.PodSandboxAdjustment `json:"podAdjust,omitempty"`
+	Adjust    *api.ContainerAdjustment  `json:"adjust,omitempty"`
+	Update    []*api.ContainerUpdate    `json:"update,omitempty"`
+	Evict     []*api.ContainerEviction  `json:"evict,omitempty"`
+	Error     string                    `json:"error,omitempty"`
+}
+
+// LoadTrace loads a trace from a YAML or JSON file.
+func LoadTrace(path string) (*Trace, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trace: %w", err)
+	}
+
+	t := &Trace{}
+	if err = yaml.UnmarshalStrict(data, t); err != nil {
+		return nil, fmt.Errorf("failed to parse trace %s: %w", path, err)
+	}
+
+	for i, step := range t.Steps {
+		if _, err = parseTraceEvent(step.Event); err != nil {
+			return nil, fmt.Errorf("invalid trace %s, step #%d: %w", path, i, err)
+		}
+	}
+
+	return t, nil
+}
+
+// InstallPlugin installs a plugin binary for the runtime to launch when it
+// starts, with the given index, name and configuration, if any. This lets
+// plugin binaries be tested over a real plugin connection.
+func (r *Runtime) InstallPlugin(idx, name, binary, config string) error {
+	if err := api.CheckPluginIndex(idx); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(binary)
+	if err != nil {
+		return fmt.Errorf("failed to read plugin binary: %w", err)
+	}
+	err = os.WriteFile(filepath.Join(r.dir, "plugins", idx+"-"+name), data, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to install plugin binary: %w", err)
+	}
+
+	if config != "" {
+		err = os.WriteFile(filepath.Join(r.dir, "conf.d", idx+"-"+name+".conf"), []byte(config), 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to install plugin configuration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// AddTraceState adds the pods and containers existing before a trace to the
+// runtime. This should be done before plugins connect to the runtime.
+func (r *Runtime) AddTraceState(t *Trace) {
+	for _, pod := range t.Pods {
+		r.AddPod(pod)
+	}
+	for _, ctr := range t.Containers {
+		r.AddContainer(ctr)
+	}
+}
+
+// Replay the steps of a trace against connected plugins, collecting the
+// combined plugin responses for each step. Failing steps are recorded in
+// the results and do not stop the replay.
+func (r *Runtime) Replay(ctx context.Context, t *Trace) ([]*TraceResult, error) {
+	if r.Adaptation == nil {
+		return nil, fmt.Errorf("fake runtime not started")
+	}
+
+	results := make([]*TraceResult, 0, len(t.Steps))
+	for i, step := range t.Steps {
+		event, err := parseTraceEvent(step.Event)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trace step #%d: %w", i, err)
+		}
+
+		result := &TraceResult{
+			Event:     step.Event,
+			Pod:       step.Pod.GetId(),
+			Container: step.Container.GetId(),
+		}
+		err = r.replayStep(ctx, event, step, result)
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (r *Runtime) replayStep(ctx context.Context, event api.Event, step *TraceStep, result *TraceResult) error {
+	switch event {
+	case api.Event_RUN_POD_SANDBOX:
+		adjust, err := r.RunPodSandboxWithAdjustment(ctx, &api.StateChangeEvent{
+			Pod: step.Pod,
+		})
+		if err != nil {
+			return err
+		}
+		result.PodAdjust = adjust
+		r.AddPod(step.Pod)
+
+	case api.Event_CREATE_CONTAINER:
+		rpl, err := r.CreateContainer(ctx, &api.CreateContainerRequest{
+			Pod:       step.Pod,
+			Container: step.Container,
+		})
+		if err != nil {
+			return err
+		}
+		result.Adjust = rpl.GetAdjust()
+		result.Update = rpl.GetUpdate()
+		result.Evict = rpl.GetEvict()
+		r.AddContainer(step.Container)
+
+	case api.Event_UPDATE_CONTAINER:
+		rpl, err := r.UpdateContainer(ctx, &api.UpdateContainerRequest{
+			Pod:            step.Pod,
+			Container:      step.Container,
+			LinuxResources: step.Resources,
+		})
+		if err != nil {
+			return err
+		}
+		result.Update = rpl.GetUpdate()
+		result.Evict = rpl.GetEvict()
+
+	case api.Event_STOP_CONTAINER:
+		rpl, err := r.StopContainer(ctx, &api.StopContainerRequest{
+			Pod:       step.Pod,
+			Container: step.Container,
+		})
+		if err != nil {
+			return err
+		}
+		result.Update = rpl.GetUpdate()
+
+	default:
+		err := r.StateChange(ctx, &api.StateChangeEvent{
+			Event:     event,
+			Pod:       step.Pod,
+			Container: step.Container,
+		})
+		if err != nil {
+			return err
+		}
+		switch event {
+		case api.Event_REMOVE_CONTAINER:
+			r.DeleteContainer(step.Container.GetId())
+		case api.Event_REMOVE_POD_SANDBOX:
+			r.DeletePod(step.Pod.GetId())
+		}
+	}
+
+	return nil
+}
+
+// CheckGolden checks trace results against a golden file. If the
+// UpdateGoldenEnvVar environment variable is set, the results are
+// written to the golden file instead.
+func CheckGolden(results []*TraceResult, path string) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal trace results: %w", err)
+	}
+	data = append(data, '\n')
+
+	if os.Getenv(UpdateGoldenEnvVar) != "" {
+		if err = os.WriteFile(path, data, 0o644); err != nil {
+			return fmt.Errorf("failed to update golden file: %w", err)
+		}
+		return nil
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read golden file: %w", err)
+	}
+	if !bytes.Equal(data, golden) {
+		return fmt.Errorf("trace results differ from golden file %s, got:\n%s", path, data)
+	}
+
+	return nil
+}
+
+// parseTraceEvent parses the name of a single request or event in a trace.
+func parseTraceEvent(name string) (api.Event, error) {
+	mask, err := api.ParseEventMask(name)
+	if err != nil {
+		return api.Event_UNKNOWN, err
+	}
+	for e := api.Event_UNKNOWN + 1; e < api.Event_LAST; e++ {
+		if mask == api.EventMask(1<<(e-1)) {
+			return e, nil
+		}
+	}
+	return api.Event_UNKNOWN, fmt.Errorf("trace step needs a single event, got %q", name)
+}
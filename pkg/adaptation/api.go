@@ -29,8 +29,30 @@ import (
 type (
 	RegisterPluginRequest    = api.RegisterPluginRequest
 	RegisterPluginResponse   = api.Empty
+	RegisterClientRequest    = api.RegisterClientRequest
+	RegisterClientResponse   = api.Empty
 	UpdateContainersRequest  = api.UpdateContainersRequest
 	UpdateContainersResponse = api.UpdateContainersResponse
+	ListPluginsRequest       = api.ListPluginsRequest
+	ListPluginsResponse      = api.ListPluginsResponse
+	PluginInfo               = api.PluginInfo
+	BuildInfo                = api.BuildInfo
+	PluginStats              = api.PluginStats
+	HookStats                = api.HookStats
+	GetStatsRequest          = api.GetStatsRequest
+	GetStatsResponse         = api.GetStatsResponse
+	RestartContainerRequest  = api.RestartContainerRequest
+	RestartContainerResponse = api.RestartContainerResponse
+
+	SetNetworkReadinessRequest  = api.SetNetworkReadinessRequest
+	SetNetworkReadinessResponse = api.SetNetworkReadinessResponse
+
+	DeliverCreateContainerResponseRequest  = api.DeliverCreateContainerResponseRequest
+	DeliverCreateContainerResponseResponse = api.Empty
+
+	SimulatePodRequest  = api.SimulatePodRequest
+	SimulatePodResponse = api.SimulatePodResponse
+	SimulatedRequest    = api.SimulatedRequest
 
 	ConfigureRequest    = api.ConfigureRequest
 	ConfigureResponse   = api.ConfigureResponse
@@ -60,47 +82,137 @@ type (
 	PostUpdateContainerRequest  = api.PostUpdateContainerRequest
 	PostUpdateContainerResponse = api.PostUpdateContainerResponse
 
-	PodSandbox               = api.PodSandbox
-	LinuxPodSandbox          = api.LinuxPodSandbox
-	Container                = api.Container
-	ContainerAdjustment      = api.ContainerAdjustment
-	LinuxContainerAdjustment = api.LinuxContainerAdjustment
-	ContainerUpdate          = api.ContainerUpdate
-	LinuxContainerUpdate     = api.LinuxContainerUpdate
-	ContainerEviction        = api.ContainerEviction
-	ContainerState           = api.ContainerState
-	KeyValue                 = api.KeyValue
-	Mount                    = api.Mount
-	LinuxContainer           = api.LinuxContainer
-	LinuxNamespace           = api.LinuxNamespace
-	LinuxResources           = api.LinuxResources
-	LinuxCPU                 = api.LinuxCPU
-	LinuxMemory              = api.LinuxMemory
-	LinuxDevice              = api.LinuxDevice
-	LinuxDeviceCgroup        = api.LinuxDeviceCgroup
-	HugepageLimit            = api.HugepageLimit
-	Hooks                    = api.Hooks
-	Hook                     = api.Hook
+	CheckpointPodSandboxRequest  = api.CheckpointPodSandboxRequest
+	CheckpointPodSandboxResponse = api.CheckpointPodSandboxResponse
+	RestorePodSandboxRequest     = api.RestorePodSandboxRequest
+	RestorePodSandboxResponse    = api.RestorePodSandboxResponse
+	CheckpointContainerRequest   = api.CheckpointContainerRequest
+	CheckpointContainerResponse  = api.CheckpointContainerResponse
+	RestoreContainerRequest      = api.RestoreContainerRequest
+	RestoreContainerResponse     = api.RestoreContainerResponse
+	ImagePulledRequest           = api.ImagePulledRequest
+	ImagePulledResponse          = api.ImagePulledResponse
+	ImageRemovedRequest          = api.ImageRemovedRequest
+	ImageRemovedResponse         = api.ImageRemovedResponse
+	PreExecRequest               = api.PreExecRequest
+	PreExecResponse              = api.PreExecResponse
+	PostExecRequest              = api.PostExecRequest
+	PostExecResponse             = api.PostExecResponse
+	ContainerOOMRequest          = api.ContainerOOMRequest
+	ContainerOOMResponse         = api.ContainerOOMResponse
+	ContainerExitRequest         = api.ContainerExitRequest
+	ContainerExitResponse        = api.ContainerExitResponse
+	UpdatePodSandboxRequest      = api.UpdatePodSandboxRequest
+	UpdatePodSandboxResponse     = api.UpdatePodSandboxResponse
+	PostUpdatePodSandboxRequest  = api.PostUpdatePodSandboxRequest
+	PostUpdatePodSandboxResponse = api.PostUpdatePodSandboxResponse
+	ResourcePressureRequest      = api.ResourcePressureRequest
+	ResourcePressureResponse     = api.ResourcePressureResponse
+	NetworkStatusRequest         = api.NetworkStatusRequest
+	NetworkStatusResponse        = api.NetworkStatusResponse
+	NetworkGCRequest             = api.NetworkGCRequest
+	NetworkGCResponse            = api.NetworkGCResponse
+	SetupNetworkFailedRequest    = api.SetupNetworkFailedRequest
+	SetupNetworkFailedResponse   = api.SetupNetworkFailedResponse
+
+	PodSandbox                = api.PodSandbox
+	LinuxPodSandbox           = api.LinuxPodSandbox
+	PodSandboxNetworkStatus   = api.PodSandboxNetworkStatus
+	PodOwner                  = api.PodOwner
+	Container                 = api.Container
+	Image                     = api.Image
+	ExecSession               = api.ExecSession
+	ContainerExit             = api.ContainerExit
+	Pressure                  = api.Pressure
+	PressureResource          = api.PressureResource
+	NetworkStatus             = api.NetworkStatus
+	NetworkAttachment         = api.NetworkAttachment
+	NetworkSetupFailure       = api.NetworkSetupFailure
+	NetworkSetupRetry         = api.NetworkSetupRetry
+	ContainerAdjustment       = api.ContainerAdjustment
+	PodSandboxAdjustment      = api.PodSandboxAdjustment
+	LinuxPodSandboxAdjustment = api.LinuxPodSandboxAdjustment
+	LinuxNamespaceJoin        = api.LinuxNamespaceJoin
+	LinuxContainerAdjustment  = api.LinuxContainerAdjustment
+	ContainerUpdate           = api.ContainerUpdate
+	LinuxContainerUpdate      = api.LinuxContainerUpdate
+	ContainerEviction         = api.ContainerEviction
+	ContainerState            = api.ContainerState
+	KeyValue                  = api.KeyValue
+	Mount                     = api.Mount
+	LinuxIDMapping            = api.LinuxIDMapping
+	LinuxIOPriority           = api.LinuxIOPriority
+	IOPrioClass               = api.IOPrioClass
+	LinuxScheduler            = api.LinuxScheduler
+	LinuxSchedulerPolicy      = api.LinuxSchedulerPolicy
+	LinuxSchedulerFlag        = api.LinuxSchedulerFlag
+	LinuxMemoryPolicy         = api.LinuxMemoryPolicy
+	MemoryPolicyMode          = api.MemoryPolicyMode
+	MemoryPolicyFlag          = api.MemoryPolicyFlag
+	LinuxTopologyHints        = api.LinuxTopologyHints
+	LinuxCapabilities         = api.LinuxCapabilities
+	HostEntry                 = api.HostEntry
+	LinuxContainer            = api.LinuxContainer
+	LinuxNamespace            = api.LinuxNamespace
+	LinuxResources            = api.LinuxResources
+	LinuxCPU                  = api.LinuxCPU
+	LinuxMemory               = api.LinuxMemory
+	LinuxDevice               = api.LinuxDevice
+	CDIDevice                 = api.CDIDevice
+	SecurityProfile           = api.SecurityProfile
+	LinuxDeviceCgroup         = api.LinuxDeviceCgroup
+	HugepageLimit             = api.HugepageLimit
+	LinuxBlockIO              = api.LinuxBlockIO
+	LinuxWeightDevice         = api.LinuxWeightDevice
+	LinuxThrottleDevice       = api.LinuxThrottleDevice
+	Hooks                     = api.Hooks
+	Hook                      = api.Hook
+	POSIXRlimit               = api.POSIXRlimit
+	HookTemplateData          = api.HookTemplateData
+	Stats                     = api.Stats
+	CPUStats                  = api.CPUStats
+	MemoryStats               = api.MemoryStats
+	NetworkStats              = api.NetworkStats
 
 	EventMask = api.EventMask
+	Field     = api.Field
+	FieldMask = api.FieldMask
+	Scope     = api.Scope
+	ScopeMask = api.ScopeMask
 )
 
 // Aliased consts for api/api.proto.
 // nolint
 const (
-	Event_UNKNOWN               = api.Event_UNKNOWN
-	Event_RUN_POD_SANDBOX       = api.Event_RUN_POD_SANDBOX
-	Event_STOP_POD_SANDBOX      = api.Event_STOP_POD_SANDBOX
-	Event_REMOVE_POD_SANDBOX    = api.Event_REMOVE_POD_SANDBOX
-	Event_CREATE_CONTAINER      = api.Event_CREATE_CONTAINER
-	Event_POST_CREATE_CONTAINER = api.Event_POST_CREATE_CONTAINER
-	Event_START_CONTAINER       = api.Event_START_CONTAINER
-	Event_POST_START_CONTAINER  = api.Event_POST_START_CONTAINER
-	Event_UPDATE_CONTAINER      = api.Event_UPDATE_CONTAINER
-	Event_POST_UPDATE_CONTAINER = api.Event_POST_UPDATE_CONTAINER
-	Event_STOP_CONTAINER        = api.Event_STOP_CONTAINER
-	Event_REMOVE_CONTAINER      = api.Event_REMOVE_CONTAINER
-	ValidEvents                 = api.ValidEvents
+	Event_UNKNOWN                 = api.Event_UNKNOWN
+	Event_RUN_POD_SANDBOX         = api.Event_RUN_POD_SANDBOX
+	Event_STOP_POD_SANDBOX        = api.Event_STOP_POD_SANDBOX
+	Event_REMOVE_POD_SANDBOX      = api.Event_REMOVE_POD_SANDBOX
+	Event_CREATE_CONTAINER        = api.Event_CREATE_CONTAINER
+	Event_POST_CREATE_CONTAINER   = api.Event_POST_CREATE_CONTAINER
+	Event_START_CONTAINER         = api.Event_START_CONTAINER
+	Event_POST_START_CONTAINER    = api.Event_POST_START_CONTAINER
+	Event_UPDATE_CONTAINER        = api.Event_UPDATE_CONTAINER
+	Event_POST_UPDATE_CONTAINER   = api.Event_POST_UPDATE_CONTAINER
+	Event_STOP_CONTAINER          = api.Event_STOP_CONTAINER
+	Event_REMOVE_CONTAINER        = api.Event_REMOVE_CONTAINER
+	Event_CHECKPOINT_POD_SANDBOX  = api.Event_CHECKPOINT_POD_SANDBOX
+	Event_RESTORE_POD_SANDBOX     = api.Event_RESTORE_POD_SANDBOX
+	Event_CHECKPOINT_CONTAINER    = api.Event_CHECKPOINT_CONTAINER
+	Event_RESTORE_CONTAINER       = api.Event_RESTORE_CONTAINER
+	Event_IMAGE_PULLED            = api.Event_IMAGE_PULLED
+	Event_IMAGE_REMOVED           = api.Event_IMAGE_REMOVED
+	Event_PRE_EXEC                = api.Event_PRE_EXEC
+	Event_POST_EXEC               = api.Event_POST_EXEC
+	Event_CONTAINER_OOM           = api.Event_CONTAINER_OOM
+	Event_CONTAINER_EXIT          = api.Event_CONTAINER_EXIT
+	Event_UPDATE_POD_SANDBOX      = api.Event_UPDATE_POD_SANDBOX
+	Event_POST_UPDATE_POD_SANDBOX = api.Event_POST_UPDATE_POD_SANDBOX
+	Event_RESOURCE_PRESSURE       = api.Event_RESOURCE_PRESSURE
+	Event_NETWORK_STATUS          = api.Event_NETWORK_STATUS
+	Event_NETWORK_GC              = api.Event_NETWORK_GC
+	Event_SETUP_NETWORK_FAILED    = api.Event_SETUP_NETWORK_FAILED
+	ValidEvents                   = api.ValidEvents
 
 	ContainerState_CONTAINER_UNKNOWN = api.ContainerState_CONTAINER_UNKNOWN
 	ContainerState_CONTAINER_CREATED = api.ContainerState_CONTAINER_CREATED
@@ -108,6 +220,16 @@ const (
 	ContainerState_CONTAINER_RUNNING = api.ContainerState_CONTAINER_RUNNING
 	ContainerState_CONTAINER_STOPPED = api.ContainerState_CONTAINER_STOPPED
 	ContainerState_CONTAINER_EXITED  = api.ContainerState_CONTAINER_STOPPED
+
+	SecurityProfile_RUNTIME_DEFAULT = api.SecurityProfile_RUNTIME_DEFAULT
+	SecurityProfile_UNCONFINED      = api.SecurityProfile_UNCONFINED
+	SecurityProfile_LOCALHOST       = api.SecurityProfile_LOCALHOST
+	SecurityProfile_INLINE          = api.SecurityProfile_INLINE
+
+	PressureResource_PRESSURE_UNKNOWN = api.PressureResource_PRESSURE_UNKNOWN
+	PressureResource_PRESSURE_CPU     = api.PressureResource_PRESSURE_CPU
+	PressureResource_PRESSURE_MEMORY  = api.PressureResource_PRESSURE_MEMORY
+	PressureResource_PRESSURE_IO      = api.PressureResource_PRESSURE_IO
 )
 
 // Aliased types for api/optional.go.
@@ -144,8 +266,13 @@ var (
 	FromOCILinuxNamespaces = api.FromOCILinuxNamespaces
 	FromOCILinuxDevices    = api.FromOCILinuxDevices
 	FromOCILinuxResources  = api.FromOCILinuxResources
+	FromOCIRlimits         = api.FromOCIRlimits
+	SetRlimit              = api.SetRlimit
+	SetHugepageLimit       = api.SetHugepageLimit
 	DupStringSlice         = api.DupStringSlice
 	DupStringMap           = api.DupStringMap
 	IsMarkedForRemoval     = api.IsMarkedForRemoval
 	MarkForRemoval         = api.MarkForRemoval
+	IsMarkedForSetIfAbsent = api.IsMarkedForSetIfAbsent
+	MarkForSetIfAbsent     = api.MarkForSetIfAbsent
 )
@@ -0,0 +1,352 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/containerd/nri/pkg/adaptation/adaptationtest"
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+// The benchmarks below measure the cost NRI adds to pod and container
+// lifecycle operations: serializing requests and responses, a ttrpc round
+// trip to a plugin, and fanning requests out to several plugins. Run them
+// with 'make bench' and compare the results against the baseline in
+// testdata/bench.baseline using benchstat.
+
+// benchPod returns a pod with a realistic amount of metadata.
+func benchPod(i int) *api.PodSandbox {
+	id := fmt.Sprintf("pod%d", i)
+	return &api.PodSandbox{
+		Id:        id,
+		Name:      "bench-" + id,
+		Uid:       "uid-" + id,
+		Namespace: "default",
+		Labels: map[string]string{
+			"app":                          "bench",
+			"pod-template-hash":            "5d4b8c7f9",
+			"app.kubernetes.io/managed-by": "bench",
+		},
+		Annotations: map[string]string{
+			"kubernetes.io/config.source": "api",
+			"kubernetes.io/config.seen":   "2024-01-01T00:00:00Z",
+		},
+		RuntimeHandler: "runc",
+		Linux: &api.LinuxPodSandbox{
+			CgroupParent: "/kubepods/burstable/pod" + id,
+			CgroupsPath:  "/kubepods/burstable/pod" + id + "/sandbox",
+		},
+	}
+}
+
+// benchContainer returns a container of the given pod with a realistic
+// amount of metadata, mounts and resources.
+func benchContainer(pod *api.PodSandbox) *api.Container {
+	id := "ctr-" + pod.Id
+	return &api.Container{
+		Id:           id,
+		PodSandboxId: pod.Id,
+		Name:         "app",
+		State:        api.ContainerState_CONTAINER_CREATED,
+		Labels: map[string]string{
+			"io.kubernetes.container.name": "app",
+			"io.kubernetes.pod.name":       pod.Name,
+		},
+		Annotations: map[string]string{
+			"io.kubernetes.container.restartCount": "0",
+			"io.kubernetes.container.hash":         "8e2b1ad3",
+		},
+		Args: []string{"/usr/bin/app", "--config", "/etc/app/config.yaml"},
+		Env: []string{
+			"PATH=/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin",
+			"HOSTNAME=" + pod.Name,
+			"KUBERNETES_SERVICE_HOST=10.96.0.1",
+			"KUBERNETES_SERVICE_PORT=443",
+		},
+		Mounts: []*api.Mount{
+			{
+				Destination: "/etc/hosts",
+				Type:        "bind",
+				Source:      "/var/lib/kubelet/pods/" + pod.Uid + "/etc-hosts",
+				Options:     []string{"rbind", "rprivate", "rw"},
+			},
+			{
+				Destination: "/var/run/secrets/kubernetes.io/serviceaccount",
+				Type:        "bind",
+				Source:      "/var/lib/kubelet/pods/" + pod.Uid + "/volumes/token",
+				Options:     []string{"rbind", "rprivate", "ro"},
+			},
+		},
+		Linux: &api.LinuxContainer{
+			Resources: &api.LinuxResources{
+				Memory: &api.LinuxMemory{
+					Limit: api.Int64(256 << 20),
+				},
+				Cpu: &api.LinuxCPU{
+					Shares: api.UInt64(512),
+					Quota:  api.Int64(50000),
+					Period: api.UInt64(100000),
+				},
+			},
+			CgroupsPath: pod.Linux.CgroupParent + "/" + id,
+		},
+	}
+}
+
+// benchAdjustment returns the kind of adjustment a typical plugin makes.
+// Only the first plugin adjusts resources, to avoid conflicts.
+func benchAdjustment(plugin int) *api.ContainerAdjustment {
+	adjust := &api.ContainerAdjustment{}
+	adjust.AddAnnotation(fmt.Sprintf("bench.nri.io/adjusted-%d", plugin), "true")
+	adjust.AddEnv(fmt.Sprintf("BENCH_%d", plugin), "true")
+	if plugin == 0 {
+		adjust.SetLinuxCPUSetCPUs("0-3")
+		adjust.SetLinuxMemoryLimit(512 << 20)
+	}
+	return adjust
+}
+
+func BenchmarkHookSerialization(b *testing.B) {
+	var (
+		pod    = benchPod(0)
+		ctr    = benchContainer(pod)
+		update = &api.ContainerUpdate{ContainerId: ctr.Id}
+	)
+	update.SetLinuxCPUShares(1024)
+
+	for _, bm := range []struct {
+		hook string
+		msgs []proto.Message
+	}{
+		{
+			hook: "RunPodSandbox",
+			msgs: []proto.Message{
+				&api.StateChangeEvent{Event: api.Event_RUN_POD_SANDBOX, Pod: pod},
+				&api.StateChangeResponse{},
+			},
+		},
+		{
+			hook: "CreateContainer",
+			msgs: []proto.Message{
+				&api.CreateContainerRequest{Pod: pod, Container: ctr},
+				&api.CreateContainerResponse{Adjust: benchAdjustment(0)},
+			},
+		},
+		{
+			hook: "StartContainer",
+			msgs: []proto.Message{
+				&api.StateChangeEvent{Event: api.Event_START_CONTAINER, Pod: pod, Container: ctr},
+				&api.StateChangeResponse{},
+			},
+		},
+		{
+			hook: "UpdateContainer",
+			msgs: []proto.Message{
+				&api.UpdateContainerRequest{Pod: pod, Container: ctr, LinuxResources: ctr.Linux.Resources},
+				&api.UpdateContainerResponse{Update: []*api.ContainerUpdate{update}},
+			},
+		},
+		{
+			hook: "StopContainer",
+			msgs: []proto.Message{
+				&api.StopContainerRequest{Pod: pod, Container: ctr},
+				&api.StopContainerResponse{},
+			},
+		},
+	} {
+		bm := bm
+		b.Run(bm.hook, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for _, msg := range bm.msgs {
+					data, err := proto.Marshal(msg)
+					if err != nil {
+						b.Fatalf("failed to marshal %T: %v", msg, err)
+					}
+					if err = proto.Unmarshal(data, msg.ProtoReflect().New().Interface()); err != nil {
+						b.Fatalf("failed to unmarshal %T: %v", msg, err)
+					}
+				}
+			}
+		})
+	}
+}
+
+// startBenchRuntime starts a fake runtime with n connected plugins, each
+// subscribed to all pod and container lifecycle events and adjusting every
+// container being created.
+func startBenchRuntime(b *testing.B, n int) *adaptationtest.Runtime {
+	logrus.SetLevel(logrus.ErrorLevel)
+
+	runtime, err := adaptationtest.New(b.TempDir())
+	if err != nil {
+		b.Fatalf("failed to create fake runtime: %v", err)
+	}
+	if err = runtime.Start(); err != nil {
+		b.Fatalf("failed to start fake runtime: %v", err)
+	}
+	b.Cleanup(runtime.Stop)
+
+	for i := 0; i < n; i++ {
+		idx := i
+		noop := func(*api.PodSandbox) error { return nil }
+		noopContainer := func(*api.PodSandbox, *api.Container) error { return nil }
+		plugin, err := stub.New(nil,
+			stub.WithPluginName("bench"),
+			stub.WithPluginIdx(fmt.Sprintf("%02d", i)),
+			stub.WithSocketPath(runtime.SocketPath()),
+			stub.WithOnClose(func() {}),
+			stub.HandleRunPodSandbox(noop),
+			stub.HandleStopPodSandbox(noop),
+			stub.HandleRemovePodSandbox(noop),
+			stub.HandleCreateContainer(func(*api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+				return benchAdjustment(idx), nil, nil
+			}),
+			stub.HandlePostCreateContainer(noopContainer),
+			stub.HandleStartContainer(noopContainer),
+			stub.HandlePostStartContainer(noopContainer),
+			stub.HandleUpdateContainer(func(*api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error) {
+				return nil, nil
+			}),
+			stub.HandleStopContainer(func(*api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error) {
+				return nil, nil
+			}),
+			stub.HandleRemoveContainer(noopContainer),
+		)
+		if err != nil {
+			b.Fatalf("failed to create plugin: %v", err)
+		}
+		if err = plugin.Start(context.Background()); err != nil {
+			b.Fatalf("failed to start plugin: %v", err)
+		}
+		b.Cleanup(plugin.Stop)
+	}
+
+	if err = runtime.WaitForPlugins(n, 5*time.Second); err != nil {
+		b.Fatalf("%v", err)
+	}
+
+	return runtime
+}
+
+func BenchmarkTTRPCRoundTrip(b *testing.B) {
+	var (
+		ctx = context.Background()
+		pod = benchPod(0)
+		ctr = benchContainer(pod)
+	)
+
+	for _, bm := range []struct {
+		hook string
+		call func(*adaptationtest.Runtime) error
+	}{
+		{
+			hook: "RunPodSandbox",
+			call: func(r *adaptationtest.Runtime) error {
+				return r.RunPodSandbox(ctx, &api.StateChangeEvent{Pod: pod})
+			},
+		},
+		{
+			hook: "CreateContainer",
+			call: func(r *adaptationtest.Runtime) error {
+				_, err := r.CreateContainer(ctx, &api.CreateContainerRequest{Pod: pod, Container: ctr})
+				return err
+			},
+		},
+		{
+			hook: "StartContainer",
+			call: func(r *adaptationtest.Runtime) error {
+				return r.StartContainer(ctx, &api.StateChangeEvent{Pod: pod, Container: ctr})
+			},
+		},
+		{
+			hook: "UpdateContainer",
+			call: func(r *adaptationtest.Runtime) error {
+				_, err := r.UpdateContainer(ctx, &api.UpdateContainerRequest{
+					Pod:            pod,
+					Container:      ctr,
+					LinuxResources: ctr.Linux.Resources,
+				})
+				return err
+			},
+		},
+		{
+			hook: "StopContainer",
+			call: func(r *adaptationtest.Runtime) error {
+				_, err := r.StopContainer(ctx, &api.StopContainerRequest{Pod: pod, Container: ctr})
+				return err
+			},
+		},
+	} {
+		bm := bm
+		b.Run(bm.hook, func(b *testing.B) {
+			runtime := startBenchRuntime(b, 1)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := bm.call(runtime); err != nil {
+					b.Fatalf("%s failed: %v", bm.hook, err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkAdaptationFanOut(b *testing.B) {
+	ctx := context.Background()
+
+	for _, plugins := range []int{1, 4, 16} {
+		for _, pods := range []int{1, 16, 64} {
+			plugins, pods := plugins, pods
+			b.Run(fmt.Sprintf("plugins=%d/pods=%d", plugins, pods), func(b *testing.B) {
+				runtime := startBenchRuntime(b, plugins)
+
+				podList := make([]*api.PodSandbox, pods)
+				ctrList := make([]*api.Container, pods)
+				for i := range podList {
+					podList[i] = benchPod(i)
+					ctrList[i] = benchContainer(podList[i])
+				}
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				start := time.Now()
+				for i := 0; i < b.N; i++ {
+					for j := range podList {
+						if _, err := runtime.RunPodAndContainer(ctx, podList[j], ctrList[j]); err != nil {
+							b.Fatalf("failed to run pod: %v", err)
+						}
+					}
+					for j := range podList {
+						if err := runtime.StopPodAndContainer(ctx, podList[j], ctrList[j]); err != nil {
+							b.Fatalf("failed to stop pod: %v", err)
+						}
+					}
+				}
+				b.ReportMetric(float64(time.Since(start).Nanoseconds())/float64(b.N*pods), "ns/pod")
+			})
+		}
+	}
+}
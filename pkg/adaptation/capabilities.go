@@ -0,0 +1,57 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// checkCapabilities checks if a plugin is allowed to adjust the given
+// capabilities. Added capabilities must be in the allowlist, dropped ones
+// only need to be valid capability names.
+func (r *Adaptation) checkCapabilities(caps *LinuxCapabilities, plugin string) error {
+	for _, c := range caps.GetAdd() {
+		if !isCapabilityName(c) {
+			return fmt.Errorf("plugin %q: invalid capability %q", plugin, c)
+		}
+		if _, ok := r.caps[c]; !ok {
+			return fmt.Errorf("plugin %q: adding capability %s is not allowed", plugin, c)
+		}
+	}
+	for _, c := range caps.GetDrop() {
+		if !isCapabilityName(c) {
+			return fmt.Errorf("plugin %q: invalid capability %q", plugin, c)
+		}
+	}
+	return nil
+}
+
+// isCapabilityName checks if the given name looks like a full capability
+// name, like CAP_SYS_ADMIN.
+func isCapabilityName(name string) bool {
+	rest := strings.TrimPrefix(name, "CAP_")
+	if rest == name || rest == "" {
+		return false
+	}
+	for _, c := range rest {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,238 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// The fuzz targets below exercise the handling of plugin responses, which
+// the runtime must survive however malformed they are. Their seed corpus is
+// taken from the recorded trace in testdata and its golden results, and is
+// run as part of the regular tests. Run 'make fuzz' to fuzz them for real.
+
+// fuzzTrace is the part of a recorded trace used for seeding fuzz targets.
+type fuzzTrace struct {
+	Steps []struct {
+		Event     string              `json:"event"`
+		Pod       *api.PodSandbox     `json:"pod"`
+		Container *api.Container      `json:"container"`
+		Resources *api.LinuxResources `json:"resources"`
+	} `json:"steps"`
+}
+
+// fuzzResult is the part of recorded trace results used for seeding.
+type fuzzResult struct {
+	PodAdjust *api.PodSandboxAdjustment `json:"podAdjust"`
+	Adjust    *api.ContainerAdjustment  `json:"adjust"`
+	Update    []*api.ContainerUpdate    `json:"update"`
+}
+
+func loadFuzzTrace(f *testing.F) *fuzzTrace {
+	data, err := os.ReadFile(filepath.Join("testdata", "trace.yaml"))
+	if err != nil {
+		f.Fatalf("failed to read trace: %v", err)
+	}
+	t := &fuzzTrace{}
+	if err = yaml.Unmarshal(data, t); err != nil {
+		f.Fatalf("failed to parse trace: %v", err)
+	}
+	return t
+}
+
+// addResponseSeeds adds the plugin responses of recorded trace results to
+// the seed corpus, marshaled to the wire format.
+func addResponseSeeds(f *testing.F, add func([]byte)) {
+	data, err := os.ReadFile(filepath.Join("testdata", "trace.golden.json"))
+	if err != nil {
+		f.Fatalf("failed to read trace results: %v", err)
+	}
+	var results []*fuzzResult
+	if err = json.Unmarshal(data, &results); err != nil {
+		f.Fatalf("failed to parse trace results: %v", err)
+	}
+
+	add(nil)
+	for _, r := range results {
+		for _, msg := range []proto.Message{
+			&api.CreateContainerResponse{Adjust: r.Adjust, Update: r.Update},
+			&api.UpdateContainerResponse{Update: r.Update},
+			&api.StateChangeResponse{Adjust: r.PodAdjust},
+		} {
+			data, err := proto.Marshal(msg)
+			if err != nil {
+				f.Fatalf("failed to marshal seed: %v", err)
+			}
+			add(data)
+		}
+	}
+}
+
+// createRequest returns a fresh CreateContainer request from the trace.
+func (t *fuzzTrace) createRequest() *api.CreateContainerRequest {
+	for _, s := range t.Steps {
+		if s.Event == "CreateContainer" {
+			return &api.CreateContainerRequest{
+				Pod:       proto.Clone(s.Pod).(*api.PodSandbox),
+				Container: proto.Clone(s.Container).(*api.Container),
+			}
+		}
+	}
+	return &api.CreateContainerRequest{
+		Pod:       &api.PodSandbox{Id: "pod0"},
+		Container: &api.Container{Id: "ctr0", PodSandboxId: "pod0"},
+	}
+}
+
+// updateRequest returns a fresh UpdateContainer request from the trace.
+func (t *fuzzTrace) updateRequest() *api.UpdateContainerRequest {
+	for _, s := range t.Steps {
+		if s.Event == "UpdateContainer" {
+			return &api.UpdateContainerRequest{
+				Pod:            proto.Clone(s.Pod).(*api.PodSandbox),
+				Container:      proto.Clone(s.Container).(*api.Container),
+				LinuxResources: proto.Clone(s.Resources).(*api.LinuxResources),
+			}
+		}
+	}
+	return &api.UpdateContainerRequest{
+		Pod:       &api.PodSandbox{Id: "pod0"},
+		Container: &api.Container{Id: "ctr0", PodSandboxId: "pod0"},
+	}
+}
+
+func FuzzPluginResponseUnmarshal(f *testing.F) {
+	addResponseSeeds(f, func(data []byte) { f.Add(data) })
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, msg := range []proto.Message{
+			&api.ConfigureResponse{},
+			&api.SynchronizeResponse{},
+			&api.CreateContainerResponse{},
+			&api.UpdateContainerResponse{},
+			&api.StopContainerResponse{},
+			&api.StateChangeResponse{},
+		} {
+			if err := proto.Unmarshal(data, msg); err != nil {
+				continue
+			}
+			if _, err := proto.Marshal(msg); err != nil {
+				t.Fatalf("failed to marshal unmarshaled %T: %v", msg, err)
+			}
+			if c := proto.Clone(msg); !proto.Equal(c, msg) {
+				t.Fatalf("clone of %T differs from original", msg)
+			}
+		}
+	})
+}
+
+func FuzzCreateContainerMerge(f *testing.F) {
+	trace := loadFuzzTrace(f)
+	addResponseSeeds(f, func(data []byte) { f.Add(data, data) })
+
+	r := &Adaptation{
+		sysctls: []string{"net.*"},
+		caps:    map[string]struct{}{"CAP_NET_ADMIN": {}},
+	}
+
+	f.Fuzz(func(t *testing.T, data1, data2 []byte) {
+		req := trace.createRequest()
+		result := collectCreateContainerResult(req)
+		for i, data := range [][]byte{data1, data2} {
+			rpl := &api.CreateContainerResponse{}
+			if err := proto.Unmarshal(data, rpl); err != nil {
+				return
+			}
+			plugin := []string{"00-first", "10-second"}[i]
+			if err := r.checkContainerAdjustment(rpl.GetAdjust(), plugin); err != nil {
+				return
+			}
+			if err := result.apply(rpl, plugin); err != nil {
+				return
+			}
+		}
+		if result.createContainerResponse() == nil {
+			t.Fatalf("no merged CreateContainer response")
+		}
+	})
+}
+
+func FuzzUpdateContainerMerge(f *testing.F) {
+	trace := loadFuzzTrace(f)
+	addResponseSeeds(f, func(data []byte) { f.Add(data, data) })
+
+	f.Fuzz(func(t *testing.T, data1, data2 []byte) {
+		req := trace.updateRequest()
+		result := collectUpdateContainerResult(req)
+		for i, data := range [][]byte{data1, data2} {
+			rpl := &api.UpdateContainerResponse{}
+			if err := proto.Unmarshal(data, rpl); err != nil {
+				return
+			}
+			if err := result.apply(rpl, []string{"00-first", "10-second"}[i]); err != nil {
+				return
+			}
+		}
+		if result.updateContainerResponse() == nil {
+			t.Fatalf("no merged UpdateContainer response")
+		}
+	})
+}
+
+func FuzzHookTemplates(f *testing.F) {
+	for _, s := range []string{
+		"",
+		"/bin/true",
+		"{{.NetNSPath}}",
+		"IPS={{join .IPs \",\"}}",
+		"{{.PodNamespace}}/{{.PodName}}/{{.ContainerName}}",
+		"{{if .IPs}}{{index .IPs 0}}{{end}}",
+	} {
+		f.Add(s)
+	}
+
+	data := &api.HookTemplateData{
+		PodName:       "nginx",
+		PodNamespace:  "default",
+		PodUID:        "uid1",
+		ContainerName: "nginx",
+		ContainerID:   "ctr1",
+		NetNSPath:     "/var/run/netns/cni-1",
+		IPs:           []string{"10.0.0.1", "fd00::1"},
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		h := &api.Hook{
+			Path: "/bin/hook",
+			Args: []string{"hook", s},
+			Env:  []string{s},
+		}
+		if err := h.CheckTemplates(); err != nil {
+			return
+		}
+		// Expansion may fail at execution time, but must not panic.
+		_, _ = h.Expand(data)
+	})
+}
@@ -0,0 +1,125 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"time"
+)
+
+const (
+	// maxStateChanges is the number of changed pods and containers
+	// remembered for delta synchronization. Plugins which reconnect after
+	// more changes than this get fully synchronized.
+	maxStateChanges = 4096
+)
+
+// stateLog tracks the generation of the runtime state and the generation
+// each pod and container last changed in, so that plugins reconnecting
+// after a brief disconnect can be synchronized with the changes they
+// missed. Generations start from the time of creation, so they keep
+// increasing across runtime restarts and a generation known from an
+// earlier runtime instance is too old to compute a delta from.
+type stateLog struct {
+	generation uint64
+	floor      uint64
+	pods       map[string]uint64
+	containers map[string]uint64
+}
+
+func newStateLog() *stateLog {
+	now := uint64(time.Now().UnixNano())
+	return &stateLog{
+		generation: now,
+		floor:      now,
+		pods:       make(map[string]uint64),
+		containers: make(map[string]uint64),
+	}
+}
+
+// changed records a change to the given pod and containers, returning the
+// new generation of the runtime state. An empty pod ID is ignored.
+func (l *stateLog) changed(pod string, containers ...string) uint64 {
+	l.generation++
+	if pod != "" {
+		l.pods[pod] = l.generation
+	}
+	for _, id := range containers {
+		if id != "" {
+			l.containers[id] = l.generation
+		}
+	}
+	l.trim()
+	return l.generation
+}
+
+// trim forgets the oldest changes once too many are remembered.
+func (l *stateLog) trim() {
+	if len(l.pods)+len(l.containers) <= maxStateChanges {
+		return
+	}
+
+	floor := l.generation - maxStateChanges/2
+	for id, gen := range l.pods {
+		if gen <= floor {
+			delete(l.pods, id)
+		}
+	}
+	for id, gen := range l.containers {
+		if gen <= floor {
+			delete(l.containers, id)
+		}
+	}
+	l.floor = floor
+
+	if len(l.pods)+len(l.containers) > maxStateChanges {
+		l.pods = make(map[string]uint64)
+		l.containers = make(map[string]uint64)
+		l.floor = l.generation
+	}
+}
+
+// since returns the pods and containers changed since the given generation.
+// It returns false if the changes since then are not known.
+func (l *stateLog) since(generation uint64) (map[string]struct{}, map[string]struct{}, bool) {
+	if generation < l.floor || generation > l.generation {
+		return nil, nil, false
+	}
+
+	pods := make(map[string]struct{})
+	for id, gen := range l.pods {
+		if gen > generation {
+			pods[id] = struct{}{}
+		}
+	}
+	containers := make(map[string]struct{})
+	for id, gen := range l.containers {
+		if gen > generation {
+			containers[id] = struct{}{}
+		}
+	}
+
+	return pods, containers, true
+}
+
+// updatedContainers returns the IDs of the containers in the given updates.
+func updatedContainers(updates []*ContainerUpdate) []string {
+	ids := make([]string, 0, len(updates))
+	for _, u := range updates {
+		ids = append(ids, u.GetContainerId())
+	}
+	return ids
+}
@@ -0,0 +1,125 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	stdnet "net"
+	"strings"
+)
+
+// PeerPolicy describes which local processes are allowed to connect to the
+// runtime as external plugins. An empty list of IDs or digests allows any.
+type PeerPolicy struct {
+	// UIDs are the user IDs plugin processes are allowed to run as.
+	UIDs []uint32
+	// GIDs are the group IDs plugin processes are allowed to run as.
+	GIDs []uint32
+	// Digests are the hex-encoded SHA-256 digests of the plugin binaries
+	// allowed to connect, checked against the executable of the process.
+	// They are checked once, after the connection is accepted, so they are
+	// not a security boundary against processes with an allowed UID or GID,
+	// which can exec another binary or hand the connection to another
+	// process after the check. Use them to pin the expected plugin binaries,
+	// and the UIDs and GIDs to restrict who can connect.
+	Digests []string
+}
+
+// PeerCredentials are the credentials of the process at the other end of
+// a plugin connection.
+type PeerCredentials struct {
+	Pid int
+	UID uint32
+	GID uint32
+}
+
+var (
+	// ErrPeerRejected is returned for plugin connections rejected by policy.
+	ErrPeerRejected = errors.New("plugin connection rejected")
+)
+
+// WithPeerPolicy returns an option to only accept connections from external
+// plugins which match the given policy. Peer credentials are checked with
+// SO_PEERCRED, which is only supported on Linux. By default any process
+// with access to the NRI socket can connect as a plugin. Only the UIDs and
+// GIDs of the policy restrict which processes can connect, binary digests
+// are not a security boundary against processes sharing them.
+func WithPeerPolicy(policy *PeerPolicy) Option {
+	return func(r *Adaptation) error {
+		if policy == nil {
+			return fmt.Errorf("no peer policy given")
+		}
+		for _, d := range policy.Digests {
+			if b, err := hex.DecodeString(d); err != nil || len(b) != 32 {
+				return fmt.Errorf("invalid SHA-256 digest %q", d)
+			}
+		}
+		r.peerPolicy = policy
+		return nil
+	}
+}
+
+// checkPeer checks the process at the other end of the connection against
+// the peer policy, if we have one.
+func (r *Adaptation) checkPeer(conn stdnet.Conn) error {
+	policy := r.peerPolicy
+	if policy == nil {
+		return nil
+	}
+
+	cred, err := getPeerCredentials(conn)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPeerRejected, err)
+	}
+
+	if len(policy.UIDs) > 0 && !containsID(policy.UIDs, cred.UID) {
+		return fmt.Errorf("%w: pid %d, uid %d not allowed", ErrPeerRejected, cred.Pid, cred.UID)
+	}
+	if len(policy.GIDs) > 0 && !containsID(policy.GIDs, cred.GID) {
+		return fmt.Errorf("%w: pid %d, gid %d not allowed", ErrPeerRejected, cred.Pid, cred.GID)
+	}
+
+	if len(policy.Digests) > 0 {
+		digest, err := getPeerDigest(cred.Pid)
+		if err != nil {
+			return fmt.Errorf("%w: pid %d: %v", ErrPeerRejected, cred.Pid, err)
+		}
+		allowed := false
+		for _, d := range policy.Digests {
+			if strings.EqualFold(d, digest) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%w: pid %d, binary digest %s not allowed", ErrPeerRejected, cred.Pid, digest)
+		}
+	}
+
+	return nil
+}
+
+func containsID(ids []uint32, id uint32) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
@@ -24,6 +24,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -33,6 +34,7 @@ import (
 	"github.com/containerd/nri/pkg/net"
 	"github.com/containerd/nri/pkg/net/multiplex"
 	"github.com/containerd/ttrpc"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -40,31 +42,50 @@ const (
 	DefaultPluginRegistrationTimeout = 5 * time.Second
 	// DefaultPluginRequestTimeout is the default timeout for plugins to handle a request.
 	DefaultPluginRequestTimeout = 2 * time.Second
+	// DefaultPluginDeferredResponseTimeout is the default timeout for plugins
+	// to deliver a deferred response.
+	DefaultPluginDeferredResponseTimeout = 10 * time.Second
 )
 
 var (
 	pluginRegistrationTimeout = DefaultPluginRegistrationTimeout
 	pluginRequestTimeout      = DefaultPluginRequestTimeout
+	pluginDeferredTimeout     = DefaultPluginDeferredResponseTimeout
 	timeoutCfgLock            sync.RWMutex
 )
 
 type plugin struct {
 	sync.Mutex
-	idx    string
-	base   string
-	cfg    string
-	pid    int
-	cmd    *exec.Cmd
-	mux    multiplex.Mux
-	rpcc   *ttrpc.Client
-	rpcl   stdnet.Listener
-	rpcs   *ttrpc.Server
-	events EventMask
-	closed bool
-	stub   api.PluginService
-	regC   chan error
-	closeC chan struct{}
-	r      *Adaptation
+	idx     string
+	base    string
+	cfg     string
+	cfgType string
+	build   *BuildInfo
+	pid     int
+	cmd     *exec.Cmd
+	mux     multiplex.Mux
+	pconn   stdnet.Conn
+	rpcc    *ttrpc.Client
+	rpcl    stdnet.Listener
+	rpcs    *ttrpc.Server
+	grpcc   *grpc.ClientConn
+	grpcs   *grpc.Server
+	events  EventMask
+	spec    bool
+	dryRun  bool
+	fields  *FieldMask
+	scopes  *ScopeMask
+	lastGen uint64
+	closed  bool
+	client  string
+	regd    bool
+	stub    api.PluginService
+	regC    chan error
+	closeC  chan struct{}
+	r       *Adaptation
+	stats   pluginStats
+
+	deferred map[string]chan *DeliverCreateContainerResponseRequest
 }
 
 // SetPluginRegistrationTimeout sets the timeout for plugin registration.
@@ -93,8 +114,22 @@ func getPluginRequestTimeout() time.Duration {
 	return pluginRequestTimeout
 }
 
+// SetPluginDeferredResponseTimeout sets the timeout for plugins to deliver
+// a deferred response.
+func SetPluginDeferredResponseTimeout(t time.Duration) {
+	timeoutCfgLock.Lock()
+	defer timeoutCfgLock.Unlock()
+	pluginDeferredTimeout = t
+}
+
+func getPluginDeferredResponseTimeout() time.Duration {
+	timeoutCfgLock.RLock()
+	defer timeoutCfgLock.RUnlock()
+	return pluginDeferredTimeout
+}
+
 // Launch a pre-installed plugin with a pre-connected socketpair.
-func (r *Adaptation) newLaunchedPlugin(dir, idx, base, cfg string) (p *plugin, retErr error) {
+func (r *Adaptation) newLaunchedPlugin(dir, idx, base, cfg, cfgType string) (p *plugin, retErr error) {
 	name := idx + "-" + base
 
 	sockets, err := net.NewSocketPair()
@@ -125,13 +160,14 @@ func (r *Adaptation) newLaunchedPlugin(dir, idx, base, cfg string) (p *plugin, r
 	}
 
 	p = &plugin{
-		cfg:    cfg,
-		cmd:    cmd,
-		idx:    idx,
-		base:   base,
-		regC:   make(chan error, 1),
-		closeC: make(chan struct{}),
-		r:      r,
+		cfg:     cfg,
+		cfgType: cfgType,
+		cmd:     cmd,
+		idx:     idx,
+		base:    base,
+		regC:    make(chan error, 1),
+		closeC:  make(chan struct{}),
+		r:       r,
 	}
 
 	if err = p.cmd.Start(); err != nil {
@@ -159,25 +195,27 @@ func (r *Adaptation) newExternalPlugin(conn stdnet.Conn) (p *plugin, retErr erro
 	return p, nil
 }
 
-// Get plugin-specific configuration for an NRI-launched plugin.
-func (r *Adaptation) getPluginConfig(id, base string) (string, error) {
+// Get plugin-specific configuration and its format for an NRI-launched plugin.
+func (r *Adaptation) getPluginConfig(id, base string) (string, string, error) {
 	name := id + "-" + base
-	dropIns := []string{
-		filepath.Join(r.dropinPath, name+".conf"),
-		filepath.Join(r.dropinPath, base+".conf"),
+	dropIns := []string{}
+	for _, n := range []string{name, base} {
+		for _, ext := range []string{".conf", ".yaml", ".yml", ".json", ".toml", ".hcl"} {
+			dropIns = append(dropIns, filepath.Join(r.dropinPath, n+ext))
+		}
 	}
 
 	for _, path := range dropIns {
 		buf, err := os.ReadFile(path)
 		if err == nil {
-			return string(buf), nil
+			return string(buf), api.ConfigTypeForFile(path), nil
 		}
 		if !os.IsNotExist(err) {
-			return "", fmt.Errorf("failed to read configuration for plugin %q: %w", name, err)
+			return "", "", fmt.Errorf("failed to read configuration for plugin %q: %w", name, err)
 		}
 	}
 
-	return "", nil
+	return "", "", nil
 }
 
 // Check if the plugin is external (was not launched by us).
@@ -198,28 +236,6 @@ func (p *plugin) connect(conn stdnet.Conn) (retErr error) {
 	if err != nil {
 		return fmt.Errorf("failed to mux plugin connection for plugin %q: %w", p.name(), err)
 	}
-	rpcc := ttrpc.NewClient(pconn, ttrpc.WithOnClose(
-		func() {
-			log.Infof(noCtx, "connection to plugin %q closed", p.name())
-			close(p.closeC)
-			p.close()
-		}))
-	defer func() {
-		if retErr != nil {
-			rpcc.Close()
-		}
-	}()
-	stub := api.NewPluginClient(rpcc)
-
-	rpcs, err := ttrpc.NewServer()
-	if err != nil {
-		return fmt.Errorf("failed to create ttrpc server for plugin %q: %w", p.name(), err)
-	}
-	defer func() {
-		if retErr != nil {
-			rpcs.Close()
-		}
-	}()
 
 	rpcl, err := mux.Listen(multiplex.RuntimeServiceConn)
 	if err != nil {
@@ -227,21 +243,75 @@ func (p *plugin) connect(conn stdnet.Conn) (retErr error) {
 	}
 
 	p.mux = mux
-	p.rpcc = rpcc
+	p.pconn = pconn
 	p.rpcl = rpcl
-	p.rpcs = rpcs
-	p.stub = stub
 
 	p.pid, err = getPeerPid(p.mux.Trunk())
 	if err != nil {
 		log.Warnf(noCtx, "failed to determine plugin pid pid: %v", err)
 	}
 
-	api.RegisterRuntimeService(p.rpcs, p)
-
 	return nil
 }
 
+// serve the Runtime service, using the transport the plugin connects with.
+func (p *plugin) serve() error {
+	conn, err := p.rpcl.Accept()
+	if err != nil {
+		return err
+	}
+
+	transport, conn, err := detectTransport(conn)
+	if err != nil {
+		return fmt.Errorf("failed to detect transport for plugin %q: %w", p.name(), err)
+	}
+
+	onClose := func() {
+		log.Infof(noCtx, "connection to plugin %q closed", p.name())
+		p.close()
+	}
+
+	p.Lock()
+	if p.closed {
+		p.Unlock()
+		return ttrpc.ErrServerClosed
+	}
+
+	switch transport {
+	case api.TransportGRPC:
+		grpcc, err := net.NewGRPCClientConn(p.pconn, onClose)
+		if err != nil {
+			p.Unlock()
+			return fmt.Errorf("failed to create gRPC client for plugin %q: %w", p.name(), err)
+		}
+		p.grpcc = grpcc
+		p.grpcs = grpc.NewServer()
+		p.stub = api.NewPluginGRPCClient(grpcc)
+		api.RegisterRuntimeGRPCService(p.grpcs, p)
+		p.Unlock()
+
+		err = p.grpcs.Serve(net.NewConnListener(conn))
+		if err == nil || err == grpc.ErrServerStopped {
+			err = ttrpc.ErrServerClosed
+		}
+		return err
+
+	default:
+		rpcs, err := ttrpc.NewServer()
+		if err != nil {
+			p.Unlock()
+			return fmt.Errorf("failed to create ttrpc server for plugin %q: %w", p.name(), err)
+		}
+		p.rpcs = rpcs
+		p.rpcc = ttrpc.NewClient(p.pconn, ttrpc.WithOnClose(onClose))
+		p.stub = api.NewPluginClient(p.rpcc)
+		api.RegisterRuntimeService(p.rpcs, p)
+		p.Unlock()
+
+		return p.rpcs.Serve(context.Background(), net.NewConnListener(conn))
+	}
+}
+
 // Start Runtime service, wait for plugin to register, then configure it.
 func (p *plugin) start(name, version string) error {
 	var (
@@ -250,7 +320,7 @@ func (p *plugin) start(name, version string) error {
 	)
 
 	go func() {
-		err := p.rpcs.Serve(context.Background(), p.rpcl)
+		err := p.serve()
 		if err != ttrpc.ErrServerClosed {
 			log.Infof(noCtx, "ttrpc server for plugin %q closed (%v)", p.name(), err)
 		}
@@ -261,18 +331,21 @@ func (p *plugin) start(name, version string) error {
 
 	select {
 	case err = <-p.regC:
+		if err == errClientRegistered {
+			return err
+		}
 		if err != nil {
 			return fmt.Errorf("failed to register plugin: %w", err)
 		}
 	case <-p.closeC:
-		return fmt.Errorf("failed to register plugin, connection closed")
+		return errClosedUnregistered
 	case <-time.After(timeout):
 		p.close()
 		p.stop()
 		return errors.New("plugin registration timed out")
 	}
 
-	err = p.configure(context.Background(), name, version, p.cfg)
+	err = p.configure(context.Background(), name, version, p.cfg, p.cfgType)
 	if err != nil {
 		p.close()
 		p.stop()
@@ -291,10 +364,25 @@ func (p *plugin) close() {
 	}
 
 	p.closed = true
+	close(p.closeC)
 	p.mux.Close()
-	p.rpcc.Close()
-	p.rpcs.Close()
 	p.rpcl.Close()
+	if p.rpcc != nil {
+		p.rpcc.Close()
+	}
+	if p.rpcs != nil {
+		p.rpcs.Close()
+	}
+	if p.grpcc != nil {
+		p.grpcc.Close()
+	}
+	if p.grpcs != nil {
+		// Stop waits for active requests, which might need the runtime lock.
+		go p.grpcs.Stop()
+	}
+
+	// Network readiness reported by a plugin is stale once it is gone.
+	p.r.readiness.clear(noCtx, p.name())
 }
 
 func (p *plugin) isClosed() bool {
@@ -343,8 +431,37 @@ func (p *plugin) qualifiedName() string {
 	return kind + ":" + idx + "-" + base + "[" + strconv.Itoa(p.pid) + "]"
 }
 
+var (
+	// errClientRegistered is returned by start for introspection clients.
+	errClientRegistered = errors.New("client registered")
+	// errClosedUnregistered is returned by start for connections closed
+	// before registering.
+	errClosedUnregistered = errors.New("failed to register plugin, connection closed")
+)
+
+// register marks the connection registered, returning false if it already was.
+func (p *plugin) register() bool {
+	p.Lock()
+	defer p.Unlock()
+	if p.regd {
+		return false
+	}
+	p.regd = true
+	return true
+}
+
+// isPlugin returns true if the connection is from a registered plugin.
+func (p *plugin) isPlugin() bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.regd && p.client == ""
+}
+
 // RegisterPlugin handles the plugin's registration request.
 func (p *plugin) RegisterPlugin(ctx context.Context, req *RegisterPluginRequest) (*RegisterPluginResponse, error) {
+	if !p.register() {
+		return &RegisterPluginResponse{}, errors.New("connection already registered")
+	}
 	if p.isExternal() {
 		if req.PluginName == "" {
 			p.regC <- fmt.Errorf("plugin %q registered empty name", p.qualifiedName())
@@ -358,31 +475,172 @@ func (p *plugin) RegisterPlugin(ctx context.Context, req *RegisterPluginRequest)
 		p.idx = req.PluginIdx
 	}
 
-	log.Infof(ctx, "plugin %q registered as %q", p.qualifiedName(), p.name())
+	p.build = req.BuildInfo
+
+	log.Infof(ctx, "plugin %q registered as %q, build %s", p.qualifiedName(), p.name(),
+		p.build.Summary())
+
+	if p.r.compress > 0 && api.HasCompression(req.Compression, api.CompressionZstd) {
+		p.mux.SetCompression(p.r.compress)
+	}
 
 	p.regC <- nil
 	return &RegisterPluginResponse{}, nil
 }
 
+// RegisterClient handles the registration request of an introspection
+// client, like the nri CLI. Clients are not plugins, they are not sent any
+// requests or events, and only use the runtime service to query the runtime.
+func (p *plugin) RegisterClient(ctx context.Context, req *RegisterClientRequest) (*RegisterClientResponse, error) {
+	if !p.isExternal() {
+		return &RegisterClientResponse{}, errors.New("pre-connected plugin cannot register as a client")
+	}
+	if !p.register() {
+		return &RegisterClientResponse{}, errors.New("connection already registered")
+	}
+
+	name := req.ClientName
+	if name == "" {
+		name = "client"
+	}
+	p.Lock()
+	p.client = name
+	p.Unlock()
+
+	log.Debugf(ctx, "client %q connected, pid %d", name, p.pid)
+
+	p.regC <- errClientRegistered
+	return &RegisterClientResponse{}, nil
+}
+
 // UpdateContainers relays container update request to the runtime.
 func (p *plugin) UpdateContainers(ctx context.Context, req *UpdateContainersRequest) (*UpdateContainersResponse, error) {
 	log.Infof(ctx, "plugin %q requested container updates", p.name())
 
+	if err := p.checkContainerScopes(nil, req.Update); err != nil {
+		return nil, err
+	}
+
 	failed, err := p.r.updateContainers(ctx, req.Update)
 	return &UpdateContainersResponse{
 		Failed: failed,
 	}, err
 }
 
+// GetStats relays a resource usage request to the runtime.
+func (p *plugin) GetStats(ctx context.Context, req *GetStatsRequest) (*GetStatsResponse, error) {
+	stats, err := p.r.getStats(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &GetStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+// RestartContainer relays a container restart request to the runtime.
+func (p *plugin) RestartContainer(ctx context.Context, req *RestartContainerRequest) (*RestartContainerResponse, error) {
+	action := "restart"
+	if req.Stop {
+		action = "stop"
+	}
+	log.Infof(ctx, "plugin %q requested %s of container %s: %s", p.name(), action,
+		req.ContainerId, req.Reason)
+
+	if err := p.r.restartContainer(ctx, p.name(), req); err != nil {
+		return nil, err
+	}
+	return &RestartContainerResponse{}, nil
+}
+
+// DeliverCreateContainerResponse relays a deferred CreateContainer response.
+func (p *plugin) DeliverCreateContainerResponse(ctx context.Context, req *DeliverCreateContainerResponseRequest) (*DeliverCreateContainerResponseResponse, error) {
+	if req.GetResponse().GetDeferred() {
+		return nil, fmt.Errorf("invalid deferred response for container %s: deferred again",
+			req.ContainerId)
+	}
+
+	p.Lock()
+	ch, ok := p.deferred[req.ContainerId]
+	p.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no pending deferred response for container %s",
+			req.ContainerId)
+	}
+
+	select {
+	case ch <- req:
+	default:
+		return nil, fmt.Errorf("deferred response for container %s already delivered",
+			req.ContainerId)
+	}
+
+	log.Infof(ctx, "plugin %q delivered deferred response for container %s",
+		p.name(), req.ContainerId)
+
+	return &DeliverCreateContainerResponseResponse{}, nil
+}
+
+// expect a deferred CreateContainer response for a container.
+func (p *plugin) expectDeferred(id string) {
+	p.Lock()
+	defer p.Unlock()
+	if p.deferred == nil {
+		p.deferred = make(map[string]chan *DeliverCreateContainerResponseRequest)
+	}
+	p.deferred[id] = make(chan *DeliverCreateContainerResponseRequest, 1)
+}
+
+// stop expecting a deferred CreateContainer response for a container.
+func (p *plugin) dropDeferred(id string) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.deferred, id)
+}
+
+// SetNetworkReadiness records the node network readiness reported by the
+// plugin.
+func (p *plugin) SetNetworkReadiness(ctx context.Context, req *SetNetworkReadinessRequest) (*SetNetworkReadinessResponse, error) {
+	if !req.Ready && req.Reason == "" && req.Message == "" {
+		return nil, fmt.Errorf("plugin %q: no reason given for network not being ready", p.name())
+	}
+	p.r.readiness.set(ctx, p.name(), req)
+	return &SetNetworkReadinessResponse{}, nil
+}
+
+// ListPlugins relays the list of active plugins to the plugin.
+func (p *plugin) ListPlugins(ctx context.Context, req *ListPluginsRequest) (*ListPluginsResponse, error) {
+	return &ListPluginsResponse{
+		Plugins: p.r.ListPlugins(),
+	}, nil
+}
+
+// SimulatePod relays dry-run requests for a hypothetical pod to plugins.
+// It is only served to clients. Registered plugins could only call it while
+// handling a request, with the runtime lock already held, and deadlock.
+func (p *plugin) SimulatePod(ctx context.Context, req *SimulatePodRequest) (*SimulatePodResponse, error) {
+	if p.isPlugin() {
+		return nil, fmt.Errorf("plugin %q: pod simulation is only available to clients", p.name())
+	}
+	return p.r.SimulatePod(ctx, req)
+}
+
 // configure the plugin and subscribe it for the events it requested.
-func (p *plugin) configure(ctx context.Context, name, version, config string) error {
+func (p *plugin) configure(ctx context.Context, name, version, config, configType string) error {
 	ctx, cancel := context.WithTimeout(ctx, getPluginRequestTimeout())
 	defer cancel()
 
 	rpl, err := p.stub.Configure(ctx, &ConfigureRequest{
-		Config:         config,
-		RuntimeName:    name,
-		RuntimeVersion: version,
+		Config:           config,
+		ConfigType:       configType,
+		RuntimeName:      name,
+		RuntimeVersion:   version,
+		RuntimeBuildInfo: p.r.buildInfo,
+		Plugins:          p.r.ListPlugins(),
+		Compression: []string{
+			api.CompressionZstd,
+		},
 	})
 	if err != nil {
 		return fmt.Errorf("failed to configure plugin: %w", err)
@@ -397,6 +655,14 @@ func (p *plugin) configure(ctx context.Context, name, version, config string) er
 		events = ValidEvents
 	}
 	p.events = events
+	p.spec = rpl.WantOciSpec
+	p.dryRun = rpl.SupportsDryRun
+	if err := p.r.checkScopeGrant(p.base, rpl.Scopes); err != nil {
+		return err
+	}
+	p.fields = rpl.Fields
+	p.scopes = rpl.Scopes
+	p.lastGen = rpl.LastGeneration
 
 	return nil
 }
@@ -408,51 +674,194 @@ func (p *plugin) synchronize(ctx context.Context, pods []*PodSandbox, containers
 	ctx, cancel := context.WithTimeout(ctx, getPluginRequestTimeout())
 	defer cancel()
 
-	req := &SynchronizeRequest{
-		Pods:       pods,
-		Containers: containers,
+	for _, pod := range pods {
+		p.r.pluginData.inject(pod)
+	}
+
+	req := p.synchronizeRequest(pods, containers)
+	if p.fields != nil {
+		pods, containers := req.Pods, req.Containers
+		req.Pods = make([]*PodSandbox, 0, len(pods))
+		for _, pod := range pods {
+			req.Pods = append(req.Pods, p.fields.FilterPod(pod))
+		}
+		req.Containers = make([]*Container, 0, len(containers))
+		for _, ctr := range containers {
+			req.Containers = append(req.Containers, p.fields.FilterContainer(ctr))
+		}
 	}
+	start := time.Now()
 	rpl, err := p.stub.Synchronize(ctx, req)
+	p.stats.record("Synchronize", start, err)
 	if err != nil {
 		return nil, err
 	}
+	if err = p.checkContainerScopes(nil, rpl.Update); err != nil {
+		return nil, err
+	}
 
 	return rpl.Update, nil
 }
 
+// synchronizeRequest creates a Synchronize request for the plugin. If the
+// plugin knows an earlier generation of the runtime state, and the changes
+// since then are known, the request only carries those changes.
+func (p *plugin) synchronizeRequest(pods []*PodSandbox, containers []*Container) *SynchronizeRequest {
+	req := &SynchronizeRequest{
+		Pods:       pods,
+		Containers: containers,
+		Generation: p.r.state.generation,
+	}
+
+	if p.lastGen == 0 {
+		return req
+	}
+
+	changedPods, changedContainers, ok := p.r.state.since(p.lastGen)
+	if !ok {
+		log.Infof(noCtx, "generation %d of plugin %s too old, fully synchronizing it",
+			p.lastGen, p.name())
+		return req
+	}
+
+	req.Delta = true
+	req.Pods = nil
+	for _, pod := range pods {
+		if _, ok := changedPods[pod.GetId()]; ok {
+			req.Pods = append(req.Pods, pod)
+			delete(changedPods, pod.GetId())
+		}
+	}
+	req.Containers = nil
+	for _, ctr := range containers {
+		if _, ok := changedContainers[ctr.GetId()]; ok {
+			req.Containers = append(req.Containers, ctr)
+			delete(changedContainers, ctr.GetId())
+		}
+	}
+	for id := range changedPods {
+		req.RemovedPods = append(req.RemovedPods, id)
+	}
+	for id := range changedContainers {
+		req.RemovedContainers = append(req.RemovedContainers, id)
+	}
+	sort.Strings(req.RemovedPods)
+	sort.Strings(req.RemovedContainers)
+
+	log.Infof(noCtx, "delta synchronizing plugin %s from generation %d to %d", p.name(),
+		p.lastGen, req.Generation)
+
+	return req
+}
+
 // Relay CreateContainer request to plugin.
 func (p *plugin) createContainer(ctx context.Context, req *CreateContainerRequest) (*CreateContainerResponse, error) {
 	if !p.events.IsSet(Event_CREATE_CONTAINER) {
 		return nil, nil
 	}
 
+	// Only pass the OCI Spec and the optional fields plugins asked for.
+	if (!p.spec && req.OciSpec != nil) || p.fields != nil {
+		filtered := &CreateContainerRequest{
+			Pod:        p.fields.FilterPod(req.Pod),
+			Container:  p.fields.FilterContainer(req.Container),
+			DryRun:     req.DryRun,
+			Generation: req.Generation,
+		}
+		if p.spec {
+			filtered.OciSpec = req.OciSpec
+		}
+		req = filtered
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, getPluginRequestTimeout())
 	defer cancel()
 
+	id := req.GetContainer().GetId()
+	p.expectDeferred(id)
+
+	start := time.Now()
 	rpl, err := p.stub.CreateContainer(ctx, req)
+	p.stats.record("CreateContainer", start, err)
 	if err != nil {
+		p.dropDeferred(id)
 		if isFatalError(err) {
 			log.Errorf(ctx, "closing plugin %s, failed to handle CreateContainer request: %v",
 				p.name(), err)
 			p.close()
 			return nil, nil
 		}
+		return nil, api.FromStatusError(err, p.name())
+	}
+
+	if !rpl.GetDeferred() {
+		p.dropDeferred(id)
+	}
+
+	if err = p.verifyResponse(ctx, "CreateContainer", id, rpl); err != nil {
+		p.dropDeferred(id)
 		return nil, err
 	}
 
 	return rpl, nil
 }
 
+// Wait for a deferred CreateContainer response from the plugin.
+func (p *plugin) deferredCreateContainer(ctx context.Context, id string) (*CreateContainerResponse, error) {
+	p.Lock()
+	ch, ok := p.deferred[id]
+	p.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no pending deferred response from plugin %s", p.name())
+	}
+	defer p.dropDeferred(id)
+
+	ctx, cancel := context.WithTimeout(ctx, getPluginDeferredResponseTimeout())
+	defer cancel()
+
+	select {
+	case req := <-ch:
+		if req.ErrorDetails != nil {
+			return nil, api.FromErrorDetails(req.ErrorDetails, req.Error, p.name())
+		}
+		if req.Error != "" {
+			return nil, fmt.Errorf("plugin %s failed to create container %s: %s",
+				p.name(), id, req.Error)
+		}
+		if err := p.verifyResponse(ctx, "deferred CreateContainer", id, req.Response); err != nil {
+			return nil, err
+		}
+		return req.Response, nil
+	case <-p.closeC:
+		return nil, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("plugin %s failed to deliver deferred response for container %s: %w",
+			p.name(), id, ctx.Err())
+	}
+}
+
 // Relay UpdateContainer request to plugin.
 func (p *plugin) updateContainer(ctx context.Context, req *UpdateContainerRequest) (*UpdateContainerResponse, error) {
 	if !p.events.IsSet(Event_UPDATE_CONTAINER) {
 		return nil, nil
 	}
 
+	if p.fields != nil {
+		req = &UpdateContainerRequest{
+			Pod:            p.fields.FilterPod(req.Pod),
+			Container:      p.fields.FilterContainer(req.Container),
+			LinuxResources: req.LinuxResources,
+			Generation:     req.Generation,
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, getPluginRequestTimeout())
 	defer cancel()
 
+	start := time.Now()
 	rpl, err := p.stub.UpdateContainer(ctx, req)
+	p.stats.record("UpdateContainer", start, err)
 	if err != nil {
 		if isFatalError(err) {
 			log.Errorf(ctx, "closing plugin %s, failed to handle UpdateContainer request: %v",
@@ -460,6 +869,10 @@ func (p *plugin) updateContainer(ctx context.Context, req *UpdateContainerReques
 			p.close()
 			return nil, nil
 		}
+		return nil, api.FromStatusError(err, p.name())
+	}
+
+	if err = p.verifyResponse(ctx, "UpdateContainer", req.GetContainer().GetId(), rpl); err != nil {
 		return nil, err
 	}
 
@@ -472,10 +885,20 @@ func (p *plugin) stopContainer(ctx context.Context, req *StopContainerRequest) (
 		return nil, nil
 	}
 
+	if p.fields != nil {
+		req = &StopContainerRequest{
+			Pod:        p.fields.FilterPod(req.Pod),
+			Container:  p.fields.FilterContainer(req.Container),
+			Generation: req.Generation,
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, getPluginRequestTimeout())
 	defer cancel()
 
+	start := time.Now()
 	rpl, err := p.stub.StopContainer(ctx, req)
+	p.stats.record("StopContainer", start, err)
 	if err != nil {
 		if isFatalError(err) {
 			log.Errorf(ctx, "closing plugin %s, failed to handle StopContainer request: %v",
@@ -483,6 +906,10 @@ func (p *plugin) stopContainer(ctx context.Context, req *StopContainerRequest) (
 			p.close()
 			return nil, nil
 		}
+		return nil, api.FromStatusError(err, p.name())
+	}
+
+	if err = p.verifyResponse(ctx, "StopContainer", req.GetContainer().GetId(), rpl); err != nil {
 		return nil, err
 	}
 
@@ -490,26 +917,32 @@ func (p *plugin) stopContainer(ctx context.Context, req *StopContainerRequest) (
 }
 
 // Relay other pod or container state change events to the plugin.
-func (p *plugin) StateChange(ctx context.Context, evt *StateChangeEvent) error {
+func (p *plugin) StateChange(ctx context.Context, evt *StateChangeEvent) (*StateChangeResponse, error) {
 	if !p.events.IsSet(evt.Event) {
-		return nil
+		return nil, nil
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, getPluginRequestTimeout())
 	defer cancel()
 
-	_, err := p.stub.StateChange(ctx, evt)
+	start := time.Now()
+	rpl, err := p.stub.StateChange(ctx, p.fields.FilterEvent(evt))
+	p.stats.record(hookName(evt.Event), start, err)
 	if err != nil {
 		if isFatalError(err) {
 			log.Errorf(ctx, "closing plugin %s, failed to handle event %d: %v",
 				p.name(), evt.Event, err)
 			p.close()
-			return nil
+			return nil, nil
 		}
-		return err
+		return nil, api.FromStatusError(err, p.name())
 	}
 
-	return nil
+	if err = p.verifyResponse(ctx, evt.Event.String(), evt.GetPod().GetId(), rpl); err != nil {
+		return nil, err
+	}
+
+	return rpl, nil
 }
 
 // isFatalError returns true if the error is fatal and the plugin connection shoudld be closed.
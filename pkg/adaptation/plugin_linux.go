@@ -19,36 +19,71 @@
 package adaptation
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	stdnet "net"
+	"os"
 
 	"golang.org/x/sys/unix"
 )
 
 // getPeerPid returns the process id at the other end of the connection.
 func getPeerPid(conn stdnet.Conn) (int, error) {
-	var cred *unix.Ucred
+	cred, err := getPeerCredentials(conn)
+	if err != nil {
+		return 0, err
+	}
+	return cred.Pid, nil
+}
 
+// getPeerCredentials returns the credentials of the process at the other end
+// of the connection.
+func getPeerCredentials(conn stdnet.Conn) (*PeerCredentials, error) {
+	var cred *unix.Ucred
 	uc, ok := conn.(*stdnet.UnixConn)
 	if !ok {
-		return 0, errors.New("invalid connection, not *net.UnixConn")
+		return nil, errors.New("invalid connection, not *net.UnixConn")
 	}
 
 	raw, err := uc.SyscallConn()
 	if err != nil {
-		return 0, fmt.Errorf("failed get raw unix domain connection: %w", err)
+		return nil, fmt.Errorf("failed get raw unix domain connection: %w", err)
 	}
 
 	ctrlErr := raw.Control(func(fd uintptr) {
 		cred, err = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
 	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to get process credentials: %w", err)
+		return nil, fmt.Errorf("failed to get process credentials: %w", err)
 	}
 	if ctrlErr != nil {
-		return 0, fmt.Errorf("uc.SyscallConn().Control() failed: %w", ctrlErr)
+		return nil, fmt.Errorf("uc.SyscallConn().Control() failed: %w", ctrlErr)
+	}
+
+	return &PeerCredentials{
+		Pid: int(cred.Pid),
+		UID: cred.Uid,
+		GID: cred.Gid,
+	}, nil
+}
+
+// getPeerDigest returns the hex-encoded SHA-256 digest of the executable of
+// the given process. The executable is opened through procfs, so it is the
+// binary the process runs even if the file has been replaced since.
+func getPeerDigest(pid int) (string, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/exe", pid))
+	if err != nil {
+		return "", fmt.Errorf("failed to open executable: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to read executable: %w", err)
 	}
 
-	return int(cred.Pid), nil
+	return hex.EncodeToString(h.Sum(nil)), nil
 }
@@ -28,3 +28,14 @@ import (
 func getPeerPid(conn net.Conn) (int, error) {
 	return 0, fmt.Errorf("getPeerPid() unimplemented on %s", runtime.GOOS)
 }
+
+// getPeerCredentials returns the credentials of the process at the other end
+// of the connection.
+func getPeerCredentials(conn net.Conn) (*PeerCredentials, error) {
+	return nil, fmt.Errorf("getPeerCredentials() unimplemented on %s", runtime.GOOS)
+}
+
+// getPeerDigest returns the digest of the executable of the given process.
+func getPeerDigest(pid int) (string, error) {
+	return "", fmt.Errorf("getPeerDigest() unimplemented on %s", runtime.GOOS)
+}
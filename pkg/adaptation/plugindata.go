@@ -0,0 +1,102 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"fmt"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// pluginData tracks data attached to pods by plugins, and the owners of each key.
+type pluginData struct {
+	pods map[string]*podPluginData
+}
+
+type podPluginData struct {
+	data   map[string]string
+	owners map[string]string
+}
+
+func newPluginData() *pluginData {
+	return &pluginData{
+		pods: make(map[string]*podPluginData),
+	}
+}
+
+// inject the current plugin data of the pod into the pod.
+func (d *pluginData) inject(pod *PodSandbox) {
+	if pod == nil {
+		return
+	}
+	if pd, ok := d.pods[pod.Id]; ok {
+		pod.PluginData = api.DupStringMap(pd.data)
+	} else {
+		pod.PluginData = nil
+	}
+}
+
+// apply changes to the plugin data of a pod requested by a plugin. Changes
+// are applied all or nothing: if the plugin does not own any of the keys it
+// tries to change, none of the changes are applied.
+func (d *pluginData) apply(pod *PodSandbox, plugin string, changes map[string]string) error {
+	if pod == nil || len(changes) == 0 {
+		return nil
+	}
+
+	pd, ok := d.pods[pod.Id]
+	if ok {
+		for k := range changes {
+			key, _ := api.IsMarkedForRemoval(k)
+			if owner, ok := pd.owners[key]; ok && owner != plugin {
+				return fmt.Errorf("plugin %q: pod data %q is owned by plugin %q",
+					plugin, key, owner)
+			}
+		}
+	} else {
+		pd = &podPluginData{
+			data:   make(map[string]string),
+			owners: make(map[string]string),
+		}
+	}
+
+	for k, v := range changes {
+		key, remove := api.IsMarkedForRemoval(k)
+		if remove {
+			delete(pd.data, key)
+			delete(pd.owners, key)
+		} else {
+			pd.data[key] = v
+			pd.owners[key] = plugin
+		}
+	}
+
+	if len(pd.data) == 0 {
+		delete(d.pods, pod.Id)
+	} else {
+		d.pods[pod.Id] = pd
+	}
+
+	return nil
+}
+
+// remove all plugin data of a pod.
+func (d *pluginData) remove(pod *PodSandbox) {
+	if pod != nil {
+		delete(d.pods, pod.Id)
+	}
+}
@@ -0,0 +1,138 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/containerd/nri/pkg/log"
+)
+
+// NetworkReadyFn is a container runtime function called when the node
+// network readiness reported by plugins changes. The runtime is expected to
+// reflect it in the NetworkReady condition of its CRI status, so that the
+// kubelet marks the node network not ready.
+type NetworkReadyFn func(ctx context.Context, ready bool, reason, message string)
+
+// networkReadiness tracks the node network readiness reported by plugins.
+// It has its own lock, since plugins may report readiness while handling
+// events, with the runtime lock held.
+type networkReadiness struct {
+	sync.Mutex
+	notReady map[string]*SetNetworkReadinessRequest
+	fn       NetworkReadyFn
+}
+
+// WithNetworkReadyFn returns an option to set the runtime function called
+// when the node network readiness reported by plugins changes.
+func WithNetworkReadyFn(fn NetworkReadyFn) Option {
+	return func(r *Adaptation) error {
+		r.readiness.fn = fn
+		return nil
+	}
+}
+
+// NetworkReady returns whether plugins consider the node network ready,
+// and if not, the reason and message of the plugins reporting it not ready.
+// Runtimes can use this when they compose the NetworkReady condition of
+// their CRI status.
+func (r *Adaptation) NetworkReady() (bool, string, string) {
+	return r.readiness.get()
+}
+
+// set updates the readiness reported by a plugin.
+func (n *networkReadiness) set(ctx context.Context, plugin string, req *SetNetworkReadinessRequest) {
+	n.update(ctx, plugin, req, false)
+}
+
+// clear drops the readiness reported by a plugin, once it is gone.
+func (n *networkReadiness) clear(ctx context.Context, plugin string) {
+	n.update(ctx, plugin, &SetNetworkReadinessRequest{Ready: true}, true)
+}
+
+// update updates the readiness reported by a plugin, only if the plugin has
+// reported the network not ready if asked so, and notifies the runtime if
+// necessary. The runtime is notified without holding the lock, so that it
+// can call NetworkReady from its callback.
+func (n *networkReadiness) update(ctx context.Context, plugin string, req *SetNetworkReadinessRequest, onlyKnown bool) {
+	n.Lock()
+	if _, known := n.notReady[plugin]; onlyKnown && !known {
+		n.Unlock()
+		return
+	}
+
+	wasReady, _, _ := n.status()
+	if req.Ready {
+		delete(n.notReady, plugin)
+	} else {
+		if n.notReady == nil {
+			n.notReady = make(map[string]*SetNetworkReadinessRequest)
+		}
+		n.notReady[plugin] = req
+	}
+
+	ready, reason, message := n.status()
+	fn := n.fn
+	n.Unlock()
+
+	if ready != wasReady || !ready {
+		log.Infof(ctx, "node network ready: %v (plugin %q reported %v)", ready, plugin, req.Ready)
+		if fn != nil {
+			fn(ctx, ready, reason, message)
+		}
+	}
+}
+
+func (n *networkReadiness) get() (bool, string, string) {
+	n.Lock()
+	defer n.Unlock()
+	return n.status()
+}
+
+// status returns the combined readiness. The reason is the one of the first
+// plugin by name reporting the network not ready, while the message lists
+// all of them.
+func (n *networkReadiness) status() (bool, string, string) {
+	if len(n.notReady) == 0 {
+		return true, "", ""
+	}
+
+	plugins := make([]string, 0, len(n.notReady))
+	for plugin := range n.notReady {
+		plugins = append(plugins, plugin)
+	}
+	sort.Strings(plugins)
+
+	reason := n.notReady[plugins[0]].Reason
+	if reason == "" {
+		reason = "NRIPluginNotReady"
+	}
+	messages := make([]string, 0, len(plugins))
+	for _, plugin := range plugins {
+		req := n.notReady[plugin]
+		msg := req.Message
+		if msg == "" {
+			msg = req.Reason
+		}
+		messages = append(messages, "plugin "+plugin+": "+msg)
+	}
+
+	return false, reason, strings.Join(messages, "; ")
+}
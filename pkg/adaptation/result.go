@@ -17,7 +17,11 @@
 package adaptation
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"path/filepath"
 	"strings"
 )
 
@@ -75,18 +79,21 @@ func collectCreateContainerResult(request *CreateContainerRequest) *result {
 		request.Container.Linux.Resources.Unified = map[string]string{}
 	}
 
-	return &result{
+	r := &result{
 		request: resultRequest{
 			create: request,
 		},
 		reply: resultReply{
 			adjust: &ContainerAdjustment{
 				Annotations: map[string]string{},
+				Labels:      map[string]string{},
 				Mounts:      []*Mount{},
 				Env:         []*KeyValue{},
 				Hooks:       &Hooks{},
+				CDIDevices:  []*CDIDevice{},
 				Linux: &LinuxContainerAdjustment{
 					Devices: []*LinuxDevice{},
+					Sysctl:  map[string]string{},
 					Resources: &LinuxResources{
 						Memory:         &LinuxMemory{},
 						Cpu:            &LinuxCPU{},
@@ -99,6 +106,14 @@ func collectCreateContainerResult(request *CreateContainerRequest) *result {
 		updates: map[string]*ContainerUpdate{},
 		owners:  resultOwners{},
 	}
+
+	// apply pod default rlimits, these can be overridden by plugins
+	for _, rl := range request.GetPod().GetRlimits() {
+		r.reply.adjust.Rlimits = SetRlimit(r.reply.adjust.Rlimits, rl)
+		request.Container.Rlimits = SetRlimit(request.Container.Rlimits, rl)
+	}
+
+	return r
 }
 
 func collectUpdateContainerResult(request *UpdateContainerRequest) *result {
@@ -190,6 +205,9 @@ func (r *result) adjust(rpl *ContainerAdjustment, plugin string) error {
 	if err := r.adjustAnnotations(rpl.Annotations, plugin); err != nil {
 		return err
 	}
+	if err := r.adjustLabels(rpl.Labels, plugin); err != nil {
+		return err
+	}
 	if err := r.adjustMounts(rpl.Mounts, plugin); err != nil {
 		return err
 	}
@@ -199,6 +217,15 @@ func (r *result) adjust(rpl *ContainerAdjustment, plugin string) error {
 	if err := r.adjustHooks(rpl.Hooks, plugin); err != nil {
 		return err
 	}
+	if err := r.adjustCDIDevices(rpl.CDIDevices, plugin); err != nil {
+		return err
+	}
+	if err := r.adjustRlimits(rpl.Rlimits, plugin); err != nil {
+		return err
+	}
+	if err := r.adjustReadonlyRootfs(rpl.ReadonlyRootfs, plugin); err != nil {
+		return err
+	}
 	if rpl.Linux != nil {
 		if err := r.adjustDevices(rpl.Linux.Devices, plugin); err != nil {
 			return err
@@ -209,6 +236,39 @@ func (r *result) adjust(rpl *ContainerAdjustment, plugin string) error {
 		if err := r.adjustCgroupsPath(rpl.Linux.CgroupsPath, plugin); err != nil {
 			return err
 		}
+		if err := r.adjustSeccompProfile(rpl.Linux.SeccompProfile, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustAppArmorProfile(rpl.Linux.ApparmorProfile, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustSysctls(rpl.Linux.Sysctl, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustIDMappings(rpl.Linux.UidMappings, rpl.Linux.GidMappings, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustIOPriority(rpl.Linux.IoPriority, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustScheduler(rpl.Linux.Scheduler, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustMemoryPolicy(rpl.Linux.MemoryPolicy, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustTopologyHints(rpl.Linux.TopologyHints, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustMaskedPaths(rpl.Linux.MaskedPaths, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustReadonlyPaths(rpl.Linux.ReadonlyPaths, plugin); err != nil {
+			return err
+		}
+		if err := r.adjustCapabilities(rpl.Linux.Capabilities, plugin); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -223,6 +283,9 @@ func (r *result) update(updates []*ContainerUpdate, plugin string) error {
 		if err := r.updateResources(reply, u, plugin); err != nil && !u.IgnoreFailure {
 			return err
 		}
+		if err := r.updateScheduling(reply, u, plugin); err != nil && !u.IgnoreFailure {
+			return err
+		}
 	}
 
 	return nil
@@ -234,6 +297,22 @@ func (r *result) adjustAnnotations(annotations map[string]string, plugin string)
 	}
 
 	create, id := r.request.create, r.request.create.Container.Id
+	if create.Container.Annotations == nil {
+		create.Container.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		if key, marked := IsMarkedForSetIfAbsent(k); marked {
+			delete(annotations, k)
+			if _, ok := create.Container.Annotations[key]; ok {
+				continue
+			}
+			if _, ok := annotations[key]; ok {
+				continue
+			}
+			annotations[key] = v
+		}
+	}
+
 	del := map[string]struct{}{}
 	for k := range annotations {
 		if key, marked := IsMarkedForRemoval(k); marked {
@@ -263,6 +342,26 @@ func (r *result) adjustAnnotations(annotations map[string]string, plugin string)
 	return nil
 }
 
+func (r *result) adjustLabels(labels map[string]string, plugin string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	create, id := r.request.create, r.request.create.Container.Id
+	if create.Container.Labels == nil {
+		create.Container.Labels = map[string]string{}
+	}
+
+	return adjustKeyValues(r.reply.adjust.Labels, create.Container.Labels, labels,
+		func(key string) error {
+			return r.owners.claimLabel(id, key, plugin)
+		},
+		func(key string) {
+			r.owners.clearLabel(id, key)
+		},
+	)
+}
+
 func (r *result) adjustMounts(mounts []*Mount, plugin string) error {
 	if len(mounts) == 0 {
 		return nil
@@ -379,6 +478,23 @@ func (r *result) adjustDevices(devices []*LinuxDevice, plugin string) error {
 	return nil
 }
 
+func (r *result) adjustCDIDevices(devices []*CDIDevice, plugin string) error {
+	if len(devices) == 0 {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	for _, d := range devices {
+		if err := r.owners.claimCDIDevice(id, d.Name, plugin); err != nil {
+			return err
+		}
+		r.reply.adjust.CDIDevices = append(r.reply.adjust.CDIDevices, d)
+	}
+
+	return nil
+}
+
 func (r *result) adjustEnv(env []*KeyValue, plugin string) error {
 	if len(env) == 0 {
 		return nil
@@ -386,6 +502,9 @@ func (r *result) adjustEnv(env []*KeyValue, plugin string) error {
 
 	create, id := r.request.create, r.request.create.Container.Id
 
+	// resolve conditional additions against the current environment
+	env = resolveEnvIfAbsent(env, create.Container.Env)
+
 	// first split removals from the rest of adjustments
 	add := []*KeyValue{}
 	del := map[string]struct{}{}
@@ -440,6 +559,38 @@ func (r *result) adjustEnv(env []*KeyValue, plugin string) error {
 	return nil
 }
 
+// resolveEnvIfAbsent turns variables marked to be set if absent into plain
+// additions, or drops them if the variable is already set.
+func resolveEnvIfAbsent(env []*KeyValue, current []string) []*KeyValue {
+	present := map[string]struct{}{}
+	for _, e := range current {
+		key, _ := splitEnvVar(e)
+		present[key] = struct{}{}
+	}
+	for _, e := range env {
+		if _, marked := e.IsMarkedForSetIfAbsent(); !marked {
+			present[e.Key] = struct{}{}
+		}
+	}
+
+	resolved := make([]*KeyValue, 0, len(env))
+	for _, e := range env {
+		if key, marked := e.IsMarkedForSetIfAbsent(); marked {
+			if _, ok := present[key]; ok {
+				continue
+			}
+			present[key] = struct{}{}
+			e = &KeyValue{
+				Key:   key,
+				Value: e.Value,
+			}
+		}
+		resolved = append(resolved, e)
+	}
+
+	return resolved
+}
+
 func splitEnvVar(s string) (string, string) {
 	split := strings.SplitN(s, "=", 2)
 	if len(split) < 1 {
@@ -451,11 +602,36 @@ func splitEnvVar(s string) (string, string) {
 	return split[0], split[1]
 }
 
+func (r *result) adjustRlimits(rlimits []*POSIXRlimit, plugin string) error {
+	if len(rlimits) == 0 {
+		return nil
+	}
+
+	create, id := r.request.create, r.request.create.Container.Id
+
+	for _, rl := range rlimits {
+		if err := rl.Validate(); err != nil {
+			return fmt.Errorf("plugin %q: invalid rlimit: %w", plugin, err)
+		}
+		if err := r.owners.claimRlimit(id, rl.Type, plugin); err != nil {
+			return err
+		}
+		create.Container.Rlimits = SetRlimit(create.Container.Rlimits, rl)
+		r.reply.adjust.Rlimits = SetRlimit(r.reply.adjust.Rlimits, rl)
+	}
+
+	return nil
+}
+
 func (r *result) adjustHooks(hooks *Hooks, plugin string) error {
 	if hooks == nil {
 		return nil
 	}
 
+	if err := hooks.CheckTemplates(); err != nil {
+		return fmt.Errorf("plugin %q: %w", plugin, err)
+	}
+
 	reply := r.reply.adjust
 	container := r.request.create.Container
 
@@ -607,56 +783,895 @@ func (r *result) adjustResources(resources *LinuxResources, plugin string) error
 	}
 
 	for _, l := range resources.HugepageLimits {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("plugin %q: %w", plugin, err)
+		}
 		if err := r.owners.claimHugepageLimit(id, l.PageSize, plugin); err != nil {
 			return err
 		}
-		container.HugepageLimits = append(container.HugepageLimits, l)
-		reply.HugepageLimits = append(reply.HugepageLimits, l)
+		container.HugepageLimits = SetHugepageLimit(container.HugepageLimits, l)
+		reply.HugepageLimits = SetHugepageLimit(reply.HugepageLimits, l)
+	}
+
+	if len(resources.Unified) != 0 {
+		for k, v := range resources.Unified {
+			if err := validateUnified(k, v, plugin); err != nil {
+				return err
+			}
+			if err := r.owners.claimUnified(id, k, plugin); err != nil {
+				return err
+			}
+			container.Unified[k] = v
+			reply.Unified[k] = v
+		}
+	}
+
+	for _, d := range resources.Devices {
+		if err := d.Validate(); err != nil {
+			return fmt.Errorf("plugin %q: %w", plugin, err)
+		}
+		if err := r.owners.claimDeviceCgroup(id, d.Key(), plugin); err != nil {
+			return err
+		}
+		container.Devices = append(container.Devices, d)
+		reply.Devices = append(reply.Devices, d)
+	}
+
+	if v := resources.GetBlockioClass(); v != nil {
+		if err := r.owners.claimBlockioClass(id, plugin); err != nil {
+			return err
+		}
+		container.BlockioClass = String(v.GetValue())
+		reply.BlockioClass = String(v.GetValue())
+	}
+	if blkio := resources.Blockio; blkio != nil {
+		if err := r.owners.claimBlockio(id, blkio, plugin); err != nil {
+			return err
+		}
+		container.Blockio = mergeBlockio(container.Blockio, blkio)
+		reply.Blockio = mergeBlockio(reply.Blockio, blkio)
+	}
+	if v := resources.GetRdtClass(); v != nil {
+		if err := r.owners.claimRdtClass(id, plugin); err != nil {
+			return err
+		}
+		container.RdtClass = String(v.GetValue())
+		reply.RdtClass = String(v.GetValue())
+	}
+	if v := resources.GetRdtClosId(); v != nil {
+		if err := r.owners.claimRdtClosID(id, plugin); err != nil {
+			return err
+		}
+		container.RdtClosId = String(v.GetValue())
+		reply.RdtClosId = String(v.GetValue())
+	}
+
+	return nil
+}
+
+// mergeBlockio merges the block I/O parameters of src into dst.
+func mergeBlockio(dst, src *LinuxBlockIO) *LinuxBlockIO {
+	if dst == nil {
+		dst = &LinuxBlockIO{}
+	}
+	if src.Weight != nil {
+		dst.Weight = UInt32(src.Weight)
+	}
+	for _, d := range src.WeightDevice {
+		merged := false
+		for _, o := range dst.WeightDevice {
+			if o.Major == d.Major && o.Minor == d.Minor {
+				o.Weight = d.Weight
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			dst.WeightDevice = append(dst.WeightDevice, d)
+		}
+	}
+	dst.ThrottleReadBpsDevice = mergeThrottleDevices(dst.ThrottleReadBpsDevice, src.ThrottleReadBpsDevice)
+	dst.ThrottleWriteBpsDevice = mergeThrottleDevices(dst.ThrottleWriteBpsDevice, src.ThrottleWriteBpsDevice)
+	dst.ThrottleReadIopsDevice = mergeThrottleDevices(dst.ThrottleReadIopsDevice, src.ThrottleReadIopsDevice)
+	dst.ThrottleWriteIopsDevice = mergeThrottleDevices(dst.ThrottleWriteIopsDevice, src.ThrottleWriteIopsDevice)
+	return dst
+}
+
+func mergeThrottleDevices(dst, src []*LinuxThrottleDevice) []*LinuxThrottleDevice {
+	for _, d := range src {
+		merged := false
+		for _, o := range dst {
+			if o.Major == d.Major && o.Minor == d.Minor {
+				o.Rate = d.Rate
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			dst = append(dst, d)
+		}
+	}
+	return dst
+}
+
+func (r *result) adjustCgroupsPath(path, plugin string) error {
+	if path == "" {
+		return nil
+	}
+
+	create, id := r.request.create, r.request.create.Container.Id
+
+	if err := r.owners.claimCgroupsPath(id, plugin); err != nil {
+		return err
+	}
+
+	create.Container.Linux.CgroupsPath = path
+	r.reply.adjust.Linux.CgroupsPath = path
+
+	return nil
+}
+
+func (r *result) adjustSeccompProfile(profile *SecurityProfile, plugin string) error {
+	if profile == nil {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	if err := checkSecurityProfile(profile, true); err != nil {
+		return fmt.Errorf("plugin %q: invalid seccomp profile: %w", plugin, err)
+	}
+	if err := r.owners.claimSeccompProfile(id, plugin); err != nil {
+		return err
+	}
+
+	r.reply.adjust.Linux.SeccompProfile = profile
+
+	return nil
+}
+
+func (r *result) adjustAppArmorProfile(profile *SecurityProfile, plugin string) error {
+	if profile == nil {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	if err := checkSecurityProfile(profile, false); err != nil {
+		return fmt.Errorf("plugin %q: invalid AppArmor profile: %w", plugin, err)
+	}
+	if err := r.owners.claimAppArmorProfile(id, plugin); err != nil {
+		return err
+	}
+
+	r.reply.adjust.Linux.ApparmorProfile = profile
+
+	return nil
+}
+
+func (r *result) adjustSysctls(sysctls map[string]string, plugin string) error {
+	if len(sysctls) == 0 {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	return adjustKeyValues(r.reply.adjust.Linux.Sysctl, nil, sysctls,
+		func(key string) error {
+			return r.owners.claimSysctl(id, key, plugin)
+		},
+		func(key string) {
+			r.owners.clearSysctl(id, key)
+		},
+	)
+}
+
+func (r *result) adjustIDMappings(uids, gids []*LinuxIDMapping, plugin string) error {
+	if len(uids) == 0 && len(gids) == 0 {
+		return nil
+	}
+
+	create, id := r.request.create, r.request.create.Container.Id
+
+	if err := r.owners.claimIDMappings(id, plugin); err != nil {
+		return err
+	}
+
+	create.Container.Linux.UidMappings = uids
+	create.Container.Linux.GidMappings = gids
+	r.reply.adjust.Linux.UidMappings = uids
+	r.reply.adjust.Linux.GidMappings = gids
+
+	return nil
+}
+
+func (r *result) adjustIOPriority(prio *LinuxIOPriority, plugin string) error {
+	if prio == nil {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	if err := prio.Validate(); err != nil {
+		return fmt.Errorf("plugin %q: %w", plugin, err)
+	}
+	if err := r.owners.claimIOPriority(id, plugin); err != nil {
+		return err
+	}
+
+	r.reply.adjust.Linux.IoPriority = prio
+
+	return nil
+}
+
+func (r *result) adjustScheduler(sched *LinuxScheduler, plugin string) error {
+	if sched == nil {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	if err := sched.Validate(); err != nil {
+		return fmt.Errorf("plugin %q: %w", plugin, err)
+	}
+	if err := r.owners.claimScheduler(id, plugin); err != nil {
+		return err
+	}
+
+	r.reply.adjust.Linux.Scheduler = sched
+
+	return nil
+}
+
+func (r *result) adjustMemoryPolicy(policy *LinuxMemoryPolicy, plugin string) error {
+	if policy == nil {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	if err := policy.Validate(); err != nil {
+		return fmt.Errorf("plugin %q: invalid memory policy: %w", plugin, err)
+	}
+	if err := r.owners.claimMemoryPolicy(id, plugin); err != nil {
+		return err
+	}
+
+	r.reply.adjust.Linux.MemoryPolicy = policy
+
+	return nil
+}
+
+// adjustTopologyHints collects topology hints. Binding to NUMA nodes is
+// also applied as an adjustment of cpuset memory.
+func (r *result) adjustTopologyHints(hints *LinuxTopologyHints, plugin string) error {
+	if hints == nil {
+		return nil
+	}
+
+	create, id := r.request.create, r.request.create.Container.Id
+
+	if err := hints.Validate(); err != nil {
+		return fmt.Errorf("plugin %q: invalid topology hints: %w", plugin, err)
+	}
+	if err := r.owners.claimTopologyHints(id, plugin); err != nil {
+		return err
+	}
+	if nodes := hints.NumaNodes; nodes != "" {
+		if err := r.owners.claimCpusetMems(id, plugin); err != nil {
+			return err
+		}
+		create.Container.Linux.Resources.Cpu.Mems = nodes
+		r.reply.adjust.Linux.Resources.Cpu.Mems = nodes
+	}
+
+	r.reply.adjust.Linux.TopologyHints = hints
+
+	return nil
+}
+
+func (r *result) adjustReadonlyRootfs(readonly *OptionalBool, plugin string) error {
+	if readonly == nil {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	if err := r.owners.claimReadonlyRootfs(id, plugin); err != nil {
+		return err
+	}
+
+	r.reply.adjust.ReadonlyRootfs = readonly
+
+	return nil
+}
+
+func (r *result) adjustMaskedPaths(paths []string, plugin string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	if err := checkPaths(paths); err != nil {
+		return fmt.Errorf("plugin %q: invalid masked path: %w", plugin, err)
+	}
+
+	collected, err := adjustPaths(r.reply.adjust.Linux.MaskedPaths, paths,
+		func(path string) error {
+			return r.owners.claimMaskedPath(id, path, plugin)
+		},
+		func(path string) {
+			r.owners.clearMaskedPath(id, path)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	r.reply.adjust.Linux.MaskedPaths = collected
+
+	return nil
+}
+
+func (r *result) adjustReadonlyPaths(paths []string, plugin string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	if err := checkPaths(paths); err != nil {
+		return fmt.Errorf("plugin %q: invalid read-only path: %w", plugin, err)
+	}
+
+	collected, err := adjustPaths(r.reply.adjust.Linux.ReadonlyPaths, paths,
+		func(path string) error {
+			return r.owners.claimReadonlyPath(id, path, plugin)
+		},
+		func(path string) {
+			r.owners.clearReadonlyPath(id, path)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	r.reply.adjust.Linux.ReadonlyPaths = collected
+
+	return nil
+}
+
+func (r *result) adjustCapabilities(caps *LinuxCapabilities, plugin string) error {
+	if caps == nil {
+		return nil
+	}
+
+	id := r.request.create.Container.Id
+
+	for _, c := range caps.Add {
+		if err := r.owners.claimCapability(id, c, plugin); err != nil {
+			return err
+		}
+	}
+	for _, c := range caps.Drop {
+		if err := r.owners.claimCapability(id, c, plugin); err != nil {
+			return err
+		}
+	}
+
+	reply := r.reply.adjust.Linux
+	if reply.Capabilities == nil {
+		reply.Capabilities = &LinuxCapabilities{}
+	}
+	reply.Capabilities.Add = append(reply.Capabilities.Add, caps.Add...)
+	reply.Capabilities.Drop = append(reply.Capabilities.Drop, caps.Drop...)
+
+	return nil
+}
+
+// checkPaths checks that all paths, possibly marked for removal, are absolute.
+func checkPaths(paths []string) error {
+	for _, p := range paths {
+		if path, _ := IsMarkedForRemoval(p); !filepath.IsAbs(path) {
+			return fmt.Errorf("relative path %q", path)
+		}
+	}
+	return nil
+}
+
+// adjustPaths merges path adjustments, like masked or read-only paths, into
+// collected. Paths marked for removal stay marked in collected, so that the
+// runtime removes them. The updated collected paths are returned.
+func adjustPaths(collected, adjust []string, claim func(string) error, clear func(string)) ([]string, error) {
+	for _, p := range adjust {
+		path, marked := IsMarkedForRemoval(p)
+		if marked {
+			clear(path)
+		} else {
+			if err := claim(path); err != nil {
+				return nil, err
+			}
+		}
+
+		kept := make([]string, 0, len(collected)+1)
+		for _, c := range collected {
+			if old, _ := IsMarkedForRemoval(c); old != path {
+				kept = append(kept, c)
+			}
+		}
+		collected = append(kept, p)
+	}
+
+	return collected, nil
+}
+
+// adjustKeyValues merges key-value adjustments, like labels or sysctls,
+// into collected, honoring removal and set-if-absent markers. If current
+// is not nil, it holds the present key-values and it is kept up to date.
+func adjustKeyValues(collected, current, adjust map[string]string, claim func(string) error, clear func(string)) error {
+	del := map[string]struct{}{}
+	set := map[string]string{}
+	for k, v := range adjust {
+		if key, marked := IsMarkedForRemoval(k); marked {
+			del[key] = struct{}{}
+			continue
+		}
+		if key, marked := IsMarkedForSetIfAbsent(k); marked {
+			if _, ok := adjust[key]; ok {
+				continue
+			}
+			if _, ok := current[key]; ok {
+				continue
+			}
+			if _, ok := collected[key]; ok {
+				continue
+			}
+			k = key
+		}
+		set[k] = v
+	}
+
+	for k, v := range set {
+		if _, ok := del[k]; ok {
+			clear(k)
+			delete(del, k)
+		}
+		if err := claim(k); err != nil {
+			return err
+		}
+		delete(collected, MarkForRemoval(k))
+		collected[k] = v
+		if current != nil {
+			current[k] = v
+		}
+	}
+
+	for k := range del {
+		clear(k)
+		delete(collected, k)
+		collected[MarkForRemoval(k)] = ""
+		if current != nil {
+			delete(current, k)
+		}
+	}
+
+	return nil
+}
+
+// podResult collects pod adjustments requested by plugins. Annotation and
+// label adjustments are also applied to the pod itself, so that subsequent
+// plugins see them. It also collects the changes requested for retrying a
+// failed pod network setup.
+type podResult struct {
+	reply  *PodSandboxAdjustment
+	retry  *NetworkSetupRetry
+	pod    *PodSandbox
+	owners *owners
+}
+
+func collectPodSandboxResult(pod *PodSandbox) *podResult {
+	if pod == nil {
+		pod = &PodSandbox{}
+	}
+	return &podResult{
+		reply: &PodSandboxAdjustment{
+			Annotations: map[string]string{},
+			Labels:      map[string]string{},
+			Linux: &LinuxPodSandboxAdjustment{
+				Sysctl: map[string]string{},
+			},
+		},
+		retry: &NetworkSetupRetry{
+			CapabilityArgs: map[string]string{},
+		},
+		pod:    pod,
+		owners: &owners{},
+	}
+}
+
+func (r *podResult) adjust(rpl *PodSandboxAdjustment, plugin string) error {
+	if r.pod.Annotations == nil {
+		r.pod.Annotations = map[string]string{}
+	}
+	if r.pod.Labels == nil {
+		r.pod.Labels = map[string]string{}
+	}
+
+	err := adjustKeyValues(r.reply.Annotations, r.pod.Annotations, rpl.GetAnnotations(),
+		func(key string) error {
+			return r.owners.claimAnnotation(key, plugin)
+		},
+		func(key string) {
+			r.owners.clearAnnotation(key)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = adjustKeyValues(r.reply.Labels, r.pod.Labels, rpl.GetLabels(),
+		func(key string) error {
+			return r.owners.claimLabel(key, plugin)
+		},
+		func(key string) {
+			r.owners.clearLabel(key)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	err = adjustKeyValues(r.reply.Linux.Sysctl, nil, rpl.GetLinux().GetSysctl(),
+		func(key string) error {
+			return r.owners.claimSysctl(key, plugin)
+		},
+		func(key string) {
+			r.owners.clearSysctl(key)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = r.adjustNetworkNamespace(rpl.GetLinux().GetNetworkNamespace(), plugin); err != nil {
+		return err
+	}
+
+	err = r.adjustIDMappings(rpl.GetLinux().GetUidMappings(), rpl.GetLinux().GetGidMappings(), plugin)
+	if err != nil {
+		return err
+	}
+
+	if err = r.adjustResources(rpl.GetLinux().GetResources(), plugin); err != nil {
+		return err
+	}
+
+	if err = r.adjustConntrackMax(rpl.GetLinux().GetConntrackMax(), plugin); err != nil {
+		return err
+	}
+
+	if err = r.adjustHostname(rpl.GetHostname(), rpl.GetDomainname(), plugin); err != nil {
+		return err
+	}
+
+	if err = r.adjustRlimits(rpl.GetRlimits(), plugin); err != nil {
+		return err
+	}
+
+	return r.adjustExtraHosts(rpl.GetExtraHosts(), plugin)
+}
+
+func (r *podResult) adjustNetworkNamespace(join *LinuxNamespaceJoin, plugin string) error {
+	if join == nil {
+		return nil
+	}
+
+	switch {
+	case join.Path != "" && join.PodUid != "":
+		return fmt.Errorf("plugin %q: network namespace with both path and pod UID",
+			plugin)
+	case join.Path == "" && join.PodUid == "":
+		return fmt.Errorf("plugin %q: network namespace without path or pod UID",
+			plugin)
+	case join.Path != "" && !filepath.IsAbs(join.Path):
+		return fmt.Errorf("plugin %q: relative network namespace path %q",
+			plugin, join.Path)
+	case join.PodUid != "" && join.PodUid == r.pod.GetUid():
+		return fmt.Errorf("plugin %q: pod cannot share its own network namespace",
+			plugin)
+	}
+
+	if err := r.owners.claimNetworkNamespace(plugin); err != nil {
+		return err
+	}
+	r.reply.Linux.NetworkNamespace = join
+
+	return nil
+}
+
+func (r *podResult) adjustIDMappings(uids, gids []*LinuxIDMapping, plugin string) error {
+	if len(uids) == 0 && len(gids) == 0 {
+		return nil
+	}
+
+	if err := r.owners.claimIDMappings(plugin); err != nil {
+		return err
+	}
+
+	if r.pod.Linux == nil {
+		r.pod.Linux = &LinuxPodSandbox{}
+	}
+	r.pod.Linux.UidMappings = uids
+	r.pod.Linux.GidMappings = gids
+	r.reply.Linux.UidMappings = uids
+	r.reply.Linux.GidMappings = gids
+
+	return nil
+}
+
+func (r *podResult) adjustRlimits(rlimits []*POSIXRlimit, plugin string) error {
+	for _, rl := range rlimits {
+		if err := rl.Validate(); err != nil {
+			return fmt.Errorf("plugin %q: invalid rlimit: %w", plugin, err)
+		}
+		if err := r.owners.claimRlimit(rl.Type, plugin); err != nil {
+			return err
+		}
+		r.pod.Rlimits = SetRlimit(r.pod.Rlimits, rl)
+		r.reply.Rlimits = SetRlimit(r.reply.Rlimits, rl)
+	}
+	return nil
+}
+
+// adjustResources collects adjustments of the pod-level cgroup resources.
+// These are also applied to the pod resources, so that subsequent plugins
+// see them.
+func (r *podResult) adjustResources(resources *LinuxResources, plugin string) error {
+	if resources == nil {
+		return nil
+	}
+
+	if err := checkPodResources(resources); err != nil {
+		return fmt.Errorf("plugin %q: %w", plugin, err)
+	}
+
+	if r.pod.Linux == nil {
+		r.pod.Linux = &LinuxPodSandbox{}
+	}
+	if r.pod.Linux.PodResources == nil {
+		r.pod.Linux.PodResources = &LinuxResources{}
+	}
+	if r.reply.Linux.Resources == nil {
+		r.reply.Linux.Resources = &LinuxResources{}
+	}
+
+	pod, reply := r.pod.Linux.PodResources, r.reply.Linux.Resources
+	for _, res := range []*LinuxResources{pod, reply} {
+		if res.Memory == nil {
+			res.Memory = &LinuxMemory{}
+		}
+		if res.Cpu == nil {
+			res.Cpu = &LinuxCPU{}
+		}
+		if res.Unified == nil {
+			res.Unified = map[string]string{}
+		}
+	}
+
+	if mem := resources.Memory; mem != nil {
+		if v := mem.GetLimit(); v != nil {
+			if err := r.owners.claimMemLimit(plugin); err != nil {
+				return err
+			}
+			pod.Memory.Limit = Int64(v.GetValue())
+			reply.Memory.Limit = Int64(v.GetValue())
+		}
+		if v := mem.GetReservation(); v != nil {
+			if err := r.owners.claimMemReservation(plugin); err != nil {
+				return err
+			}
+			pod.Memory.Reservation = Int64(v.GetValue())
+			reply.Memory.Reservation = Int64(v.GetValue())
+		}
+		if v := mem.GetSwap(); v != nil {
+			if err := r.owners.claimMemSwapLimit(plugin); err != nil {
+				return err
+			}
+			pod.Memory.Swap = Int64(v.GetValue())
+			reply.Memory.Swap = Int64(v.GetValue())
+		}
+	}
+
+	if cpu := resources.Cpu; cpu != nil {
+		if v := cpu.GetShares(); v != nil {
+			if err := r.owners.claimCpuShares(plugin); err != nil {
+				return err
+			}
+			pod.Cpu.Shares = UInt64(v.GetValue())
+			reply.Cpu.Shares = UInt64(v.GetValue())
+		}
+		if v := cpu.GetQuota(); v != nil {
+			if err := r.owners.claimCpuQuota(plugin); err != nil {
+				return err
+			}
+			pod.Cpu.Quota = Int64(v.GetValue())
+			reply.Cpu.Quota = Int64(v.GetValue())
+		}
+		if v := cpu.GetPeriod(); v != nil {
+			if err := r.owners.claimCpuPeriod(plugin); err != nil {
+				return err
+			}
+			pod.Cpu.Period = UInt64(v.GetValue())
+			reply.Cpu.Period = UInt64(v.GetValue())
+		}
+		if v := cpu.GetCpus(); v != "" {
+			if err := r.owners.claimCpusetCpus(plugin); err != nil {
+				return err
+			}
+			pod.Cpu.Cpus = v
+			reply.Cpu.Cpus = v
+		}
+		if v := cpu.GetMems(); v != "" {
+			if err := r.owners.claimCpusetMems(plugin); err != nil {
+				return err
+			}
+			pod.Cpu.Mems = v
+			reply.Cpu.Mems = v
+		}
+	}
+
+	for _, l := range resources.HugepageLimits {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("plugin %q: %w", plugin, err)
+		}
+		if err := r.owners.claimHugepageLimit(l.PageSize, plugin); err != nil {
+			return err
+		}
+		pod.HugepageLimits = SetHugepageLimit(pod.HugepageLimits, l)
+		reply.HugepageLimits = SetHugepageLimit(reply.HugepageLimits, l)
+	}
+
+	for k, v := range resources.Unified {
+		if err := validateUnified(k, v, plugin); err != nil {
+			return err
+		}
+		if err := r.owners.claimUnified(k, plugin); err != nil {
+			return err
+		}
+		pod.Unified[k] = v
+		reply.Unified[k] = v
+	}
+
+	return nil
+}
+
+// checkPodResources checks that only resources which apply to the pod-level
+// cgroup are adjusted.
+func checkPodResources(r *LinuxResources) error {
+	mem, cpu := r.GetMemory(), r.GetCpu()
+	switch {
+	case mem.GetKernel() != nil, mem.GetKernelTcp() != nil:
+		return fmt.Errorf("kernel memory limits cannot be adjusted for pods")
+	case mem.GetSwappiness() != nil, mem.GetDisableOomKiller() != nil, mem.GetUseHierarchy() != nil:
+		return fmt.Errorf("memory swappiness and OOM flags cannot be adjusted for pods")
+	case cpu.GetRealtimeRuntime() != nil, cpu.GetRealtimePeriod() != nil:
+		return fmt.Errorf("CPU realtime parameters cannot be adjusted for pods")
+	case r.GetBlockioClass() != nil, r.GetBlockio() != nil:
+		return fmt.Errorf("block I/O cannot be adjusted for pods")
+	case r.GetRdtClass() != nil, r.GetRdtClosId() != nil:
+		return fmt.Errorf("RDT cannot be adjusted for pods")
+	case len(r.GetDevices()) != 0:
+		return fmt.Errorf("device cgroup rules cannot be adjusted for pods")
+	}
+	return nil
+}
+
+func (r *podResult) adjustConntrackMax(max uint64, plugin string) error {
+	if max == 0 {
+		return nil
+	}
+	if err := r.owners.claimConntrackMax(plugin); err != nil {
+		return err
+	}
+	r.reply.Linux.ConntrackMax = max
+	return nil
+}
+
+func (r *podResult) adjustHostname(hostname, domainname, plugin string) error {
+	if hostname != "" {
+		if err := r.owners.claimHostname(plugin); err != nil {
+			return err
+		}
+		r.reply.Hostname = hostname
+	}
+	if domainname != "" {
+		if err := r.owners.claimDomainname(plugin); err != nil {
+			return err
+		}
+		r.reply.Domainname = domainname
 	}
+	return nil
+}
 
-	if len(resources.Unified) != 0 {
-		for k, v := range resources.Unified {
-			if err := r.owners.claimUnified(id, k, plugin); err != nil {
+func (r *podResult) adjustExtraHosts(hosts []*HostEntry, plugin string) error {
+	for _, h := range hosts {
+		if net.ParseIP(h.Ip) == nil {
+			return fmt.Errorf("plugin %q: invalid IP address %q in hosts entry",
+				plugin, h.Ip)
+		}
+		if len(h.Hostnames) == 0 {
+			return fmt.Errorf("plugin %q: hosts entry for %q without hostnames",
+				plugin, h.Ip)
+		}
+		for _, name := range h.Hostnames {
+			if name == "" || strings.ContainsAny(name, " \t\n#") {
+				return fmt.Errorf("plugin %q: invalid hostname %q in hosts entry",
+					plugin, name)
+			}
+			if err := r.owners.claimHostEntry(name, plugin); err != nil {
 				return err
 			}
-			container.Unified[k] = v
-			reply.Unified[k] = v
 		}
+		r.reply.ExtraHosts = append(r.reply.ExtraHosts, h)
 	}
+	return nil
+}
 
-	if v := resources.GetBlockioClass(); v != nil {
-		if err := r.owners.claimBlockioClass(id, plugin); err != nil {
+func (r *podResult) adjustment() *PodSandboxAdjustment {
+	return r.reply
+}
+
+// adjustRetry collects the changes a plugin requests for retrying a failed
+// pod network setup.
+func (r *podResult) adjustRetry(rpl *NetworkSetupRetry, plugin string) error {
+	if networks := rpl.GetNetworks(); len(networks) > 0 {
+		for _, n := range networks {
+			if n == "" {
+				return fmt.Errorf("plugin %q: invalid empty network name", plugin)
+			}
+		}
+		if err := r.owners.claimNetworks(plugin); err != nil {
 			return err
 		}
-		container.BlockioClass = String(v.GetValue())
-		reply.BlockioClass = String(v.GetValue())
+		r.retry.Networks = networks
 	}
-	if v := resources.GetRdtClass(); v != nil {
-		if err := r.owners.claimRdtClass(id, plugin); err != nil {
+
+	for key, value := range rpl.GetCapabilityArgs() {
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("plugin %q: invalid capability argument %s, not JSON",
+				plugin, key)
+		}
+		if err := r.owners.claimCapabilityArg(key, plugin); err != nil {
 			return err
 		}
-		container.RdtClass = String(v.GetValue())
-		reply.RdtClass = String(v.GetValue())
+		r.retry.CapabilityArgs[key] = value
 	}
 
 	return nil
 }
 
-func (r *result) adjustCgroupsPath(path, plugin string) error {
-	if path == "" {
-		return nil
-	}
-
-	create, id := r.request.create, r.request.create.Container.Id
+func (r *podResult) networkRetry() *NetworkSetupRetry {
+	return r.retry
+}
 
-	if err := r.owners.claimCgroupsPath(id, plugin); err != nil {
-		return err
+func checkSecurityProfile(p *SecurityProfile, allowInline bool) error {
+	switch p.ProfileType {
+	case SecurityProfile_RUNTIME_DEFAULT, SecurityProfile_UNCONFINED:
+		return nil
+	case SecurityProfile_LOCALHOST:
+		if p.LocalhostRef == "" {
+			return errors.New("localhost profile without reference")
+		}
+		return nil
+	case SecurityProfile_INLINE:
+		if !allowInline {
+			return errors.New("inline profiles are not supported")
+		}
+		if p.Inline == "" {
+			return errors.New("empty inline profile")
+		}
+		return nil
 	}
-
-	create.Container.Linux.CgroupsPath = path
-	r.reply.adjust.Linux.CgroupsPath = path
-
-	return nil
+	return fmt.Errorf("unknown profile type %v", p.ProfileType)
 }
 
 func (r *result) updateResources(reply, u *ContainerUpdate, plugin string) error {
@@ -770,10 +1785,13 @@ func (r *result) updateResources(reply, u *ContainerUpdate, plugin string) error
 	}
 
 	for _, l := range u.Linux.Resources.HugepageLimits {
+		if err := l.Validate(); err != nil {
+			return fmt.Errorf("plugin %q: %w", plugin, err)
+		}
 		if err := r.owners.claimHugepageLimit(id, l.PageSize, plugin); err != nil {
 			return err
 		}
-		resources.HugepageLimits = append(resources.HugepageLimits, l)
+		resources.HugepageLimits = SetHugepageLimit(resources.HugepageLimits, l)
 	}
 
 	if len(u.Linux.Resources.Unified) != 0 {
@@ -781,6 +1799,9 @@ func (r *result) updateResources(reply, u *ContainerUpdate, plugin string) error
 			resources.Unified = make(map[string]string)
 		}
 		for k, v := range u.Linux.Resources.Unified {
+			if err := validateUnified(k, v, plugin); err != nil {
+				return err
+			}
 			if err := r.owners.claimUnified(id, k, plugin); err != nil {
 				return err
 			}
@@ -794,12 +1815,24 @@ func (r *result) updateResources(reply, u *ContainerUpdate, plugin string) error
 		}
 		resources.BlockioClass = String(v.GetValue())
 	}
+	if blkio := u.Linux.Resources.Blockio; blkio != nil {
+		if err := r.owners.claimBlockio(id, blkio, plugin); err != nil {
+			return err
+		}
+		resources.Blockio = mergeBlockio(resources.Blockio, blkio)
+	}
 	if v := u.Linux.Resources.GetRdtClass(); v != nil {
 		if err := r.owners.claimRdtClass(id, plugin); err != nil {
 			return err
 		}
 		resources.RdtClass = String(v.GetValue())
 	}
+	if v := u.Linux.Resources.GetRdtClosId(); v != nil {
+		if err := r.owners.claimRdtClosID(id, plugin); err != nil {
+			return err
+		}
+		resources.RdtClosId = String(v.GetValue())
+	}
 
 	// update request/reply from copy on success
 	reply.Linux.Resources = resources.Copy()
@@ -811,6 +1844,36 @@ func (r *result) updateResources(reply, u *ContainerUpdate, plugin string) error
 	return nil
 }
 
+func (r *result) updateScheduling(reply, u *ContainerUpdate, plugin string) error {
+	prio, sched, id := u.GetLinux().GetIoPriority(), u.GetLinux().GetScheduler(), u.ContainerId
+
+	if prio != nil {
+		if err := prio.Validate(); err != nil {
+			return fmt.Errorf("plugin %q: %w", plugin, err)
+		}
+	}
+	if sched != nil {
+		if err := sched.Validate(); err != nil {
+			return fmt.Errorf("plugin %q: %w", plugin, err)
+		}
+	}
+
+	if prio != nil {
+		if err := r.owners.claimIOPriority(id, plugin); err != nil {
+			return err
+		}
+		reply.Linux.IoPriority = prio
+	}
+	if sched != nil {
+		if err := r.owners.claimScheduler(id, plugin); err != nil {
+			return err
+		}
+		reply.Linux.Scheduler = sched
+	}
+
+	return nil
+}
+
 func (r *result) getContainerUpdate(u *ContainerUpdate, plugin string) (*ContainerUpdate, error) {
 	id := u.ContainerId
 	if r.request.create != nil && r.request.create.Container != nil {
@@ -850,8 +1913,10 @@ func (r *result) getContainerUpdate(u *ContainerUpdate, plugin string) (*Contain
 
 type owners struct {
 	annotations         map[string]string
+	labels              map[string]string
 	mounts              map[string]string
 	devices             map[string]string
+	cdiDevices          map[string]string
 	env                 map[string]string
 	memLimit            string
 	memReservation      string
@@ -869,10 +1934,34 @@ type owners struct {
 	cpusetCpus          string
 	cpusetMems          string
 	hugepageLimits      map[string]string
+	deviceCgroups       map[string]string
 	blockioClass        string
+	blockioWeight       string
+	blockioDevices      map[string]string
 	rdtClass            string
+	rdtClosID           string
 	unified             map[string]string
 	cgroupsPath         string
+	seccompProfile      string
+	apparmorProfile     string
+	sysctls             map[string]string
+	idMappings          string
+	ioPriority          string
+	scheduler           string
+	memoryPolicy        string
+	topologyHints       string
+	readonlyRootfs      string
+	maskedPaths         map[string]string
+	readonlyPaths       map[string]string
+	capabilities        map[string]string
+	networkNamespace    string
+	conntrackMax        string
+	hostname            string
+	domainname          string
+	hostEntries         map[string]string
+	rlimits             map[string]string
+	networks            string
+	capabilityArgs      map[string]string
 }
 
 func (ro resultOwners) ownersFor(id string) *owners {
@@ -888,6 +1977,10 @@ func (ro resultOwners) claimAnnotation(id, key, plugin string) error {
 	return ro.ownersFor(id).claimAnnotation(key, plugin)
 }
 
+func (ro resultOwners) claimLabel(id, key, plugin string) error {
+	return ro.ownersFor(id).claimLabel(key, plugin)
+}
+
 func (ro resultOwners) claimMount(id, destination, plugin string) error {
 	return ro.ownersFor(id).claimMount(destination, plugin)
 }
@@ -896,6 +1989,10 @@ func (ro resultOwners) claimDevice(id, path, plugin string) error {
 	return ro.ownersFor(id).claimDevice(path, plugin)
 }
 
+func (ro resultOwners) claimCDIDevice(id, name, plugin string) error {
+	return ro.ownersFor(id).claimCDIDevice(name, plugin)
+}
+
 func (ro resultOwners) claimEnv(id, name, plugin string) error {
 	return ro.ownersFor(id).claimEnv(name, plugin)
 }
@@ -964,14 +2061,26 @@ func (ro resultOwners) claimHugepageLimit(id, size, plugin string) error {
 	return ro.ownersFor(id).claimHugepageLimit(size, plugin)
 }
 
+func (ro resultOwners) claimDeviceCgroup(id, device, plugin string) error {
+	return ro.ownersFor(id).claimDeviceCgroup(device, plugin)
+}
+
 func (ro resultOwners) claimBlockioClass(id, plugin string) error {
 	return ro.ownersFor(id).claimBlockioClass(plugin)
 }
 
+func (ro resultOwners) claimBlockio(id string, blkio *LinuxBlockIO, plugin string) error {
+	return ro.ownersFor(id).claimBlockio(blkio, plugin)
+}
+
 func (ro resultOwners) claimRdtClass(id, plugin string) error {
 	return ro.ownersFor(id).claimRdtClass(plugin)
 }
 
+func (ro resultOwners) claimRdtClosID(id, plugin string) error {
+	return ro.ownersFor(id).claimRdtClosID(plugin)
+}
+
 func (ro resultOwners) claimUnified(id, key, plugin string) error {
 	return ro.ownersFor(id).claimUnified(key, plugin)
 }
@@ -980,6 +2089,58 @@ func (ro resultOwners) claimCgroupsPath(id, plugin string) error {
 	return ro.ownersFor(id).claimCgroupsPath(plugin)
 }
 
+func (ro resultOwners) claimSysctl(id, key, plugin string) error {
+	return ro.ownersFor(id).claimSysctl(key, plugin)
+}
+
+func (ro resultOwners) claimIDMappings(id, plugin string) error {
+	return ro.ownersFor(id).claimIDMappings(plugin)
+}
+
+func (ro resultOwners) claimRlimit(id, typ, plugin string) error {
+	return ro.ownersFor(id).claimRlimit(typ, plugin)
+}
+
+func (ro resultOwners) claimIOPriority(id, plugin string) error {
+	return ro.ownersFor(id).claimIOPriority(plugin)
+}
+
+func (ro resultOwners) claimScheduler(id, plugin string) error {
+	return ro.ownersFor(id).claimScheduler(plugin)
+}
+
+func (ro resultOwners) claimMemoryPolicy(id, plugin string) error {
+	return ro.ownersFor(id).claimMemoryPolicy(plugin)
+}
+
+func (ro resultOwners) claimTopologyHints(id, plugin string) error {
+	return ro.ownersFor(id).claimTopologyHints(plugin)
+}
+
+func (ro resultOwners) claimReadonlyRootfs(id, plugin string) error {
+	return ro.ownersFor(id).claimReadonlyRootfs(plugin)
+}
+
+func (ro resultOwners) claimMaskedPath(id, path, plugin string) error {
+	return ro.ownersFor(id).claimMaskedPath(path, plugin)
+}
+
+func (ro resultOwners) claimReadonlyPath(id, path, plugin string) error {
+	return ro.ownersFor(id).claimReadonlyPath(path, plugin)
+}
+
+func (ro resultOwners) claimCapability(id, capability, plugin string) error {
+	return ro.ownersFor(id).claimCapability(capability, plugin)
+}
+
+func (ro resultOwners) claimSeccompProfile(id, plugin string) error {
+	return ro.ownersFor(id).claimSeccompProfile(plugin)
+}
+
+func (ro resultOwners) claimAppArmorProfile(id, plugin string) error {
+	return ro.ownersFor(id).claimAppArmorProfile(plugin)
+}
+
 func (o *owners) claimAnnotation(key, plugin string) error {
 	if o.annotations == nil {
 		o.annotations = make(map[string]string)
@@ -991,6 +2152,17 @@ func (o *owners) claimAnnotation(key, plugin string) error {
 	return nil
 }
 
+func (o *owners) claimLabel(key, plugin string) error {
+	if o.labels == nil {
+		o.labels = make(map[string]string)
+	}
+	if other, taken := o.labels[key]; taken {
+		return conflict(plugin, other, "label", key)
+	}
+	o.labels[key] = plugin
+	return nil
+}
+
 func (o *owners) claimMount(destination, plugin string) error {
 	if o.mounts == nil {
 		o.mounts = make(map[string]string)
@@ -1013,6 +2185,17 @@ func (o *owners) claimDevice(path, plugin string) error {
 	return nil
 }
 
+func (o *owners) claimCDIDevice(name, plugin string) error {
+	if o.cdiDevices == nil {
+		o.cdiDevices = make(map[string]string)
+	}
+	if other, taken := o.cdiDevices[name]; taken {
+		return conflict(plugin, other, "CDI device", name)
+	}
+	o.cdiDevices[name] = plugin
+	return nil
+}
+
 func (o *owners) claimEnv(name, plugin string) error {
 	if o.env == nil {
 		o.env = make(map[string]string)
@@ -1156,6 +2339,18 @@ func (o *owners) claimHugepageLimit(size, plugin string) error {
 	return nil
 }
 
+func (o *owners) claimDeviceCgroup(device, plugin string) error {
+	if o.deviceCgroups == nil {
+		o.deviceCgroups = make(map[string]string)
+	}
+
+	if other, taken := o.deviceCgroups[device]; taken {
+		return conflict(plugin, other, "device cgroup rule for", device)
+	}
+	o.deviceCgroups[device] = plugin
+	return nil
+}
+
 func (o *owners) claimBlockioClass(plugin string) error {
 	if other := o.blockioClass; other != "" {
 		return conflict(plugin, other, "block I/O class")
@@ -1164,6 +2359,49 @@ func (o *owners) claimBlockioClass(plugin string) error {
 	return nil
 }
 
+func (o *owners) claimBlockio(blkio *LinuxBlockIO, plugin string) error {
+	if blkio.Weight != nil {
+		if other := o.blockioWeight; other != "" {
+			return conflict(plugin, other, "block I/O weight")
+		}
+		o.blockioWeight = plugin
+	}
+	for _, d := range blkio.WeightDevice {
+		if err := o.claimBlockioDevice("block I/O weight", d.Major, d.Minor, plugin); err != nil {
+			return err
+		}
+	}
+	for _, limits := range []struct {
+		kind    string
+		devices []*LinuxThrottleDevice
+	}{
+		{"block I/O read bps limit", blkio.ThrottleReadBpsDevice},
+		{"block I/O write bps limit", blkio.ThrottleWriteBpsDevice},
+		{"block I/O read IOPS limit", blkio.ThrottleReadIopsDevice},
+		{"block I/O write IOPS limit", blkio.ThrottleWriteIopsDevice},
+	} {
+		for _, d := range limits.devices {
+			if err := o.claimBlockioDevice(limits.kind, d.Major, d.Minor, plugin); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (o *owners) claimBlockioDevice(kind string, major, minor int64, plugin string) error {
+	if o.blockioDevices == nil {
+		o.blockioDevices = make(map[string]string)
+	}
+	device := fmt.Sprintf("%d:%d", major, minor)
+	key := kind + " " + device
+	if other, taken := o.blockioDevices[key]; taken {
+		return conflict(plugin, other, kind+" of device", device)
+	}
+	o.blockioDevices[key] = plugin
+	return nil
+}
+
 func (o *owners) claimRdtClass(plugin string) error {
 	if other := o.rdtClass; other != "" {
 		return conflict(plugin, other, "RDT class")
@@ -1172,6 +2410,14 @@ func (o *owners) claimRdtClass(plugin string) error {
 	return nil
 }
 
+func (o *owners) claimRdtClosID(plugin string) error {
+	if other := o.rdtClosID; other != "" {
+		return conflict(plugin, other, "RDT CLOS ID")
+	}
+	o.rdtClosID = plugin
+	return nil
+}
+
 func (o *owners) claimUnified(key, plugin string) error {
 	if o.unified == nil {
 		o.unified = make(map[string]string)
@@ -1191,10 +2437,199 @@ func (o *owners) claimCgroupsPath(plugin string) error {
 	return nil
 }
 
+func (o *owners) claimSysctl(key, plugin string) error {
+	if o.sysctls == nil {
+		o.sysctls = make(map[string]string)
+	}
+	if other, taken := o.sysctls[key]; taken {
+		return conflict(plugin, other, "sysctl", key)
+	}
+	o.sysctls[key] = plugin
+	return nil
+}
+
+func (o *owners) claimIDMappings(plugin string) error {
+	if other := o.idMappings; other != "" {
+		return conflict(plugin, other, "user namespace ID mappings")
+	}
+	o.idMappings = plugin
+	return nil
+}
+
+func (o *owners) claimNetworkNamespace(plugin string) error {
+	if other := o.networkNamespace; other != "" {
+		return conflict(plugin, other, "network namespace")
+	}
+	o.networkNamespace = plugin
+	return nil
+}
+
+func (o *owners) claimConntrackMax(plugin string) error {
+	if other := o.conntrackMax; other != "" {
+		return conflict(plugin, other, "connection tracking limit")
+	}
+	o.conntrackMax = plugin
+	return nil
+}
+
+func (o *owners) claimNetworks(plugin string) error {
+	if other := o.networks; other != "" {
+		return conflict(plugin, other, "networks")
+	}
+	o.networks = plugin
+	return nil
+}
+
+func (o *owners) claimCapabilityArg(key, plugin string) error {
+	if o.capabilityArgs == nil {
+		o.capabilityArgs = make(map[string]string)
+	}
+	if other, taken := o.capabilityArgs[key]; taken {
+		return conflict(plugin, other, "capability argument", key)
+	}
+	o.capabilityArgs[key] = plugin
+	return nil
+}
+
+func (o *owners) claimHostname(plugin string) error {
+	if other := o.hostname; other != "" {
+		return conflict(plugin, other, "hostname")
+	}
+	o.hostname = plugin
+	return nil
+}
+
+func (o *owners) claimDomainname(plugin string) error {
+	if other := o.domainname; other != "" {
+		return conflict(plugin, other, "domainname")
+	}
+	o.domainname = plugin
+	return nil
+}
+
+func (o *owners) claimHostEntry(name, plugin string) error {
+	if o.hostEntries == nil {
+		o.hostEntries = make(map[string]string)
+	}
+	if other, taken := o.hostEntries[name]; taken {
+		return conflict(plugin, other, "hosts entry for", name)
+	}
+	o.hostEntries[name] = plugin
+	return nil
+}
+
+func (o *owners) claimRlimit(typ, plugin string) error {
+	if o.rlimits == nil {
+		o.rlimits = make(map[string]string)
+	}
+	if other, taken := o.rlimits[typ]; taken {
+		return conflict(plugin, other, "rlimit", typ)
+	}
+	o.rlimits[typ] = plugin
+	return nil
+}
+
+func (o *owners) claimIOPriority(plugin string) error {
+	if other := o.ioPriority; other != "" {
+		return conflict(plugin, other, "I/O priority")
+	}
+	o.ioPriority = plugin
+	return nil
+}
+
+func (o *owners) claimScheduler(plugin string) error {
+	if other := o.scheduler; other != "" {
+		return conflict(plugin, other, "scheduling policy")
+	}
+	o.scheduler = plugin
+	return nil
+}
+
+func (o *owners) claimMemoryPolicy(plugin string) error {
+	if other := o.memoryPolicy; other != "" {
+		return conflict(plugin, other, "memory policy")
+	}
+	o.memoryPolicy = plugin
+	return nil
+}
+
+func (o *owners) claimTopologyHints(plugin string) error {
+	if other := o.topologyHints; other != "" {
+		return conflict(plugin, other, "topology hints")
+	}
+	o.topologyHints = plugin
+	return nil
+}
+
+func (o *owners) claimReadonlyRootfs(plugin string) error {
+	if other := o.readonlyRootfs; other != "" {
+		return conflict(plugin, other, "read-only rootfs")
+	}
+	o.readonlyRootfs = plugin
+	return nil
+}
+
+func (o *owners) claimMaskedPath(path, plugin string) error {
+	if o.maskedPaths == nil {
+		o.maskedPaths = make(map[string]string)
+	}
+	if other, taken := o.maskedPaths[path]; taken {
+		return conflict(plugin, other, "masked path", path)
+	}
+	o.maskedPaths[path] = plugin
+	return nil
+}
+
+func (o *owners) claimReadonlyPath(path, plugin string) error {
+	if o.readonlyPaths == nil {
+		o.readonlyPaths = make(map[string]string)
+	}
+	if other, taken := o.readonlyPaths[path]; taken {
+		return conflict(plugin, other, "read-only path", path)
+	}
+	o.readonlyPaths[path] = plugin
+	return nil
+}
+
+func (o *owners) claimCapability(capability, plugin string) error {
+	if o.capabilities == nil {
+		o.capabilities = make(map[string]string)
+	}
+	if other, taken := o.capabilities[capability]; taken {
+		return conflict(plugin, other, "capability", capability)
+	}
+	o.capabilities[capability] = plugin
+	return nil
+}
+
+func (o *owners) claimSeccompProfile(plugin string) error {
+	if other := o.seccompProfile; other != "" {
+		return conflict(plugin, other, "seccomp profile")
+	}
+	o.seccompProfile = plugin
+	return nil
+}
+
+func (o *owners) claimAppArmorProfile(plugin string) error {
+	if other := o.apparmorProfile; other != "" {
+		return conflict(plugin, other, "AppArmor profile")
+	}
+	o.apparmorProfile = plugin
+	return nil
+}
+
 func (ro resultOwners) clearAnnotation(id, key string) {
 	ro.ownersFor(id).clearAnnotation(key)
 }
 
+func (ro resultOwners) clearLabel(id, key string) {
+	ro.ownersFor(id).clearLabel(key)
+}
+
+func (ro resultOwners) clearSysctl(id, key string) {
+	ro.ownersFor(id).clearSysctl(key)
+}
+
 func (ro resultOwners) clearMount(id, destination string) {
 	ro.ownersFor(id).clearMount(destination)
 }
@@ -1207,6 +2642,14 @@ func (ro resultOwners) clearEnv(id, name string) {
 	ro.ownersFor(id).clearEnv(name)
 }
 
+func (ro resultOwners) clearMaskedPath(id, path string) {
+	ro.ownersFor(id).clearMaskedPath(path)
+}
+
+func (ro resultOwners) clearReadonlyPath(id, path string) {
+	ro.ownersFor(id).clearReadonlyPath(path)
+}
+
 func (o *owners) clearAnnotation(key string) {
 	if o.annotations == nil {
 		return
@@ -1214,6 +2657,20 @@ func (o *owners) clearAnnotation(key string) {
 	delete(o.annotations, key)
 }
 
+func (o *owners) clearLabel(key string) {
+	if o.labels == nil {
+		return
+	}
+	delete(o.labels, key)
+}
+
+func (o *owners) clearSysctl(key string) {
+	if o.sysctls == nil {
+		return
+	}
+	delete(o.sysctls, key)
+}
+
 func (o *owners) clearMount(destination string) {
 	if o.mounts == nil {
 		return
@@ -1235,6 +2692,20 @@ func (o *owners) clearEnv(name string) {
 	delete(o.env, name)
 }
 
+func (o *owners) clearMaskedPath(path string) {
+	if o.maskedPaths == nil {
+		return
+	}
+	delete(o.maskedPaths, path)
+}
+
+func (o *owners) clearReadonlyPath(path string) {
+	if o.readonlyPaths == nil {
+		return
+	}
+	delete(o.readonlyPaths, path)
+}
+
 func conflict(plugin, other, subject string, qualif ...string) error {
 	return fmt.Errorf("plugins %q and %q both tried to set %s",
 		plugin, other, strings.Join(append([]string{subject}, qualif...), " "))
@@ -0,0 +1,88 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// WithScopeGrants returns an option to enforce adjustment scopes. Grants
+// map plugin names, without their index, to the scopes they are allowed
+// to use. Once enabled, only plugins which declare their scopes and have
+// all of them granted are accepted. By default scopes are not enforced and
+// plugins are only held to the scopes they declare themselves.
+func WithScopeGrants(grants map[string][]string) Option {
+	return func(r *Adaptation) error {
+		r.scopeGrants = make(map[string]*ScopeMask)
+		for plugin, scopes := range grants {
+			m, err := api.ParseScopeMask(scopes...)
+			if err != nil {
+				return fmt.Errorf("invalid scope grant for plugin %q: %w", plugin, err)
+			}
+			r.scopeGrants[plugin] = m
+		}
+		return nil
+	}
+}
+
+// checkScopeGrant checks if the scopes a plugin declares have been granted.
+func (r *Adaptation) checkScopeGrant(plugin string, scopes *ScopeMask) error {
+	if r.scopeGrants == nil {
+		return nil
+	}
+	if scopes == nil {
+		return fmt.Errorf("plugin %q: no adjustment scopes declared", plugin)
+	}
+
+	granted, ok := r.scopeGrants[plugin]
+	if !ok {
+		granted = &ScopeMask{}
+	}
+	if missing := granted.Missing(scopes); len(missing) > 0 {
+		return fmt.Errorf("plugin %q: adjustment scopes %s not granted", plugin, scopeNames(missing))
+	}
+
+	return nil
+}
+
+// checkScopes checks if a plugin response stays within the declared scopes
+// of the plugin.
+func (p *plugin) checkScopes(used *ScopeMask) error {
+	if missing := p.scopes.Missing(used); len(missing) > 0 {
+		return fmt.Errorf("plugin %q: adjustment scopes %s not declared", p.name(), scopeNames(missing))
+	}
+	return nil
+}
+
+// checkContainerScopes checks if a container adjustment and updates stay
+// within the declared scopes of the plugin.
+func (p *plugin) checkContainerScopes(adjust *ContainerAdjustment, updates []*ContainerUpdate) error {
+	used := adjust.Scopes()
+	used.Scopes |= api.UpdateScopes(updates).Scopes
+	return p.checkScopes(used)
+}
+
+func scopeNames(scopes []api.Scope) string {
+	names := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		names = append(names, strings.ToLower(strings.TrimPrefix(s.String(), "SCOPE_")))
+	}
+	return strings.Join(names, ", ")
+}
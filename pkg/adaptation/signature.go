@@ -0,0 +1,69 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// WithResponseVerification returns an option to verify signed responses of
+// plugins. Keys map plugin names, without their index, to the Ed25519 public
+// keys of the plugins. Responses of plugins with a key are rejected unless
+// they are properly signed, and verified signatures are logged to attribute
+// the adjustments and updates the runtime applies. Responses of plugins
+// without a key are not verified.
+func WithResponseVerification(keys map[string]ed25519.PublicKey) Option {
+	return func(r *Adaptation) error {
+		r.responseKeys = make(map[string]ed25519.PublicKey)
+		for plugin, key := range keys {
+			if len(key) != ed25519.PublicKeySize {
+				return fmt.Errorf("invalid Ed25519 public key size %d for plugin %q",
+					len(key), plugin)
+			}
+			r.responseKeys[plugin] = key
+		}
+		return nil
+	}
+}
+
+// verifyResponse verifies the signature of a plugin response for a pod or
+// container, if the plugin has a key. Empty responses carry nothing to
+// apply, so they need no signature.
+func (p *plugin) verifyResponse(ctx context.Context, request, subject string, rpl api.SignedResponse) error {
+	key, ok := p.r.responseKeys[p.base]
+	if !ok || proto.Size(rpl) == 0 {
+		return nil
+	}
+
+	if err := api.VerifyResponse(key, subject, rpl); err != nil {
+		log.Errorf(ctx, "rejecting %s response of plugin %s for %s: %v", request, p.name(),
+			subject, err)
+		return fmt.Errorf("plugin %s: %s response for %s: %w", p.name(), request, subject, err)
+	}
+
+	log.Infof(ctx, "verified %s response of plugin %s for %s, signature %s", request, p.name(),
+		subject, base64.StdEncoding.EncodeToString(rpl.GetSignature()))
+
+	return nil
+}
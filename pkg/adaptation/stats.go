@@ -0,0 +1,101 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// pluginStats tracks request statistics of a plugin for introspection.
+type pluginStats struct {
+	sync.Mutex
+	hooks     map[string]*HookStats
+	lastError string
+	lastTime  time.Time
+}
+
+// record the outcome and latency of a request to a plugin hook.
+func (s *pluginStats) record(hook string, start time.Time, err error) {
+	latency := int64(time.Since(start))
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.hooks == nil {
+		s.hooks = make(map[string]*HookStats)
+	}
+	h, ok := s.hooks[hook]
+	if !ok {
+		h = &HookStats{Hook: hook}
+		s.hooks[hook] = h
+	}
+
+	h.Calls++
+	h.TotalLatency += latency
+	if latency > h.MaxLatency {
+		h.MaxLatency = latency
+	}
+	if err != nil {
+		h.Errors++
+		s.lastError = err.Error()
+		s.lastTime = time.Now()
+	}
+}
+
+// snapshot returns a copy of the collected statistics.
+func (s *pluginStats) snapshot() *PluginStats {
+	s.Lock()
+	defer s.Unlock()
+
+	stats := &PluginStats{
+		Hooks:     make([]*HookStats, 0, len(s.hooks)),
+		LastError: s.lastError,
+	}
+	if !s.lastTime.IsZero() {
+		stats.LastErrorTime = s.lastTime.UnixNano()
+	}
+	for _, h := range s.hooks {
+		stats.Hooks = append(stats.Hooks, h.DeepCopy())
+	}
+	sort.Slice(stats.Hooks, func(i, j int) bool {
+		return stats.Hooks[i].Hook < stats.Hooks[j].Hook
+	})
+
+	return stats
+}
+
+// hookNames are the names state change events are recorded under.
+var hookNames = func() map[api.Event]string {
+	names := make(map[api.Event]string)
+	for e := api.Event_UNKNOWN + 1; e < api.Event_LAST; e++ {
+		mask := api.EventMask(0)
+		names[e] = mask.Set(e).PrettyString()
+	}
+	return names
+}()
+
+// hookName returns the name a state change event is recorded under.
+func hookName(e api.Event) string {
+	if name, ok := hookNames[e]; ok {
+		return name
+	}
+	return e.String()
+}
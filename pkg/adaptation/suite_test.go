@@ -18,8 +18,10 @@ package adaptation_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
+	stdnet "net"
 	"os"
 	"path/filepath"
 	"sort"
@@ -29,6 +31,9 @@ import (
 
 	nri "github.com/containerd/nri/pkg/adaptation"
 	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/net/multiplex"
+	"github.com/containerd/ttrpc"
+	rspec "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 
 	"github.com/containerd/nri/pkg/stub"
@@ -119,6 +124,21 @@ func (s *Suite) Cleanup() {
 	Expect(os.RemoveAll(s.dir)).To(Succeed())
 }
 
+// connectRuntime connects to the runtime service at the given socket without
+// registering, returning a client for it and a function to disconnect.
+func connectRuntime(socket string) (api.RuntimeService, func()) {
+	conn, err := stdnet.Dial("unix", socket)
+	Expect(err).To(BeNil())
+	mux := multiplex.Multiplex(conn)
+	rconn, err := mux.Open(multiplex.RuntimeServiceConn)
+	Expect(err).To(BeNil())
+	rpcc := ttrpc.NewClient(rconn)
+	return api.NewRuntimeClient(rpcc), func() {
+		rpcc.Close()
+		mux.Close()
+	}
+}
+
 // ------------------------------------
 
 func Log(format string, args ...interface{}) {
@@ -300,19 +320,25 @@ func (m *mockRuntime) update(ctx context.Context, updates []*nri.ContainerUpdate
 }
 
 type mockPlugin struct {
-	name string
-	idx  string
-	stub stub.Stub
-	mask stub.EventMask
+	name      string
+	idx       string
+	stub      stub.Stub
+	mask      stub.EventMask
+	transport api.Transport
+	compress  int
+	scopes    []api.Scope
+	key       ed25519.PrivateKey
 
 	q    *EventQ
 	pods map[string]*api.PodSandbox
 	ctrs map[string]*api.Container
 
 	runPodSandbox       func(*mockPlugin, *api.PodSandbox, *api.Container) error
+	adjustPodSandbox    func(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error)
 	stopPodSandbox      func(*mockPlugin, *api.PodSandbox, *api.Container) error
 	removePodSandbox    func(*mockPlugin, *api.PodSandbox, *api.Container) error
 	createContainer     func(*mockPlugin, *api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)
+	createContainerSpec func(*mockPlugin, *api.PodSandbox, *api.Container, *rspec.Spec) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)
 	postCreateContainer func(*mockPlugin, *api.PodSandbox, *api.Container) error
 	startContainer      func(*mockPlugin, *api.PodSandbox, *api.Container) error
 	postStartContainer  func(*mockPlugin, *api.PodSandbox, *api.Container) error
@@ -320,12 +346,37 @@ type mockPlugin struct {
 	postUpdateContainer func(*mockPlugin, *api.PodSandbox, *api.Container) error
 	stopContainer       func(*mockPlugin, *api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error)
 	removeContainer     func(*mockPlugin, *api.PodSandbox, *api.Container) error
+
+	checkpointPodSandbox func(*mockPlugin, *api.PodSandbox, *api.Container) error
+	restorePodSandbox    func(*mockPlugin, *api.PodSandbox, *api.Container) error
+	checkpointContainer  func(*mockPlugin, *api.PodSandbox, *api.Container) error
+	restoreContainer     func(*mockPlugin, *api.PodSandbox, *api.Container) error
+
+	imagePulled  func(*mockPlugin, *api.Image) error
+	imageRemoved func(*mockPlugin, *api.Image) error
+
+	preExec  func(*mockPlugin, *api.PodSandbox, *api.Container, *api.ExecSession) error
+	postExec func(*mockPlugin, *api.PodSandbox, *api.Container, *api.ExecSession) error
+
+	containerOOM  func(*mockPlugin, *api.PodSandbox, *api.Container) error
+	containerExit func(*mockPlugin, *api.PodSandbox, *api.Container, *api.ContainerExit) error
+
+	updatePodSandbox     func(*mockPlugin, *api.PodSandbox, *api.LinuxResources) error
+	postUpdatePodSandbox func(*mockPlugin, *api.PodSandbox, *api.Container) error
+
+	resourcePressure func(*mockPlugin, *api.PodSandbox, *api.Container, *api.Pressure) error
+
+	networkStatus func(*mockPlugin, []*api.NetworkStatus) error
+	networkGC     func(*mockPlugin, []*api.NetworkAttachment) error
+
+	setupNetworkFailed func(*mockPlugin, *api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error)
 }
 
 var (
 	_ = stub.ConfigureInterface(&mockPlugin{})
 	_ = stub.SynchronizeInterface(&mockPlugin{})
 	_ = stub.RunPodInterface(&mockPlugin{})
+	_ = stub.AdjustPodInterface(&mockPlugin{})
 	_ = stub.StopPodInterface(&mockPlugin{})
 	_ = stub.RemovePodInterface(&mockPlugin{})
 	_ = stub.CreateContainerInterface(&mockPlugin{})
@@ -336,6 +387,22 @@ var (
 	_ = stub.PostCreateContainerInterface(&mockPlugin{})
 	_ = stub.PostStartContainerInterface(&mockPlugin{})
 	_ = stub.PostUpdateContainerInterface(&mockPlugin{})
+	_ = stub.CheckpointPodInterface(&mockPlugin{})
+	_ = stub.RestorePodInterface(&mockPlugin{})
+	_ = stub.CheckpointContainerInterface(&mockPlugin{})
+	_ = stub.RestoreContainerInterface(&mockPlugin{})
+	_ = stub.ImagePulledInterface(&mockPlugin{})
+	_ = stub.ImageRemovedInterface(&mockPlugin{})
+	_ = stub.PreExecInterface(&mockPlugin{})
+	_ = stub.PostExecInterface(&mockPlugin{})
+	_ = stub.ContainerOOMInterface(&mockPlugin{})
+	_ = stub.ContainerExitInterface(&mockPlugin{})
+	_ = stub.UpdatePodInterface(&mockPlugin{})
+	_ = stub.PostUpdatePodInterface(&mockPlugin{})
+	_ = stub.ResourcePressureInterface(&mockPlugin{})
+	_ = stub.NetworkStatusInterface(&mockPlugin{})
+	_ = stub.NetworkGCInterface(&mockPlugin{})
+	_ = stub.SetupNetworkFailedInterface(&mockPlugin{})
 )
 
 func (m *mockPlugin) Log(format string, args ...interface{}) {
@@ -385,12 +452,31 @@ func (m *mockPlugin) Init(dir string) error {
 
 	m.Log("Init()...")
 
-	m.stub, err = stub.New(m,
+	opts := []stub.Option{
 		stub.WithPluginName(m.name),
 		stub.WithPluginIdx(m.idx),
 		stub.WithSocketPath(filepath.Join(dir, "nri.sock")),
 		stub.WithOnClose(m.onClose),
-	)
+	}
+	if m.transport != "" {
+		opts = append(opts, stub.WithTransport(m.transport))
+	}
+	if m.compress != 0 {
+		opts = append(opts, stub.WithCompression(m.compress))
+	}
+	if m.scopes != nil {
+		opts = append(opts, stub.WithScopes(m.scopes...))
+	}
+	if m.key != nil {
+		opts = append(opts, stub.WithSigningKey(m.key))
+	}
+
+	var plugin stub.Plugin = m
+	if m.createContainerSpec != nil {
+		plugin = &specPlugin{m}
+	}
+
+	m.stub, err = stub.New(plugin, opts...)
 	if err != nil {
 		m.q.Add(PluginCreationError)
 		return err
@@ -402,6 +488,9 @@ func (m *mockPlugin) Init(dir string) error {
 	if m.runPodSandbox == nil {
 		m.runPodSandbox = nopEvent
 	}
+	if m.adjustPodSandbox == nil {
+		m.adjustPodSandbox = nopAdjustPodSandbox
+	}
 	if m.stopPodSandbox == nil {
 		m.stopPodSandbox = nopEvent
 	}
@@ -432,6 +521,56 @@ func (m *mockPlugin) Init(dir string) error {
 	if m.removeContainer == nil {
 		m.removeContainer = nopEvent
 	}
+	if m.checkpointPodSandbox == nil {
+		m.checkpointPodSandbox = nopEvent
+	}
+	if m.restorePodSandbox == nil {
+		m.restorePodSandbox = nopEvent
+	}
+	if m.checkpointContainer == nil {
+		m.checkpointContainer = nopEvent
+	}
+	if m.restoreContainer == nil {
+		m.restoreContainer = nopEvent
+	}
+	if m.imagePulled == nil {
+		m.imagePulled = nopImageEvent
+	}
+	if m.imageRemoved == nil {
+		m.imageRemoved = nopImageEvent
+	}
+	if m.preExec == nil {
+		m.preExec = nopExecEvent
+	}
+	if m.postExec == nil {
+		m.postExec = nopExecEvent
+	}
+	if m.containerOOM == nil {
+		m.containerOOM = nopEvent
+	}
+	if m.containerExit == nil {
+		m.containerExit = nopExitEvent
+	}
+	if m.updatePodSandbox == nil {
+		m.updatePodSandbox = nopUpdatePodSandbox
+	}
+	if m.postUpdatePodSandbox == nil {
+		m.postUpdatePodSandbox = nopEvent
+	}
+	if m.resourcePressure == nil {
+		m.resourcePressure = nopPressureEvent
+	}
+	if m.networkStatus == nil {
+		m.networkStatus = func(*mockPlugin, []*api.NetworkStatus) error { return nil }
+	}
+	if m.networkGC == nil {
+		m.networkGC = func(*mockPlugin, []*api.NetworkAttachment) error { return nil }
+	}
+	if m.setupNetworkFailed == nil {
+		m.setupNetworkFailed = func(*mockPlugin, *api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+			return nil, nil
+		}
+	}
 
 	return nil
 }
@@ -500,6 +639,10 @@ func (m *mockPlugin) RunPodSandbox(pod *api.PodSandbox) error {
 	return err
 }
 
+func (m *mockPlugin) AdjustPodSandbox(pod *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+	return m.adjustPodSandbox(m, pod)
+}
+
 func (m *mockPlugin) StopPodSandbox(pod *api.PodSandbox) error {
 	m.pods[pod.Id] = pod
 	err := m.stopPodSandbox(m, pod, nil)
@@ -522,6 +665,55 @@ func (m *mockPlugin) CreateContainer(pod *api.PodSandbox, ctr *api.Container) (*
 	return m.createContainer(m, pod, ctr)
 }
 
+// partialPlugin subscribes to more events than it implements handlers for.
+type partialPlugin struct {
+	stub stub.Stub
+}
+
+func (p *partialPlugin) Start(dir string) error {
+	var err error
+
+	p.stub, err = stub.New(p,
+		stub.WithPluginName("partial"),
+		stub.WithPluginIdx("00"),
+		stub.WithSocketPath(filepath.Join(dir, "nri.sock")),
+		stub.WithOnClose(func() {}),
+	)
+	if err != nil {
+		return err
+	}
+
+	return p.stub.Start(context.Background())
+}
+
+func (p *partialPlugin) Stop() {
+	if p.stub != nil {
+		p.stub.Stop()
+		p.stub.Wait()
+	}
+}
+
+func (p *partialPlugin) Configure(cfg, runtime, version string) (stub.EventMask, error) {
+	return api.MustParseEventMask("StartContainer,StopContainer"), nil
+}
+
+func (p *partialPlugin) StartContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	return nil
+}
+
+// specPlugin is a mockPlugin which wants the OCI Spec of created containers.
+type specPlugin struct {
+	*mockPlugin
+}
+
+func (m *specPlugin) CreateContainerSpec(pod *api.PodSandbox, ctr *api.Container, spec *rspec.Spec) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+	m.pods[pod.Id] = pod
+	m.ctrs[ctr.Id] = ctr
+	m.q.Add(ContainerEvent(ctr, CreateContainer))
+
+	return m.createContainerSpec(m.mockPlugin, pod, ctr, spec)
+}
+
 func (m *mockPlugin) PostCreateContainer(pod *api.PodSandbox, ctr *api.Container) error {
 	m.pods[pod.Id] = pod
 	m.ctrs[ctr.Id] = ctr
@@ -577,10 +769,147 @@ func (m *mockPlugin) RemoveContainer(pod *api.PodSandbox, ctr *api.Container) er
 	return m.removeContainer(m, pod, ctr)
 }
 
+func (m *mockPlugin) CheckpointPodSandbox(pod *api.PodSandbox) error {
+	m.pods[pod.Id] = pod
+	err := m.checkpointPodSandbox(m, pod, nil)
+	m.q.Add(PodSandboxEvent(pod, CheckpointPodSandbox))
+	return err
+}
+
+func (m *mockPlugin) RestorePodSandbox(pod *api.PodSandbox) error {
+	m.pods[pod.Id] = pod
+	err := m.restorePodSandbox(m, pod, nil)
+	m.q.Add(PodSandboxEvent(pod, RestorePodSandbox))
+	return err
+}
+
+func (m *mockPlugin) CheckpointContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	m.pods[pod.Id] = pod
+	m.ctrs[ctr.Id] = ctr
+	m.q.Add(ContainerEvent(ctr, CheckpointContainer))
+
+	return m.checkpointContainer(m, pod, ctr)
+}
+
+func (m *mockPlugin) RestoreContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	m.pods[pod.Id] = pod
+	m.ctrs[ctr.Id] = ctr
+	m.q.Add(ContainerEvent(ctr, RestoreContainer))
+
+	return m.restoreContainer(m, pod, ctr)
+}
+
+func (m *mockPlugin) ImagePulled(img *api.Image) error {
+	m.q.Add(ImageEvent(img, ImagePulled))
+	return m.imagePulled(m, img)
+}
+
+func (m *mockPlugin) ImageRemoved(img *api.Image) error {
+	m.q.Add(ImageEvent(img, ImageRemoved))
+	return m.imageRemoved(m, img)
+}
+
+func (m *mockPlugin) PreExec(pod *api.PodSandbox, ctr *api.Container, exec *api.ExecSession) error {
+	m.pods[pod.Id] = pod
+	m.ctrs[ctr.Id] = ctr
+	m.q.Add(ContainerEvent(ctr, PreExec))
+
+	return m.preExec(m, pod, ctr, exec)
+}
+
+func (m *mockPlugin) PostExec(pod *api.PodSandbox, ctr *api.Container, exec *api.ExecSession) error {
+	m.pods[pod.Id] = pod
+	m.ctrs[ctr.Id] = ctr
+	m.q.Add(ContainerEvent(ctr, PostExec))
+
+	return m.postExec(m, pod, ctr, exec)
+}
+
+func (m *mockPlugin) ContainerOOM(pod *api.PodSandbox, ctr *api.Container) error {
+	m.pods[pod.Id] = pod
+	m.ctrs[ctr.Id] = ctr
+	m.q.Add(ContainerEvent(ctr, ContainerOOM))
+
+	return m.containerOOM(m, pod, ctr)
+}
+
+func (m *mockPlugin) ContainerExit(pod *api.PodSandbox, ctr *api.Container, exit *api.ContainerExit) error {
+	m.pods[pod.Id] = pod
+	m.ctrs[ctr.Id] = ctr
+	m.q.Add(ContainerEvent(ctr, ContainerExit))
+
+	return m.containerExit(m, pod, ctr, exit)
+}
+
+func (m *mockPlugin) UpdatePodSandbox(pod *api.PodSandbox, resources *api.LinuxResources) error {
+	m.pods[pod.Id] = pod
+	err := m.updatePodSandbox(m, pod, resources)
+	m.q.Add(PodSandboxEvent(pod, UpdatePodSandbox))
+	return err
+}
+
+func (m *mockPlugin) PostUpdatePodSandbox(pod *api.PodSandbox) error {
+	m.pods[pod.Id] = pod
+	err := m.postUpdatePodSandbox(m, pod, nil)
+	m.q.Add(PodSandboxEvent(pod, PostUpdatePodSandbox))
+	return err
+}
+
+func (m *mockPlugin) ResourcePressure(pod *api.PodSandbox, ctr *api.Container, pressure *api.Pressure) error {
+	switch {
+	case ctr != nil:
+		m.q.Add(ContainerEvent(ctr, ResourcePressure))
+	case pod != nil:
+		m.q.Add(PodSandboxEvent(pod, ResourcePressure))
+	default:
+		m.q.Add(&Event{Type: ResourcePressure})
+	}
+	return m.resourcePressure(m, pod, ctr, pressure)
+}
+
+func (m *mockPlugin) NetworkStatus(networks []*api.NetworkStatus) error {
+	m.q.Add(&Event{Type: NetworkStatus})
+	return m.networkStatus(m, networks)
+}
+
+func (m *mockPlugin) NetworkGC(attachments []*api.NetworkAttachment) error {
+	m.q.Add(&Event{Type: NetworkGC})
+	return m.networkGC(m, attachments)
+}
+
+func (m *mockPlugin) SetupNetworkFailed(pod *api.PodSandbox, failure *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+	m.q.Add(PodSandboxEvent(pod, SetupNetworkFailed))
+	return m.setupNetworkFailed(m, pod, failure)
+}
+
 func nopEvent(*mockPlugin, *api.PodSandbox, *api.Container) error {
 	return nil
 }
 
+func nopImageEvent(*mockPlugin, *api.Image) error {
+	return nil
+}
+
+func nopExecEvent(*mockPlugin, *api.PodSandbox, *api.Container, *api.ExecSession) error {
+	return nil
+}
+
+func nopExitEvent(*mockPlugin, *api.PodSandbox, *api.Container, *api.ContainerExit) error {
+	return nil
+}
+
+func nopPressureEvent(*mockPlugin, *api.PodSandbox, *api.Container, *api.Pressure) error {
+	return nil
+}
+
+func nopUpdatePodSandbox(*mockPlugin, *api.PodSandbox, *api.LinuxResources) error {
+	return nil
+}
+
+func nopAdjustPodSandbox(*mockPlugin, *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+	return nil, nil
+}
+
 func nopCreateContainer(*mockPlugin, *api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
 	return nil, nil, nil
 }
@@ -617,6 +946,30 @@ const (
 	PostStartContainer  = "PostStartContainer"
 	PostUpdateContainer = "PostUpdateContainer"
 
+	CheckpointPodSandbox = "CheckpointPodSandbox"
+	RestorePodSandbox    = "RestorePodSandbox"
+	CheckpointContainer  = "CheckpointContainer"
+	RestoreContainer     = "RestoreContainer"
+
+	ImagePulled  = "ImagePulled"
+	ImageRemoved = "ImageRemoved"
+
+	PreExec  = "PreExec"
+	PostExec = "PostExec"
+
+	ContainerOOM  = "ContainerOOM"
+	ContainerExit = "ContainerExit"
+
+	UpdatePodSandbox     = "UpdatePodSandbox"
+	PostUpdatePodSandbox = "PostUpdatePodSandbox"
+
+	ResourcePressure = "ResourcePressure"
+
+	NetworkStatus = "NetworkStatus"
+	NetworkGC     = "NetworkGC"
+
+	SetupNetworkFailed = "SetupNetworkFailed"
+
 	Error   = "Error"
 	Timeout = ""
 )
@@ -625,6 +978,7 @@ type Event struct {
 	Type EventType
 	Pod  *api.PodSandbox
 	Ctr  *api.Container
+	Img  *api.Image
 }
 
 var (
@@ -642,6 +996,9 @@ var (
 	ContainerEvent = func(ctr *api.Container, t EventType) *Event {
 		return &Event{Type: t, Ctr: ctr}
 	}
+	ImageEvent = func(img *api.Image, t EventType) *Event {
+		return &Event{Type: t, Img: img}
+	}
 )
 
 func (e *Event) Matches(o *Event) bool {
@@ -658,6 +1015,11 @@ func (e *Event) Matches(o *Event) bool {
 			return false
 		}
 	}
+	if e.Img != nil && o.Img != nil {
+		if e.Img.Name != o.Img.Name || e.Img.Digest != o.Img.Digest {
+			return false
+		}
+	}
 	return true
 }
 
@@ -668,6 +1030,8 @@ func (e *Event) String() string {
 		str += e.Ctr.PodSandboxId + ":" + e.Ctr.Id + "/"
 	case e.Pod != nil:
 		str += e.Pod.Id + "/"
+	case e.Img != nil:
+		str += e.Img.Name + "/"
 	}
 	return str + string(e.Type)
 }
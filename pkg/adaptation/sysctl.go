@@ -0,0 +1,48 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// checkSysctls checks if a plugin is allowed to adjust the given sysctls.
+func (r *Adaptation) checkSysctls(sysctls map[string]string, plugin string) error {
+	for k := range sysctls {
+		key, _ := IsMarkedForRemoval(k)
+		if !r.isSysctlAllowed(key) {
+			return fmt.Errorf("plugin %q: adjusting sysctl %q is not allowed", plugin, key)
+		}
+	}
+	return nil
+}
+
+// isSysctlAllowed checks if a sysctl matches any pattern in the allowlist.
+// Patterns are either full sysctl names, or prefixes with a trailing '*'.
+func (r *Adaptation) isSysctlAllowed(key string) bool {
+	for _, pattern := range r.sysctls {
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(key, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+		} else if key == pattern {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"bytes"
+	"net"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// http2Preface is sent by gRPC clients as the first data on a connection.
+var http2Preface = []byte("PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n")
+
+// detectTransport detects the transport used by a plugin by peeking at the
+// first data it sends. It returns a net.Conn which replays the peeked data.
+func detectTransport(conn net.Conn) (api.Transport, net.Conn, error) {
+	var (
+		peek []byte
+		buf  = make([]byte, 4096)
+	)
+
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return "", nil, err
+		}
+		peek = append(peek, buf[:n]...)
+
+		cnt := len(peek)
+		if cnt > len(http2Preface) {
+			cnt = len(http2Preface)
+		}
+		if !bytes.Equal(peek[:cnt], http2Preface[:cnt]) {
+			return api.TransportTTRPC, &replayConn{Conn: conn, peek: peek}, nil
+		}
+		if cnt == len(http2Preface) {
+			return api.TransportGRPC, &replayConn{Conn: conn, peek: peek}, nil
+		}
+	}
+}
+
+// replayConn replays already read data before reading from its connection.
+type replayConn struct {
+	net.Conn
+	peek []byte
+}
+
+func (c *replayConn) Read(buf []byte) (int, error) {
+	if len(c.peek) > 0 {
+		n := copy(buf, c.peek)
+		c.peek = c.peek[n:]
+		return n, nil
+	}
+	return c.Conn.Read(buf)
+}
@@ -0,0 +1,41 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validateUnified checks that a unified (cgroup v2) resource adjusted by a
+// plugin names a controller interface file, like cpu.idle or memory.high,
+// and that its value is a single line. The core cgroup.* interface files
+// control the hierarchy itself and cannot be adjusted by plugins.
+func validateUnified(key, value, plugin string) error {
+	controller, file, ok := strings.Cut(key, ".")
+	switch {
+	case !ok || controller == "" || file == "" || strings.ContainsAny(key, "/\x00"):
+		return fmt.Errorf("plugin %q: invalid unified resource %q", plugin, key)
+	case controller == "cgroup":
+		return fmt.Errorf("plugin %q: adjusting unified resource %q is not allowed",
+			plugin, key)
+	case strings.ContainsAny(value, "\n\x00"):
+		return fmt.Errorf("plugin %q: invalid value %q for unified resource %q",
+			plugin, value, key)
+	}
+	return nil
+}
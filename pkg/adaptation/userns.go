@@ -0,0 +1,145 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package adaptation
+
+import (
+	"fmt"
+	"math"
+)
+
+// IDRange is a range of host user or group IDs.
+type IDRange struct {
+	// Start is the first ID in the range.
+	Start uint32
+	// Size is the number of IDs in the range.
+	Size uint32
+}
+
+func (rng IDRange) validate() error {
+	if rng.Size == 0 {
+		return fmt.Errorf("invalid user namespace ID range %d+%d: empty range",
+			rng.Start, rng.Size)
+	}
+	if uint64(rng.Start)+uint64(rng.Size) > math.MaxUint32+1 {
+		return fmt.Errorf("invalid user namespace ID range %d+%d: out of bounds",
+			rng.Start, rng.Size)
+	}
+	return nil
+}
+
+// contains checks if the range contains the given host IDs.
+func (rng IDRange) contains(start, size uint32) bool {
+	return start >= rng.Start &&
+		uint64(start)+uint64(size) <= uint64(rng.Start)+uint64(rng.Size)
+}
+
+// checkIDMappings checks if a plugin is allowed to set the given user
+// namespace UID and GID mappings.
+func (r *Adaptation) checkIDMappings(uids, gids []*LinuxIDMapping, plugin string) error {
+	if len(uids) == 0 && len(gids) == 0 {
+		return nil
+	}
+	if len(uids) == 0 || len(gids) == 0 {
+		return fmt.Errorf("plugin %q: both UID and GID mappings must be set", plugin)
+	}
+	if err := checkIDMapping("UID", uids, r.uidRanges); err != nil {
+		return fmt.Errorf("plugin %q: %w", plugin, err)
+	}
+	if err := checkIDMapping("GID", gids, r.gidRanges); err != nil {
+		return fmt.Errorf("plugin %q: %w", plugin, err)
+	}
+	return nil
+}
+
+// checkMountIDMappings checks the ID mappings of the idmapped mounts a
+// plugin adds. These map host IDs just like user namespaces do, so they
+// are restricted to the same allowed host ranges.
+func (r *Adaptation) checkMountIDMappings(mounts []*Mount, plugin string) error {
+	for _, m := range mounts {
+		uids, gids := m.GetUidMappings(), m.GetGidMappings()
+		if len(uids) == 0 && len(gids) == 0 {
+			continue
+		}
+		if len(uids) == 0 || len(gids) == 0 {
+			return fmt.Errorf("plugin %q: mount %s: both UID and GID mappings must be set",
+				plugin, m.Destination)
+		}
+		if err := checkIDMapping("UID", uids, r.uidRanges); err != nil {
+			return fmt.Errorf("plugin %q: mount %s: %w", plugin, m.Destination, err)
+		}
+		if err := checkIDMapping("GID", gids, r.gidRanges); err != nil {
+			return fmt.Errorf("plugin %q: mount %s: %w", plugin, m.Destination, err)
+		}
+	}
+	return nil
+}
+
+// checkIDMapping checks that the given mappings are well-formed, that they
+// do not overlap, and that they map host IDs only from the allowed ranges.
+func checkIDMapping(kind string, mappings []*LinuxIDMapping, allowed []IDRange) error {
+	if err := validateIDMapping(kind, mappings); err != nil {
+		return err
+	}
+
+	for _, m := range mappings {
+		ok := false
+		for _, rng := range allowed {
+			if rng.contains(m.HostId, m.Size) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf("%s mapping %d:%d:%d is outside of the allowed host ranges",
+				kind, m.ContainerId, m.HostId, m.Size)
+		}
+	}
+	return nil
+}
+
+// validateIDMapping checks that the given mappings are well-formed and that
+// they do not overlap in the container or on the host.
+func validateIDMapping(kind string, mappings []*LinuxIDMapping) error {
+	for i, m := range mappings {
+		if m.Size == 0 {
+			return fmt.Errorf("invalid %s mapping %d:%d:%d: empty range",
+				kind, m.ContainerId, m.HostId, m.Size)
+		}
+		if uint64(m.ContainerId)+uint64(m.Size) > math.MaxUint32+1 ||
+			uint64(m.HostId)+uint64(m.Size) > math.MaxUint32+1 {
+			return fmt.Errorf("invalid %s mapping %d:%d:%d: out of bounds",
+				kind, m.ContainerId, m.HostId, m.Size)
+		}
+
+		for _, o := range mappings[:i] {
+			if overlaps(m.ContainerId, m.Size, o.ContainerId, o.Size) {
+				return fmt.Errorf("%s mapping %d:%d:%d overlaps container IDs of %d:%d:%d",
+					kind, m.ContainerId, m.HostId, m.Size, o.ContainerId, o.HostId, o.Size)
+			}
+			if overlaps(m.HostId, m.Size, o.HostId, o.Size) {
+				return fmt.Errorf("%s mapping %d:%d:%d overlaps host IDs of %d:%d:%d",
+					kind, m.ContainerId, m.HostId, m.Size, o.ContainerId, o.HostId, o.Size)
+			}
+		}
+	}
+	return nil
+}
+
+// overlaps checks if two ID ranges overlap.
+func overlaps(s1, n1, s2, n2 uint32) bool {
+	return uint64(s1) < uint64(s2)+uint64(n2) && uint64(s2) < uint64(s1)+uint64(n1)
+}
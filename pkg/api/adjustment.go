@@ -16,6 +16,10 @@
 
 package api
 
+import (
+	"path/filepath"
+)
+
 //
 // Notes:
 //   Adjustment of metadata that is stored in maps (labels and annotations)
@@ -47,6 +51,32 @@ func (a *ContainerAdjustment) RemoveAnnotation(key string) {
 	a.Annotations[MarkForRemoval(key)] = ""
 }
 
+// AddAnnotationIfAbsent records the addition of the annotation key=value,
+// unless the container already has an annotation for key.
+func (a *ContainerAdjustment) AddAnnotationIfAbsent(key, value string) {
+	a.initAnnotations()
+	a.Annotations[MarkForSetIfAbsent(key)] = value
+}
+
+// AddLabel records the addition of the label key=value.
+func (a *ContainerAdjustment) AddLabel(key, value string) {
+	a.initLabels()
+	a.Labels[key] = value
+}
+
+// RemoveLabel records the removal of the label for the given key.
+func (a *ContainerAdjustment) RemoveLabel(key string) {
+	a.initLabels()
+	a.Labels[MarkForRemoval(key)] = ""
+}
+
+// AddLabelIfAbsent records the addition of the label key=value, unless
+// the container already has a label for key.
+func (a *ContainerAdjustment) AddLabelIfAbsent(key, value string) {
+	a.initLabels()
+	a.Labels[MarkForSetIfAbsent(key)] = value
+}
+
 // AddMount records the addition of a mount to a container.
 func (a *ContainerAdjustment) AddMount(m *Mount) {
 	a.Mounts = append(a.Mounts, m) // TODO: should we dup m here ?
@@ -80,6 +110,15 @@ func (a *ContainerAdjustment) RemoveEnv(key string) {
 	})
 }
 
+// AddEnvIfAbsent records the addition of an environment variable to a
+// container, unless the container already has that variable set.
+func (a *ContainerAdjustment) AddEnvIfAbsent(key, value string) {
+	a.Env = append(a.Env, &KeyValue{
+		Key:   MarkForSetIfAbsent(key),
+		Value: value,
+	})
+}
+
 // AddHooks records the addition of the given hooks to a container.
 func (a *ContainerAdjustment) AddHooks(h *Hooks) {
 	a.initHooks()
@@ -120,6 +159,42 @@ func (a *ContainerAdjustment) RemoveDevice(path string) {
 	})
 }
 
+// AddLinuxDeviceCgroupRule records the addition of a device cgroup rule to a
+// container without injecting any device node. This can be used to grant
+// access to devices already present in the container. A nil major or minor
+// number matches any device.
+func (a *ContainerAdjustment) AddLinuxDeviceCgroupRule(allow bool, typ string, major, minor *int64, access string) {
+	a.initLinuxResources()
+	a.Linux.Resources.Devices = append(a.Linux.Resources.Devices, &LinuxDeviceCgroup{
+		Allow:  allow,
+		Type:   typ,
+		Major:  Int64(major),
+		Minor:  Int64(minor),
+		Access: access,
+	})
+}
+
+// AddCDIDevice records the injection of the given CDI device to a container.
+// The device is resolved and injected by the runtime.
+func (a *ContainerAdjustment) AddCDIDevice(d *CDIDevice) {
+	a.CDIDevices = append(a.CDIDevices, d)
+}
+
+// AddRlimit records setting a POSIX rlimit for a container.
+func (a *ContainerAdjustment) AddRlimit(typ string, hard, soft uint64) {
+	a.Rlimits = SetRlimit(a.Rlimits, &POSIXRlimit{
+		Type: typ,
+		Hard: hard,
+		Soft: soft,
+	})
+}
+
+// SetReadonlyRootfs records making the root filesystem of a container
+// read-only, or writable.
+func (a *ContainerAdjustment) SetReadonlyRootfs(readonly bool) {
+	a.ReadonlyRootfs = Bool(readonly)
+}
+
 // SetLinuxMemoryLimit records setting the memory limit for a container.
 func (a *ContainerAdjustment) SetLinuxMemoryLimit(value int64) {
 	a.initLinuxResourcesMemory()
@@ -213,7 +288,7 @@ func (a *ContainerAdjustment) SetLinuxCPUSetMems(value string) {
 // AddLinuxHugepageLimit records adding a hugepage limit for a container.
 func (a *ContainerAdjustment) AddLinuxHugepageLimit(pageSize string, value uint64) {
 	a.initLinuxResources()
-	a.Linux.Resources.HugepageLimits = append(a.Linux.Resources.HugepageLimits,
+	a.Linux.Resources.HugepageLimits = SetHugepageLimit(a.Linux.Resources.HugepageLimits,
 		&HugepageLimit{
 			PageSize: pageSize,
 			Limit:    value,
@@ -226,12 +301,63 @@ func (a *ContainerAdjustment) SetLinuxBlockIOClass(value string) {
 	a.Linux.Resources.BlockioClass = String(value)
 }
 
+// SetLinuxBlockIOWeight records setting the block I/O weight for a container.
+func (a *ContainerAdjustment) SetLinuxBlockIOWeight(value uint16) {
+	a.initLinuxResourcesBlockIO()
+	a.Linux.Resources.Blockio.Weight = UInt32(uint32(value))
+}
+
+// AddLinuxBlockIOWeightDevice records setting the block I/O weight of a device for a container.
+func (a *ContainerAdjustment) AddLinuxBlockIOWeightDevice(major, minor int64, value uint16) {
+	a.initLinuxResourcesBlockIO()
+	a.Linux.Resources.Blockio.WeightDevice = append(a.Linux.Resources.Blockio.WeightDevice,
+		&LinuxWeightDevice{
+			Major:  major,
+			Minor:  minor,
+			Weight: uint32(value),
+		})
+}
+
+// AddLinuxBlockIOThrottleReadBps records setting the read bandwidth limit of a device for a container.
+func (a *ContainerAdjustment) AddLinuxBlockIOThrottleReadBps(major, minor int64, rate uint64) {
+	a.initLinuxResourcesBlockIO()
+	a.Linux.Resources.Blockio.ThrottleReadBpsDevice = append(a.Linux.Resources.Blockio.ThrottleReadBpsDevice,
+		&LinuxThrottleDevice{Major: major, Minor: minor, Rate: rate})
+}
+
+// AddLinuxBlockIOThrottleWriteBps records setting the write bandwidth limit of a device for a container.
+func (a *ContainerAdjustment) AddLinuxBlockIOThrottleWriteBps(major, minor int64, rate uint64) {
+	a.initLinuxResourcesBlockIO()
+	a.Linux.Resources.Blockio.ThrottleWriteBpsDevice = append(a.Linux.Resources.Blockio.ThrottleWriteBpsDevice,
+		&LinuxThrottleDevice{Major: major, Minor: minor, Rate: rate})
+}
+
+// AddLinuxBlockIOThrottleReadIOPS records setting the read IOPS limit of a device for a container.
+func (a *ContainerAdjustment) AddLinuxBlockIOThrottleReadIOPS(major, minor int64, rate uint64) {
+	a.initLinuxResourcesBlockIO()
+	a.Linux.Resources.Blockio.ThrottleReadIopsDevice = append(a.Linux.Resources.Blockio.ThrottleReadIopsDevice,
+		&LinuxThrottleDevice{Major: major, Minor: minor, Rate: rate})
+}
+
+// AddLinuxBlockIOThrottleWriteIOPS records setting the write IOPS limit of a device for a container.
+func (a *ContainerAdjustment) AddLinuxBlockIOThrottleWriteIOPS(major, minor int64, rate uint64) {
+	a.initLinuxResourcesBlockIO()
+	a.Linux.Resources.Blockio.ThrottleWriteIopsDevice = append(a.Linux.Resources.Blockio.ThrottleWriteIopsDevice,
+		&LinuxThrottleDevice{Major: major, Minor: minor, Rate: rate})
+}
+
 // SetLinuxRDTClass records setting the RDT class for a container.
 func (a *ContainerAdjustment) SetLinuxRDTClass(value string) {
 	a.initLinuxResources()
 	a.Linux.Resources.RdtClass = String(value)
 }
 
+// SetLinuxRDTClosID records setting the RDT CLOS ID (resctrl group) for a container.
+func (a *ContainerAdjustment) SetLinuxRDTClosID(value string) {
+	a.initLinuxResources()
+	a.Linux.Resources.RdtClosId = String(value)
+}
+
 // AddLinuxUnified sets a cgroupv2 unified resource.
 func (a *ContainerAdjustment) AddLinuxUnified(key, value string) {
 	a.initLinuxResourcesUnified()
@@ -244,6 +370,295 @@ func (a *ContainerAdjustment) SetLinuxCgroupsPath(value string) {
 	a.Linux.CgroupsPath = value
 }
 
+// AddLinuxSysctl records setting a (namespaced) sysctl for a container.
+func (a *ContainerAdjustment) AddLinuxSysctl(key, value string) {
+	a.initLinuxSysctl()
+	a.Linux.Sysctl[key] = value
+}
+
+// RemoveLinuxSysctl records the removal of a sysctl for a container.
+func (a *ContainerAdjustment) RemoveLinuxSysctl(key string) {
+	a.initLinuxSysctl()
+	a.Linux.Sysctl[MarkForRemoval(key)] = ""
+}
+
+// AddLinuxSysctl records setting a (namespaced) sysctl for a pod sandbox.
+func (a *PodSandboxAdjustment) AddLinuxSysctl(key, value string) {
+	a.initLinuxSysctl()
+	a.Linux.Sysctl[key] = value
+}
+
+// RemoveLinuxSysctl records the removal of a sysctl for a pod sandbox.
+func (a *PodSandboxAdjustment) RemoveLinuxSysctl(key string) {
+	a.initLinuxSysctl()
+	a.Linux.Sysctl[MarkForRemoval(key)] = ""
+}
+
+// AddAnnotation records the addition of the annotation key=value for a pod sandbox.
+func (a *PodSandboxAdjustment) AddAnnotation(key, value string) {
+	a.initAnnotations()
+	a.Annotations[key] = value
+}
+
+// RemoveAnnotation records the removal of the annotation for a pod sandbox.
+func (a *PodSandboxAdjustment) RemoveAnnotation(key string) {
+	a.initAnnotations()
+	a.Annotations[MarkForRemoval(key)] = ""
+}
+
+// AddAnnotationIfAbsent records the addition of the annotation key=value
+// for a pod sandbox, unless the pod already has an annotation for key.
+func (a *PodSandboxAdjustment) AddAnnotationIfAbsent(key, value string) {
+	a.initAnnotations()
+	a.Annotations[MarkForSetIfAbsent(key)] = value
+}
+
+// AddLabel records the addition of the label key=value for a pod sandbox.
+func (a *PodSandboxAdjustment) AddLabel(key, value string) {
+	a.initLabels()
+	a.Labels[key] = value
+}
+
+// RemoveLabel records the removal of the label for a pod sandbox.
+func (a *PodSandboxAdjustment) RemoveLabel(key string) {
+	a.initLabels()
+	a.Labels[MarkForRemoval(key)] = ""
+}
+
+// AddLabelIfAbsent records the addition of the label key=value for a
+// pod sandbox, unless the pod already has a label for key.
+func (a *PodSandboxAdjustment) AddLabelIfAbsent(key, value string) {
+	a.initLabels()
+	a.Labels[MarkForSetIfAbsent(key)] = value
+}
+
+// SetLinuxMemoryLimit records setting the memory limit for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxMemoryLimit(value int64) {
+	a.initLinuxResourcesMemory()
+	a.Linux.Resources.Memory.Limit = Int64(value)
+}
+
+// SetLinuxMemoryReservation records setting the memory reservation for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxMemoryReservation(value int64) {
+	a.initLinuxResourcesMemory()
+	a.Linux.Resources.Memory.Reservation = Int64(value)
+}
+
+// SetLinuxMemorySwap records setting the memory swap limit for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxMemorySwap(value int64) {
+	a.initLinuxResourcesMemory()
+	a.Linux.Resources.Memory.Swap = Int64(value)
+}
+
+// SetLinuxCPUShares records setting the scheduler's CPU shares for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxCPUShares(value uint64) {
+	a.initLinuxResourcesCPU()
+	a.Linux.Resources.Cpu.Shares = UInt64(value)
+}
+
+// SetLinuxCPUQuota records setting the scheduler's CPU quota for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxCPUQuota(value int64) {
+	a.initLinuxResourcesCPU()
+	a.Linux.Resources.Cpu.Quota = Int64(value)
+}
+
+// SetLinuxCPUPeriod records setting the scheduler's CPU period for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxCPUPeriod(value int64) {
+	a.initLinuxResourcesCPU()
+	a.Linux.Resources.Cpu.Period = UInt64(value)
+}
+
+// SetLinuxCPUSetCPUs records setting the cpuset CPUs for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxCPUSetCPUs(value string) {
+	a.initLinuxResourcesCPU()
+	a.Linux.Resources.Cpu.Cpus = value
+}
+
+// SetLinuxCPUSetMems records setting the cpuset memory for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxCPUSetMems(value string) {
+	a.initLinuxResourcesCPU()
+	a.Linux.Resources.Cpu.Mems = value
+}
+
+// AddLinuxHugepageLimit records adding a hugepage limit for a pod sandbox.
+func (a *PodSandboxAdjustment) AddLinuxHugepageLimit(pageSize string, value uint64) {
+	a.initLinuxResources()
+	a.Linux.Resources.HugepageLimits = SetHugepageLimit(a.Linux.Resources.HugepageLimits,
+		&HugepageLimit{
+			PageSize: pageSize,
+			Limit:    value,
+		})
+}
+
+// AddLinuxUnified sets a cgroupv2 unified resource for a pod sandbox.
+func (a *PodSandboxAdjustment) AddLinuxUnified(key, value string) {
+	a.initLinuxResourcesUnified()
+	a.Linux.Resources.Unified[key] = value
+}
+
+// SetLinuxIDMappings records setting the user namespace UID and GID
+// mappings for a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxIDMappings(uids, gids []*LinuxIDMapping) {
+	a.initLinux()
+	a.Linux.UidMappings = uids
+	a.Linux.GidMappings = gids
+}
+
+// JoinNetworkNamespace records joining an existing network namespace for a
+// pod sandbox. The namespace is given either as an absolute path, or as the
+// name of a namespace created by 'ip netns'.
+func (a *PodSandboxAdjustment) JoinNetworkNamespace(nameOrPath string) {
+	if !filepath.IsAbs(nameOrPath) {
+		nameOrPath = filepath.Join(NamedNetworkNamespaceDir, nameOrPath)
+	}
+	a.initLinux()
+	a.Linux.NetworkNamespace = &LinuxNamespaceJoin{
+		Path: nameOrPath,
+	}
+}
+
+// ShareNetworkNamespace records sharing the network namespace of another
+// pod sandbox, identified by its UID, for a pod sandbox.
+func (a *PodSandboxAdjustment) ShareNetworkNamespace(podUID string) {
+	a.initLinux()
+	a.Linux.NetworkNamespace = &LinuxNamespaceJoin{
+		PodUid: podUID,
+	}
+}
+
+// SetLinuxConntrackMax records limiting the number of connection tracking
+// entries of a pod sandbox.
+func (a *PodSandboxAdjustment) SetLinuxConntrackMax(value uint64) {
+	a.initLinux()
+	a.Linux.ConntrackMax = value
+}
+
+// SetHostname records setting the hostname of a pod sandbox.
+func (a *PodSandboxAdjustment) SetHostname(value string) {
+	a.Hostname = value
+}
+
+// SetDomainname records setting the domainname of a pod sandbox.
+func (a *PodSandboxAdjustment) SetDomainname(value string) {
+	a.Domainname = value
+}
+
+// AddExtraHost records adding an entry to the hosts file of a pod sandbox.
+func (a *PodSandboxAdjustment) AddExtraHost(ip string, hostnames ...string) {
+	a.ExtraHosts = append(a.ExtraHosts, &HostEntry{
+		Ip:        ip,
+		Hostnames: hostnames,
+	})
+}
+
+// AddRlimit records setting a default POSIX rlimit for all containers of
+// a pod sandbox.
+func (a *PodSandboxAdjustment) AddRlimit(typ string, hard, soft uint64) {
+	a.Rlimits = SetRlimit(a.Rlimits, &POSIXRlimit{
+		Type: typ,
+		Hard: hard,
+		Soft: soft,
+	})
+}
+
+// SetLinuxIDMappings records setting the user namespace UID and GID
+// mappings for a container.
+func (a *ContainerAdjustment) SetLinuxIDMappings(uids, gids []*LinuxIDMapping) {
+	a.initLinux()
+	a.Linux.UidMappings = uids
+	a.Linux.GidMappings = gids
+}
+
+// SetLinuxIOPriority records setting the I/O priority for a container.
+func (a *ContainerAdjustment) SetLinuxIOPriority(class IOPrioClass, priority int32) {
+	a.initLinux()
+	a.Linux.IoPriority = &LinuxIOPriority{
+		Class:    class,
+		Priority: priority,
+	}
+}
+
+// SetLinuxScheduler records setting the CPU scheduling policy and
+// parameters for a container.
+func (a *ContainerAdjustment) SetLinuxScheduler(s *LinuxScheduler) {
+	a.initLinux()
+	a.Linux.Scheduler = s
+}
+
+// SetLinuxMemoryPolicy records setting the NUMA memory policy for a container.
+func (a *ContainerAdjustment) SetLinuxMemoryPolicy(mode MemoryPolicyMode, nodes string, flags ...MemoryPolicyFlag) {
+	a.initLinux()
+	a.Linux.MemoryPolicy = &LinuxMemoryPolicy{
+		Mode:  mode,
+		Nodes: nodes,
+		Flags: flags,
+	}
+}
+
+// SetLinuxNUMANodes records binding a container to the given NUMA nodes.
+func (a *ContainerAdjustment) SetLinuxNUMANodes(nodes string) {
+	a.initLinuxTopologyHints()
+	a.Linux.TopologyHints.NumaNodes = nodes
+}
+
+// AddLinuxPreferredNetworkDevice records a network device, by interface
+// name or PCI address, which a container should be local to.
+func (a *ContainerAdjustment) AddLinuxPreferredNetworkDevice(dev string) {
+	a.initLinuxTopologyHints()
+	a.Linux.TopologyHints.PreferredNetworkDevices = append(
+		a.Linux.TopologyHints.PreferredNetworkDevices, dev)
+}
+
+// AddLinuxMaskedPath records masking a path in a container.
+func (a *ContainerAdjustment) AddLinuxMaskedPath(path string) {
+	a.initLinux()
+	a.Linux.MaskedPaths = append(a.Linux.MaskedPaths, path)
+}
+
+// RemoveLinuxMaskedPath records unmasking a path in a container.
+func (a *ContainerAdjustment) RemoveLinuxMaskedPath(path string) {
+	a.initLinux()
+	a.Linux.MaskedPaths = append(a.Linux.MaskedPaths, MarkForRemoval(path))
+}
+
+// AddLinuxReadonlyPath records making a path read-only in a container.
+func (a *ContainerAdjustment) AddLinuxReadonlyPath(path string) {
+	a.initLinux()
+	a.Linux.ReadonlyPaths = append(a.Linux.ReadonlyPaths, path)
+}
+
+// RemoveLinuxReadonlyPath records removing a path from the read-only
+// paths of a container.
+func (a *ContainerAdjustment) RemoveLinuxReadonlyPath(path string) {
+	a.initLinux()
+	a.Linux.ReadonlyPaths = append(a.Linux.ReadonlyPaths, MarkForRemoval(path))
+}
+
+// AddLinuxCapability records adding a capability, like CAP_NET_ADMIN, to
+// a container.
+func (a *ContainerAdjustment) AddLinuxCapability(capability string) {
+	a.initLinuxCapabilities()
+	a.Linux.Capabilities.Add = append(a.Linux.Capabilities.Add, capability)
+}
+
+// DropLinuxCapability records dropping a capability from a container.
+func (a *ContainerAdjustment) DropLinuxCapability(capability string) {
+	a.initLinuxCapabilities()
+	a.Linux.Capabilities.Drop = append(a.Linux.Capabilities.Drop, capability)
+}
+
+// SetLinuxSeccompProfile records setting the seccomp profile for a container.
+func (a *ContainerAdjustment) SetLinuxSeccompProfile(p *SecurityProfile) {
+	a.initLinux()
+	a.Linux.SeccompProfile = p
+}
+
+// SetLinuxAppArmorProfile records setting the AppArmor profile for a container.
+func (a *ContainerAdjustment) SetLinuxAppArmorProfile(p *SecurityProfile) {
+	a.initLinux()
+	a.Linux.ApparmorProfile = p
+}
+
 //
 // Initializing a container adjustment and container update.
 //
@@ -254,6 +669,24 @@ func (a *ContainerAdjustment) initAnnotations() {
 	}
 }
 
+func (a *ContainerAdjustment) initLabels() {
+	if a.Labels == nil {
+		a.Labels = make(map[string]string)
+	}
+}
+
+func (a *PodSandboxAdjustment) initAnnotations() {
+	if a.Annotations == nil {
+		a.Annotations = make(map[string]string)
+	}
+}
+
+func (a *PodSandboxAdjustment) initLabels() {
+	if a.Labels == nil {
+		a.Labels = make(map[string]string)
+	}
+}
+
 func (a *ContainerAdjustment) initHooks() {
 	if a.Hooks == nil {
 		a.Hooks = &Hooks{}
@@ -266,6 +699,68 @@ func (a *ContainerAdjustment) initLinux() {
 	}
 }
 
+func (a *ContainerAdjustment) initLinuxCapabilities() {
+	a.initLinux()
+	if a.Linux.Capabilities == nil {
+		a.Linux.Capabilities = &LinuxCapabilities{}
+	}
+}
+
+func (a *ContainerAdjustment) initLinuxTopologyHints() {
+	a.initLinux()
+	if a.Linux.TopologyHints == nil {
+		a.Linux.TopologyHints = &LinuxTopologyHints{}
+	}
+}
+
+func (a *ContainerAdjustment) initLinuxSysctl() {
+	a.initLinux()
+	if a.Linux.Sysctl == nil {
+		a.Linux.Sysctl = make(map[string]string)
+	}
+}
+
+func (a *PodSandboxAdjustment) initLinux() {
+	if a.Linux == nil {
+		a.Linux = &LinuxPodSandboxAdjustment{}
+	}
+}
+
+func (a *PodSandboxAdjustment) initLinuxSysctl() {
+	a.initLinux()
+	if a.Linux.Sysctl == nil {
+		a.Linux.Sysctl = make(map[string]string)
+	}
+}
+
+func (a *PodSandboxAdjustment) initLinuxResources() {
+	a.initLinux()
+	if a.Linux.Resources == nil {
+		a.Linux.Resources = &LinuxResources{}
+	}
+}
+
+func (a *PodSandboxAdjustment) initLinuxResourcesMemory() {
+	a.initLinuxResources()
+	if a.Linux.Resources.Memory == nil {
+		a.Linux.Resources.Memory = &LinuxMemory{}
+	}
+}
+
+func (a *PodSandboxAdjustment) initLinuxResourcesCPU() {
+	a.initLinuxResources()
+	if a.Linux.Resources.Cpu == nil {
+		a.Linux.Resources.Cpu = &LinuxCPU{}
+	}
+}
+
+func (a *PodSandboxAdjustment) initLinuxResourcesUnified() {
+	a.initLinuxResources()
+	if a.Linux.Resources.Unified == nil {
+		a.Linux.Resources.Unified = make(map[string]string)
+	}
+}
+
 func (a *ContainerAdjustment) initLinuxResources() {
 	a.initLinux()
 	if a.Linux.Resources == nil {
@@ -293,3 +788,10 @@ func (a *ContainerAdjustment) initLinuxResourcesUnified() {
 		a.Linux.Resources.Unified = make(map[string]string)
 	}
 }
+
+func (a *ContainerAdjustment) initLinuxResourcesBlockIO() {
+	a.initLinuxResources()
+	if a.Linux.Resources.Blockio == nil {
+		a.Linux.Resources.Blockio = &LinuxBlockIO{}
+	}
+}
@@ -0,0 +1,1245 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Code generated by generate_deepcopy.go. DO NOT EDIT.
+
+package api
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// DeepCopy returns a deep copy of the BuildInfo.
+func (x *BuildInfo) DeepCopy() *BuildInfo {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*BuildInfo)
+}
+
+// Equal returns true if the BuildInfo is equal to o.
+func (x *BuildInfo) Equal(o *BuildInfo) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the CDIDevice.
+func (x *CDIDevice) DeepCopy() *CDIDevice {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*CDIDevice)
+}
+
+// Equal returns true if the CDIDevice is equal to o.
+func (x *CDIDevice) Equal(o *CDIDevice) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the CPUStats.
+func (x *CPUStats) DeepCopy() *CPUStats {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*CPUStats)
+}
+
+// Equal returns true if the CPUStats is equal to o.
+func (x *CPUStats) Equal(o *CPUStats) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ConfigureRequest.
+func (x *ConfigureRequest) DeepCopy() *ConfigureRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ConfigureRequest)
+}
+
+// Equal returns true if the ConfigureRequest is equal to o.
+func (x *ConfigureRequest) Equal(o *ConfigureRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ConfigureResponse.
+func (x *ConfigureResponse) DeepCopy() *ConfigureResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ConfigureResponse)
+}
+
+// Equal returns true if the ConfigureResponse is equal to o.
+func (x *ConfigureResponse) Equal(o *ConfigureResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the Container.
+func (x *Container) DeepCopy() *Container {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*Container)
+}
+
+// Equal returns true if the Container is equal to o.
+func (x *Container) Equal(o *Container) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ContainerAdjustment.
+func (x *ContainerAdjustment) DeepCopy() *ContainerAdjustment {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ContainerAdjustment)
+}
+
+// Equal returns true if the ContainerAdjustment is equal to o.
+func (x *ContainerAdjustment) Equal(o *ContainerAdjustment) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ContainerEviction.
+func (x *ContainerEviction) DeepCopy() *ContainerEviction {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ContainerEviction)
+}
+
+// Equal returns true if the ContainerEviction is equal to o.
+func (x *ContainerEviction) Equal(o *ContainerEviction) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ContainerExit.
+func (x *ContainerExit) DeepCopy() *ContainerExit {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ContainerExit)
+}
+
+// Equal returns true if the ContainerExit is equal to o.
+func (x *ContainerExit) Equal(o *ContainerExit) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ContainerUpdate.
+func (x *ContainerUpdate) DeepCopy() *ContainerUpdate {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ContainerUpdate)
+}
+
+// Equal returns true if the ContainerUpdate is equal to o.
+func (x *ContainerUpdate) Equal(o *ContainerUpdate) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the CreateContainerRequest.
+func (x *CreateContainerRequest) DeepCopy() *CreateContainerRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*CreateContainerRequest)
+}
+
+// Equal returns true if the CreateContainerRequest is equal to o.
+func (x *CreateContainerRequest) Equal(o *CreateContainerRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the CreateContainerResponse.
+func (x *CreateContainerResponse) DeepCopy() *CreateContainerResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*CreateContainerResponse)
+}
+
+// Equal returns true if the CreateContainerResponse is equal to o.
+func (x *CreateContainerResponse) Equal(o *CreateContainerResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the DeliverCreateContainerResponseRequest.
+func (x *DeliverCreateContainerResponseRequest) DeepCopy() *DeliverCreateContainerResponseRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*DeliverCreateContainerResponseRequest)
+}
+
+// Equal returns true if the DeliverCreateContainerResponseRequest is equal to o.
+func (x *DeliverCreateContainerResponseRequest) Equal(o *DeliverCreateContainerResponseRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the Empty.
+func (x *Empty) DeepCopy() *Empty {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*Empty)
+}
+
+// Equal returns true if the Empty is equal to o.
+func (x *Empty) Equal(o *Empty) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ErrorDetails.
+func (x *ErrorDetails) DeepCopy() *ErrorDetails {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ErrorDetails)
+}
+
+// Equal returns true if the ErrorDetails is equal to o.
+func (x *ErrorDetails) Equal(o *ErrorDetails) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ExecSession.
+func (x *ExecSession) DeepCopy() *ExecSession {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ExecSession)
+}
+
+// Equal returns true if the ExecSession is equal to o.
+func (x *ExecSession) Equal(o *ExecSession) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the FieldMask.
+func (x *FieldMask) DeepCopy() *FieldMask {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*FieldMask)
+}
+
+// Equal returns true if the FieldMask is equal to o.
+func (x *FieldMask) Equal(o *FieldMask) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the GetStatsRequest.
+func (x *GetStatsRequest) DeepCopy() *GetStatsRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*GetStatsRequest)
+}
+
+// Equal returns true if the GetStatsRequest is equal to o.
+func (x *GetStatsRequest) Equal(o *GetStatsRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the GetStatsResponse.
+func (x *GetStatsResponse) DeepCopy() *GetStatsResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*GetStatsResponse)
+}
+
+// Equal returns true if the GetStatsResponse is equal to o.
+func (x *GetStatsResponse) Equal(o *GetStatsResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the Hook.
+func (x *Hook) DeepCopy() *Hook {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*Hook)
+}
+
+// Equal returns true if the Hook is equal to o.
+func (x *Hook) Equal(o *Hook) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the HookStats.
+func (x *HookStats) DeepCopy() *HookStats {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*HookStats)
+}
+
+// Equal returns true if the HookStats is equal to o.
+func (x *HookStats) Equal(o *HookStats) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the Hooks.
+func (x *Hooks) DeepCopy() *Hooks {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*Hooks)
+}
+
+// Equal returns true if the Hooks is equal to o.
+func (x *Hooks) Equal(o *Hooks) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the HostEntry.
+func (x *HostEntry) DeepCopy() *HostEntry {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*HostEntry)
+}
+
+// Equal returns true if the HostEntry is equal to o.
+func (x *HostEntry) Equal(o *HostEntry) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the HugepageLimit.
+func (x *HugepageLimit) DeepCopy() *HugepageLimit {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*HugepageLimit)
+}
+
+// Equal returns true if the HugepageLimit is equal to o.
+func (x *HugepageLimit) Equal(o *HugepageLimit) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the Image.
+func (x *Image) DeepCopy() *Image {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*Image)
+}
+
+// Equal returns true if the Image is equal to o.
+func (x *Image) Equal(o *Image) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the KeyValue.
+func (x *KeyValue) DeepCopy() *KeyValue {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*KeyValue)
+}
+
+// Equal returns true if the KeyValue is equal to o.
+func (x *KeyValue) Equal(o *KeyValue) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxBlockIO.
+func (x *LinuxBlockIO) DeepCopy() *LinuxBlockIO {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxBlockIO)
+}
+
+// Equal returns true if the LinuxBlockIO is equal to o.
+func (x *LinuxBlockIO) Equal(o *LinuxBlockIO) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxCPU.
+func (x *LinuxCPU) DeepCopy() *LinuxCPU {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxCPU)
+}
+
+// Equal returns true if the LinuxCPU is equal to o.
+func (x *LinuxCPU) Equal(o *LinuxCPU) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxCapabilities.
+func (x *LinuxCapabilities) DeepCopy() *LinuxCapabilities {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxCapabilities)
+}
+
+// Equal returns true if the LinuxCapabilities is equal to o.
+func (x *LinuxCapabilities) Equal(o *LinuxCapabilities) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxContainer.
+func (x *LinuxContainer) DeepCopy() *LinuxContainer {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxContainer)
+}
+
+// Equal returns true if the LinuxContainer is equal to o.
+func (x *LinuxContainer) Equal(o *LinuxContainer) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxContainerAdjustment.
+func (x *LinuxContainerAdjustment) DeepCopy() *LinuxContainerAdjustment {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxContainerAdjustment)
+}
+
+// Equal returns true if the LinuxContainerAdjustment is equal to o.
+func (x *LinuxContainerAdjustment) Equal(o *LinuxContainerAdjustment) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxContainerUpdate.
+func (x *LinuxContainerUpdate) DeepCopy() *LinuxContainerUpdate {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxContainerUpdate)
+}
+
+// Equal returns true if the LinuxContainerUpdate is equal to o.
+func (x *LinuxContainerUpdate) Equal(o *LinuxContainerUpdate) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxDevice.
+func (x *LinuxDevice) DeepCopy() *LinuxDevice {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxDevice)
+}
+
+// Equal returns true if the LinuxDevice is equal to o.
+func (x *LinuxDevice) Equal(o *LinuxDevice) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxDeviceCgroup.
+func (x *LinuxDeviceCgroup) DeepCopy() *LinuxDeviceCgroup {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxDeviceCgroup)
+}
+
+// Equal returns true if the LinuxDeviceCgroup is equal to o.
+func (x *LinuxDeviceCgroup) Equal(o *LinuxDeviceCgroup) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxIDMapping.
+func (x *LinuxIDMapping) DeepCopy() *LinuxIDMapping {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxIDMapping)
+}
+
+// Equal returns true if the LinuxIDMapping is equal to o.
+func (x *LinuxIDMapping) Equal(o *LinuxIDMapping) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxIOPriority.
+func (x *LinuxIOPriority) DeepCopy() *LinuxIOPriority {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxIOPriority)
+}
+
+// Equal returns true if the LinuxIOPriority is equal to o.
+func (x *LinuxIOPriority) Equal(o *LinuxIOPriority) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxMemory.
+func (x *LinuxMemory) DeepCopy() *LinuxMemory {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxMemory)
+}
+
+// Equal returns true if the LinuxMemory is equal to o.
+func (x *LinuxMemory) Equal(o *LinuxMemory) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxMemoryPolicy.
+func (x *LinuxMemoryPolicy) DeepCopy() *LinuxMemoryPolicy {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxMemoryPolicy)
+}
+
+// Equal returns true if the LinuxMemoryPolicy is equal to o.
+func (x *LinuxMemoryPolicy) Equal(o *LinuxMemoryPolicy) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxNamespace.
+func (x *LinuxNamespace) DeepCopy() *LinuxNamespace {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxNamespace)
+}
+
+// Equal returns true if the LinuxNamespace is equal to o.
+func (x *LinuxNamespace) Equal(o *LinuxNamespace) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxNamespaceJoin.
+func (x *LinuxNamespaceJoin) DeepCopy() *LinuxNamespaceJoin {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxNamespaceJoin)
+}
+
+// Equal returns true if the LinuxNamespaceJoin is equal to o.
+func (x *LinuxNamespaceJoin) Equal(o *LinuxNamespaceJoin) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxPodSandbox.
+func (x *LinuxPodSandbox) DeepCopy() *LinuxPodSandbox {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxPodSandbox)
+}
+
+// Equal returns true if the LinuxPodSandbox is equal to o.
+func (x *LinuxPodSandbox) Equal(o *LinuxPodSandbox) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxPodSandboxAdjustment.
+func (x *LinuxPodSandboxAdjustment) DeepCopy() *LinuxPodSandboxAdjustment {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxPodSandboxAdjustment)
+}
+
+// Equal returns true if the LinuxPodSandboxAdjustment is equal to o.
+func (x *LinuxPodSandboxAdjustment) Equal(o *LinuxPodSandboxAdjustment) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxResources.
+func (x *LinuxResources) DeepCopy() *LinuxResources {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxResources)
+}
+
+// Equal returns true if the LinuxResources is equal to o.
+func (x *LinuxResources) Equal(o *LinuxResources) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxScheduler.
+func (x *LinuxScheduler) DeepCopy() *LinuxScheduler {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxScheduler)
+}
+
+// Equal returns true if the LinuxScheduler is equal to o.
+func (x *LinuxScheduler) Equal(o *LinuxScheduler) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxThrottleDevice.
+func (x *LinuxThrottleDevice) DeepCopy() *LinuxThrottleDevice {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxThrottleDevice)
+}
+
+// Equal returns true if the LinuxThrottleDevice is equal to o.
+func (x *LinuxThrottleDevice) Equal(o *LinuxThrottleDevice) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxTopologyHints.
+func (x *LinuxTopologyHints) DeepCopy() *LinuxTopologyHints {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxTopologyHints)
+}
+
+// Equal returns true if the LinuxTopologyHints is equal to o.
+func (x *LinuxTopologyHints) Equal(o *LinuxTopologyHints) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the LinuxWeightDevice.
+func (x *LinuxWeightDevice) DeepCopy() *LinuxWeightDevice {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*LinuxWeightDevice)
+}
+
+// Equal returns true if the LinuxWeightDevice is equal to o.
+func (x *LinuxWeightDevice) Equal(o *LinuxWeightDevice) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ListPluginsRequest.
+func (x *ListPluginsRequest) DeepCopy() *ListPluginsRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ListPluginsRequest)
+}
+
+// Equal returns true if the ListPluginsRequest is equal to o.
+func (x *ListPluginsRequest) Equal(o *ListPluginsRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ListPluginsResponse.
+func (x *ListPluginsResponse) DeepCopy() *ListPluginsResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ListPluginsResponse)
+}
+
+// Equal returns true if the ListPluginsResponse is equal to o.
+func (x *ListPluginsResponse) Equal(o *ListPluginsResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the MemoryStats.
+func (x *MemoryStats) DeepCopy() *MemoryStats {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*MemoryStats)
+}
+
+// Equal returns true if the MemoryStats is equal to o.
+func (x *MemoryStats) Equal(o *MemoryStats) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the Mount.
+func (x *Mount) DeepCopy() *Mount {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*Mount)
+}
+
+// Equal returns true if the Mount is equal to o.
+func (x *Mount) Equal(o *Mount) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the NetworkAttachment.
+func (x *NetworkAttachment) DeepCopy() *NetworkAttachment {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*NetworkAttachment)
+}
+
+// Equal returns true if the NetworkAttachment is equal to o.
+func (x *NetworkAttachment) Equal(o *NetworkAttachment) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the NetworkSetupFailure.
+func (x *NetworkSetupFailure) DeepCopy() *NetworkSetupFailure {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*NetworkSetupFailure)
+}
+
+// Equal returns true if the NetworkSetupFailure is equal to o.
+func (x *NetworkSetupFailure) Equal(o *NetworkSetupFailure) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the NetworkSetupRetry.
+func (x *NetworkSetupRetry) DeepCopy() *NetworkSetupRetry {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*NetworkSetupRetry)
+}
+
+// Equal returns true if the NetworkSetupRetry is equal to o.
+func (x *NetworkSetupRetry) Equal(o *NetworkSetupRetry) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the NetworkStats.
+func (x *NetworkStats) DeepCopy() *NetworkStats {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*NetworkStats)
+}
+
+// Equal returns true if the NetworkStats is equal to o.
+func (x *NetworkStats) Equal(o *NetworkStats) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the NetworkStatus.
+func (x *NetworkStatus) DeepCopy() *NetworkStatus {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*NetworkStatus)
+}
+
+// Equal returns true if the NetworkStatus is equal to o.
+func (x *NetworkStatus) Equal(o *NetworkStatus) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the OptionalBool.
+func (x *OptionalBool) DeepCopy() *OptionalBool {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*OptionalBool)
+}
+
+// Equal returns true if the OptionalBool is equal to o.
+func (x *OptionalBool) Equal(o *OptionalBool) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the OptionalFileMode.
+func (x *OptionalFileMode) DeepCopy() *OptionalFileMode {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*OptionalFileMode)
+}
+
+// Equal returns true if the OptionalFileMode is equal to o.
+func (x *OptionalFileMode) Equal(o *OptionalFileMode) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the OptionalInt.
+func (x *OptionalInt) DeepCopy() *OptionalInt {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*OptionalInt)
+}
+
+// Equal returns true if the OptionalInt is equal to o.
+func (x *OptionalInt) Equal(o *OptionalInt) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the OptionalInt32.
+func (x *OptionalInt32) DeepCopy() *OptionalInt32 {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*OptionalInt32)
+}
+
+// Equal returns true if the OptionalInt32 is equal to o.
+func (x *OptionalInt32) Equal(o *OptionalInt32) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the OptionalInt64.
+func (x *OptionalInt64) DeepCopy() *OptionalInt64 {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*OptionalInt64)
+}
+
+// Equal returns true if the OptionalInt64 is equal to o.
+func (x *OptionalInt64) Equal(o *OptionalInt64) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the OptionalString.
+func (x *OptionalString) DeepCopy() *OptionalString {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*OptionalString)
+}
+
+// Equal returns true if the OptionalString is equal to o.
+func (x *OptionalString) Equal(o *OptionalString) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the OptionalUInt32.
+func (x *OptionalUInt32) DeepCopy() *OptionalUInt32 {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*OptionalUInt32)
+}
+
+// Equal returns true if the OptionalUInt32 is equal to o.
+func (x *OptionalUInt32) Equal(o *OptionalUInt32) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the OptionalUInt64.
+func (x *OptionalUInt64) DeepCopy() *OptionalUInt64 {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*OptionalUInt64)
+}
+
+// Equal returns true if the OptionalUInt64 is equal to o.
+func (x *OptionalUInt64) Equal(o *OptionalUInt64) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the POSIXRlimit.
+func (x *POSIXRlimit) DeepCopy() *POSIXRlimit {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*POSIXRlimit)
+}
+
+// Equal returns true if the POSIXRlimit is equal to o.
+func (x *POSIXRlimit) Equal(o *POSIXRlimit) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the PluginInfo.
+func (x *PluginInfo) DeepCopy() *PluginInfo {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*PluginInfo)
+}
+
+// Equal returns true if the PluginInfo is equal to o.
+func (x *PluginInfo) Equal(o *PluginInfo) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the PluginStats.
+func (x *PluginStats) DeepCopy() *PluginStats {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*PluginStats)
+}
+
+// Equal returns true if the PluginStats is equal to o.
+func (x *PluginStats) Equal(o *PluginStats) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the PodOwner.
+func (x *PodOwner) DeepCopy() *PodOwner {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*PodOwner)
+}
+
+// Equal returns true if the PodOwner is equal to o.
+func (x *PodOwner) Equal(o *PodOwner) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the PodSandbox.
+func (x *PodSandbox) DeepCopy() *PodSandbox {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*PodSandbox)
+}
+
+// Equal returns true if the PodSandbox is equal to o.
+func (x *PodSandbox) Equal(o *PodSandbox) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the PodSandboxAdjustment.
+func (x *PodSandboxAdjustment) DeepCopy() *PodSandboxAdjustment {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*PodSandboxAdjustment)
+}
+
+// Equal returns true if the PodSandboxAdjustment is equal to o.
+func (x *PodSandboxAdjustment) Equal(o *PodSandboxAdjustment) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the PodSandboxNetworkStatus.
+func (x *PodSandboxNetworkStatus) DeepCopy() *PodSandboxNetworkStatus {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*PodSandboxNetworkStatus)
+}
+
+// Equal returns true if the PodSandboxNetworkStatus is equal to o.
+func (x *PodSandboxNetworkStatus) Equal(o *PodSandboxNetworkStatus) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the Pressure.
+func (x *Pressure) DeepCopy() *Pressure {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*Pressure)
+}
+
+// Equal returns true if the Pressure is equal to o.
+func (x *Pressure) Equal(o *Pressure) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the RegisterClientRequest.
+func (x *RegisterClientRequest) DeepCopy() *RegisterClientRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*RegisterClientRequest)
+}
+
+// Equal returns true if the RegisterClientRequest is equal to o.
+func (x *RegisterClientRequest) Equal(o *RegisterClientRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the RegisterPluginRequest.
+func (x *RegisterPluginRequest) DeepCopy() *RegisterPluginRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*RegisterPluginRequest)
+}
+
+// Equal returns true if the RegisterPluginRequest is equal to o.
+func (x *RegisterPluginRequest) Equal(o *RegisterPluginRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the RestartContainerRequest.
+func (x *RestartContainerRequest) DeepCopy() *RestartContainerRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*RestartContainerRequest)
+}
+
+// Equal returns true if the RestartContainerRequest is equal to o.
+func (x *RestartContainerRequest) Equal(o *RestartContainerRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the RestartContainerResponse.
+func (x *RestartContainerResponse) DeepCopy() *RestartContainerResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*RestartContainerResponse)
+}
+
+// Equal returns true if the RestartContainerResponse is equal to o.
+func (x *RestartContainerResponse) Equal(o *RestartContainerResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the ScopeMask.
+func (x *ScopeMask) DeepCopy() *ScopeMask {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*ScopeMask)
+}
+
+// Equal returns true if the ScopeMask is equal to o.
+func (x *ScopeMask) Equal(o *ScopeMask) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the SecurityProfile.
+func (x *SecurityProfile) DeepCopy() *SecurityProfile {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*SecurityProfile)
+}
+
+// Equal returns true if the SecurityProfile is equal to o.
+func (x *SecurityProfile) Equal(o *SecurityProfile) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the SetNetworkReadinessRequest.
+func (x *SetNetworkReadinessRequest) DeepCopy() *SetNetworkReadinessRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*SetNetworkReadinessRequest)
+}
+
+// Equal returns true if the SetNetworkReadinessRequest is equal to o.
+func (x *SetNetworkReadinessRequest) Equal(o *SetNetworkReadinessRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the SetNetworkReadinessResponse.
+func (x *SetNetworkReadinessResponse) DeepCopy() *SetNetworkReadinessResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*SetNetworkReadinessResponse)
+}
+
+// Equal returns true if the SetNetworkReadinessResponse is equal to o.
+func (x *SetNetworkReadinessResponse) Equal(o *SetNetworkReadinessResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the SimulatePodRequest.
+func (x *SimulatePodRequest) DeepCopy() *SimulatePodRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*SimulatePodRequest)
+}
+
+// Equal returns true if the SimulatePodRequest is equal to o.
+func (x *SimulatePodRequest) Equal(o *SimulatePodRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the SimulatePodResponse.
+func (x *SimulatePodResponse) DeepCopy() *SimulatePodResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*SimulatePodResponse)
+}
+
+// Equal returns true if the SimulatePodResponse is equal to o.
+func (x *SimulatePodResponse) Equal(o *SimulatePodResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the SimulatedRequest.
+func (x *SimulatedRequest) DeepCopy() *SimulatedRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*SimulatedRequest)
+}
+
+// Equal returns true if the SimulatedRequest is equal to o.
+func (x *SimulatedRequest) Equal(o *SimulatedRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the StateChangeEvent.
+func (x *StateChangeEvent) DeepCopy() *StateChangeEvent {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*StateChangeEvent)
+}
+
+// Equal returns true if the StateChangeEvent is equal to o.
+func (x *StateChangeEvent) Equal(o *StateChangeEvent) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the StateChangeResponse.
+func (x *StateChangeResponse) DeepCopy() *StateChangeResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*StateChangeResponse)
+}
+
+// Equal returns true if the StateChangeResponse is equal to o.
+func (x *StateChangeResponse) Equal(o *StateChangeResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the Stats.
+func (x *Stats) DeepCopy() *Stats {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*Stats)
+}
+
+// Equal returns true if the Stats is equal to o.
+func (x *Stats) Equal(o *Stats) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the StopContainerRequest.
+func (x *StopContainerRequest) DeepCopy() *StopContainerRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*StopContainerRequest)
+}
+
+// Equal returns true if the StopContainerRequest is equal to o.
+func (x *StopContainerRequest) Equal(o *StopContainerRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the StopContainerResponse.
+func (x *StopContainerResponse) DeepCopy() *StopContainerResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*StopContainerResponse)
+}
+
+// Equal returns true if the StopContainerResponse is equal to o.
+func (x *StopContainerResponse) Equal(o *StopContainerResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the SynchronizeRequest.
+func (x *SynchronizeRequest) DeepCopy() *SynchronizeRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*SynchronizeRequest)
+}
+
+// Equal returns true if the SynchronizeRequest is equal to o.
+func (x *SynchronizeRequest) Equal(o *SynchronizeRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the SynchronizeResponse.
+func (x *SynchronizeResponse) DeepCopy() *SynchronizeResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*SynchronizeResponse)
+}
+
+// Equal returns true if the SynchronizeResponse is equal to o.
+func (x *SynchronizeResponse) Equal(o *SynchronizeResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the UpdateContainerRequest.
+func (x *UpdateContainerRequest) DeepCopy() *UpdateContainerRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*UpdateContainerRequest)
+}
+
+// Equal returns true if the UpdateContainerRequest is equal to o.
+func (x *UpdateContainerRequest) Equal(o *UpdateContainerRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the UpdateContainerResponse.
+func (x *UpdateContainerResponse) DeepCopy() *UpdateContainerResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*UpdateContainerResponse)
+}
+
+// Equal returns true if the UpdateContainerResponse is equal to o.
+func (x *UpdateContainerResponse) Equal(o *UpdateContainerResponse) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the UpdateContainersRequest.
+func (x *UpdateContainersRequest) DeepCopy() *UpdateContainersRequest {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*UpdateContainersRequest)
+}
+
+// Equal returns true if the UpdateContainersRequest is equal to o.
+func (x *UpdateContainersRequest) Equal(o *UpdateContainersRequest) bool {
+	return proto.Equal(x, o)
+}
+
+// DeepCopy returns a deep copy of the UpdateContainersResponse.
+func (x *UpdateContainersResponse) DeepCopy() *UpdateContainersResponse {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*UpdateContainersResponse)
+}
+
+// Equal returns true if the UpdateContainersResponse is equal to o.
+func (x *UpdateContainersResponse) Equal(o *UpdateContainersResponse) bool {
+	return proto.Equal(x, o)
+}
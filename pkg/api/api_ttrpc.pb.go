@@ -9,7 +9,14 @@ import (
 
 type RuntimeService interface {
 	RegisterPlugin(ctx context.Context, req *RegisterPluginRequest) (*Empty, error)
+	RegisterClient(ctx context.Context, req *RegisterClientRequest) (*Empty, error)
 	UpdateContainers(ctx context.Context, req *UpdateContainersRequest) (*UpdateContainersResponse, error)
+	ListPlugins(ctx context.Context, req *ListPluginsRequest) (*ListPluginsResponse, error)
+	GetStats(ctx context.Context, req *GetStatsRequest) (*GetStatsResponse, error)
+	RestartContainer(ctx context.Context, req *RestartContainerRequest) (*RestartContainerResponse, error)
+	SetNetworkReadiness(ctx context.Context, req *SetNetworkReadinessRequest) (*SetNetworkReadinessResponse, error)
+	DeliverCreateContainerResponse(ctx context.Context, req *DeliverCreateContainerResponseRequest) (*Empty, error)
+	SimulatePod(ctx context.Context, req *SimulatePodRequest) (*SimulatePodResponse, error)
 }
 
 func RegisterRuntimeService(srv *ttrpc.Server, svc RuntimeService) {
@@ -21,6 +28,13 @@ func RegisterRuntimeService(srv *ttrpc.Server, svc RuntimeService) {
 			}
 			return svc.RegisterPlugin(ctx, &req)
 		},
+		"RegisterClient": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req RegisterClientRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.RegisterClient(ctx, &req)
+		},
 		"UpdateContainers": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
 			var req UpdateContainersRequest
 			if err := unmarshal(&req); err != nil {
@@ -28,6 +42,48 @@ func RegisterRuntimeService(srv *ttrpc.Server, svc RuntimeService) {
 			}
 			return svc.UpdateContainers(ctx, &req)
 		},
+		"ListPlugins": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req ListPluginsRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.ListPlugins(ctx, &req)
+		},
+		"GetStats": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req GetStatsRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.GetStats(ctx, &req)
+		},
+		"RestartContainer": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req RestartContainerRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.RestartContainer(ctx, &req)
+		},
+		"SetNetworkReadiness": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SetNetworkReadinessRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SetNetworkReadiness(ctx, &req)
+		},
+		"DeliverCreateContainerResponse": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req DeliverCreateContainerResponseRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.DeliverCreateContainerResponse(ctx, &req)
+		},
+		"SimulatePod": func(ctx context.Context, unmarshal func(interface{}) error) (interface{}, error) {
+			var req SimulatePodRequest
+			if err := unmarshal(&req); err != nil {
+				return nil, err
+			}
+			return svc.SimulatePod(ctx, &req)
+		},
 	})
 }
 
@@ -47,6 +103,13 @@ func (c *runtimeClient) RegisterPlugin(ctx context.Context, req *RegisterPluginR
 	}
 	return &resp, nil
 }
+func (c *runtimeClient) RegisterClient(ctx context.Context, req *RegisterClientRequest) (*Empty, error) {
+	var resp Empty
+	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Runtime", "RegisterClient", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
 func (c *runtimeClient) UpdateContainers(ctx context.Context, req *UpdateContainersRequest) (*UpdateContainersResponse, error) {
 	var resp UpdateContainersResponse
 	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Runtime", "UpdateContainers", req, &resp); err != nil {
@@ -54,6 +117,48 @@ func (c *runtimeClient) UpdateContainers(ctx context.Context, req *UpdateContain
 	}
 	return &resp, nil
 }
+func (c *runtimeClient) ListPlugins(ctx context.Context, req *ListPluginsRequest) (*ListPluginsResponse, error) {
+	var resp ListPluginsResponse
+	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Runtime", "ListPlugins", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+func (c *runtimeClient) GetStats(ctx context.Context, req *GetStatsRequest) (*GetStatsResponse, error) {
+	var resp GetStatsResponse
+	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Runtime", "GetStats", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+func (c *runtimeClient) RestartContainer(ctx context.Context, req *RestartContainerRequest) (*RestartContainerResponse, error) {
+	var resp RestartContainerResponse
+	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Runtime", "RestartContainer", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+func (c *runtimeClient) SetNetworkReadiness(ctx context.Context, req *SetNetworkReadinessRequest) (*SetNetworkReadinessResponse, error) {
+	var resp SetNetworkReadinessResponse
+	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Runtime", "SetNetworkReadiness", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+func (c *runtimeClient) DeliverCreateContainerResponse(ctx context.Context, req *DeliverCreateContainerResponseRequest) (*Empty, error) {
+	var resp Empty
+	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Runtime", "DeliverCreateContainerResponse", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+func (c *runtimeClient) SimulatePod(ctx context.Context, req *SimulatePodRequest) (*SimulatePodResponse, error) {
+	var resp SimulatePodResponse
+	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Runtime", "SimulatePod", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
 
 type PluginService interface {
 	Configure(ctx context.Context, req *ConfigureRequest) (*ConfigureResponse, error)
@@ -62,7 +167,7 @@ type PluginService interface {
 	CreateContainer(ctx context.Context, req *CreateContainerRequest) (*CreateContainerResponse, error)
 	UpdateContainer(ctx context.Context, req *UpdateContainerRequest) (*UpdateContainerResponse, error)
 	StopContainer(ctx context.Context, req *StopContainerRequest) (*StopContainerResponse, error)
-	StateChange(ctx context.Context, req *StateChangeEvent) (*Empty, error)
+	StateChange(ctx context.Context, req *StateChangeEvent) (*StateChangeResponse, error)
 }
 
 func RegisterPluginService(srv *ttrpc.Server, svc PluginService) {
@@ -170,8 +275,8 @@ func (c *pluginClient) StopContainer(ctx context.Context, req *StopContainerRequ
 	}
 	return &resp, nil
 }
-func (c *pluginClient) StateChange(ctx context.Context, req *StateChangeEvent) (*Empty, error) {
-	var resp Empty
+func (c *pluginClient) StateChange(ctx context.Context, req *StateChangeEvent) (*StateChangeResponse, error) {
+	var resp StateChangeResponse
 	if err := c.client.Call(ctx, "nri.pkg.api.v1alpha1.Plugin", "StateChange", req, &resp); err != nil {
 		return nil, err
 	}
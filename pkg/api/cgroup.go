@@ -0,0 +1,140 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DefaultCgroupMount is the default mount point of the cgroup filesystem.
+	DefaultCgroupMount = "/sys/fs/cgroup"
+)
+
+// CgroupPath returns the cgroup of the pod, relative to the root of the
+// cgroup hierarchy. The cgroup parent of the pod is resolved according to
+// the cgroupfs or systemd cgroup driver, whichever it is given for.
+func (p *PodSandbox) CgroupPath() (string, error) {
+	parent := p.GetLinux().GetCgroupParent()
+	if parent == "" {
+		parent = p.GetLinux().GetCgroupsPath()
+	}
+	if parent == "" {
+		return "", fmt.Errorf("pod %s has no cgroup parent", p.GetId())
+	}
+	return ResolveCgroupPath(parent)
+}
+
+// CgroupPath returns the cgroup of the container, relative to the root of
+// the cgroup hierarchy. The cgroups path of the container is resolved
+// according to the cgroupfs or systemd cgroup driver, whichever it is given
+// for.
+func (c *Container) CgroupPath() (string, error) {
+	cgroupsPath := c.GetLinux().GetCgroupsPath()
+	if cgroupsPath == "" {
+		return "", fmt.Errorf("container %s has no cgroups path", c.GetId())
+	}
+	return ResolveCgroupPath(cgroupsPath)
+}
+
+// ResolveCgroupPath resolves a cgroup parent or cgroups path to a cgroup
+// relative to the root of the cgroup hierarchy. Paths for the systemd cgroup
+// driver, which are either slices like "kubepods-besteffort.slice", or of
+// the form "slice:prefix:name" with an empty slice defaulting to
+// "system.slice", are expanded to the corresponding systemd
+// slice hierarchy. Other paths are taken to be cgroupfs paths.
+func ResolveCgroupPath(cgroupPath string) (string, error) {
+	if parts := strings.Split(cgroupPath, ":"); len(parts) == 3 {
+		if parts[0] == "" {
+			parts[0] = "system.slice"
+		}
+		slice, err := expandSlice(parts[0])
+		if err != nil {
+			return "", err
+		}
+		unit := parts[2]
+		if !strings.HasSuffix(unit, ".slice") {
+			if parts[1] != "" {
+				unit = parts[1] + "-" + unit
+			}
+			unit += ".scope"
+		}
+		return path.Join(slice, unit), nil
+	}
+
+	if strings.HasSuffix(cgroupPath, ".slice") && !strings.Contains(cgroupPath, "/") {
+		return expandSlice(cgroupPath)
+	}
+
+	if strings.Contains(cgroupPath, ":") {
+		return "", fmt.Errorf("invalid cgroup path %q", cgroupPath)
+	}
+
+	return path.Join("/", cgroupPath), nil
+}
+
+// expandSlice expands a systemd slice name to its path in the cgroup
+// hierarchy, for instance "a-b-c.slice" to "/a.slice/a-b.slice/a-b-c.slice".
+func expandSlice(slice string) (string, error) {
+	if slice == "" || slice == "-.slice" {
+		return "/", nil
+	}
+
+	name := strings.TrimSuffix(slice, ".slice")
+	if name == slice || name == "" || strings.Contains(name, "/") ||
+		strings.HasPrefix(name, "-") || strings.HasSuffix(name, "-") ||
+		strings.Contains(name, "--") {
+		return "", fmt.Errorf("invalid systemd slice %q", slice)
+	}
+
+	var (
+		expanded string
+		prefix   string
+	)
+	for _, component := range strings.Split(name, "-") {
+		expanded += "/" + prefix + component + ".slice"
+		prefix += component + "-"
+	}
+
+	return expanded, nil
+}
+
+// IsCgroup2UnifiedMode checks if the cgroup filesystem mounted at the given
+// mount point, or DefaultCgroupMount if it is empty, is a cgroup v2 unified
+// hierarchy.
+func IsCgroup2UnifiedMode(mount string) bool {
+	if mount == "" {
+		mount = DefaultCgroupMount
+	}
+	_, err := os.Stat(filepath.Join(mount, "cgroup.controllers"))
+	return err == nil
+}
+
+// CgroupFsPath returns the directory of a cgroup in the cgroup filesystem
+// mounted at the given mount point, or DefaultCgroupMount if it is empty.
+// For cgroup v2 the controller must be empty. For cgroup v1 it selects the
+// hierarchy of the controller, like "cpu" or "memory".
+func CgroupFsPath(mount, controller, cgroup string) string {
+	if mount == "" {
+		mount = DefaultCgroupMount
+	}
+	return filepath.Join(mount, controller, cgroup)
+}
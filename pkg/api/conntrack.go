@@ -0,0 +1,49 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// ConntrackTable is the name of the nftables table used to enforce the
+	// connection tracking limit of a pod.
+	ConntrackTable = "nri-conntrack"
+)
+
+// ConntrackRuleset returns an nftables ruleset, in the syntax of 'nft -f',
+// which limits the number of tracked connections in a network namespace to
+// max. The node-wide nf_conntrack_max sysctl is not namespaced, so runtimes
+// enforce the per-pod limit of LinuxPodSandboxAdjustment.ConntrackMax by
+// loading this ruleset into the network namespace of the pod. New
+// connections over the limit are dropped.
+func ConntrackRuleset(max uint64) string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "table inet %s\n", ConntrackTable)
+	fmt.Fprintf(b, "delete table inet %s\n", ConntrackTable)
+	fmt.Fprintf(b, "table inet %s {\n", ConntrackTable)
+	for _, hook := range []string{"prerouting", "output"} {
+		fmt.Fprintf(b, "\tchain %s {\n", hook)
+		fmt.Fprintf(b, "\t\ttype filter hook %s priority filter; policy accept;\n", hook)
+		fmt.Fprintf(b, "\t\tct state new ct count over %d drop\n", max)
+		fmt.Fprintf(b, "\t}\n")
+	}
+	fmt.Fprintf(b, "}\n")
+	return b.String()
+}
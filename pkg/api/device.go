@@ -17,6 +17,9 @@
 package api
 
 import (
+	"fmt"
+	"strings"
+
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
@@ -87,3 +90,48 @@ func (d *LinuxDevice) IsMarkedForRemoval() (string, bool) {
 	key, marked := IsMarkedForRemoval(d.Path)
 	return key, marked
 }
+
+// Validate checks that the device cgroup rule has a valid type and access.
+func (r *LinuxDeviceCgroup) Validate() error {
+	switch r.Type {
+	case "", "a", "b", "c":
+	default:
+		return fmt.Errorf("invalid device cgroup rule type %q", r.Type)
+	}
+	for i, c := range r.Access {
+		if !strings.ContainsRune("rwm", c) || strings.ContainsRune(r.Access[:i], c) {
+			return fmt.Errorf("invalid device cgroup rule access %q", r.Access)
+		}
+	}
+	return nil
+}
+
+// ToOCI returns the device cgroup rule for an OCI runtime Spec.
+func (r *LinuxDeviceCgroup) ToOCI() rspec.LinuxDeviceCgroup {
+	if r == nil {
+		return rspec.LinuxDeviceCgroup{}
+	}
+
+	return rspec.LinuxDeviceCgroup{
+		Allow:  r.Allow,
+		Type:   r.Type,
+		Major:  r.Major.Get(),
+		Minor:  r.Minor.Get(),
+		Access: r.Access,
+	}
+}
+
+// Key returns the device matched by the rule, using '*' for wildcards.
+func (r *LinuxDeviceCgroup) Key() string {
+	typ, major, minor := r.Type, "*", "*"
+	if typ == "" {
+		typ = "a"
+	}
+	if r.Major != nil {
+		major = fmt.Sprint(r.Major.GetValue())
+	}
+	if r.Minor != nil {
+		minor = fmt.Sprint(r.Minor.GetValue())
+	}
+	return typ + " " + major + ":" + minor
+}
@@ -0,0 +1,203 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Change operations, using JSON Patch (RFC 6902) terminology.
+const (
+	ChangeAdd     = "add"
+	ChangeRemove  = "remove"
+	ChangeReplace = "replace"
+)
+
+// Change describes a single difference between two objects.
+type Change struct {
+	// Op is the operation, ChangeAdd, ChangeRemove, or ChangeReplace.
+	Op string `json:"op"`
+	// Path is the JSON Pointer (RFC 6901) of the changed value.
+	Path string `json:"path"`
+	// Value is the new value, unset for removals.
+	Value interface{} `json:"value,omitempty"`
+	// Old is the original value, unset for additions.
+	Old interface{} `json:"-"`
+}
+
+// Changes is the list of differences between two objects.
+type Changes []*Change
+
+// Diff returns the differences between an original and an updated
+// object, for instance a pod or a container before and after being
+// adjusted or updated. Objects are compared by their JSON encoding,
+// using protobuf field names. Unset fields and fields with a default
+// value are considered equal. A nil object is considered empty.
+func Diff(orig, updated proto.Message) (Changes, error) {
+	o, err := diffValue(orig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff original object: %w", err)
+	}
+	u, err := diffValue(updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff updated object: %w", err)
+	}
+
+	changes := Changes{}
+	changes.diff("", o, u)
+	return changes, nil
+}
+
+// String returns a human-readable summary of changes, one per line.
+func (c Changes) String() string {
+	if len(c) == 0 {
+		return "<no changes>"
+	}
+
+	lines := make([]string, 0, len(c))
+	for _, ch := range c {
+		lines = append(lines, ch.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// JSONPatch returns changes as a JSON Patch (RFC 6902) document.
+func (c Changes) JSONPatch() ([]byte, error) {
+	if c == nil {
+		c = Changes{}
+	}
+	return json.Marshal(c)
+}
+
+// String returns a human-readable summary of a single change.
+func (c *Change) String() string {
+	switch c.Op {
+	case ChangeAdd:
+		return fmt.Sprintf("%s %s: %s", c.Op, c.Path, diffString(c.Value))
+	case ChangeRemove:
+		return fmt.Sprintf("%s %s: %s", c.Op, c.Path, diffString(c.Old))
+	default:
+		return fmt.Sprintf("%s %s: %s -> %s", c.Op, c.Path, diffString(c.Old), diffString(c.Value))
+	}
+}
+
+func (c *Changes) diff(path string, o, u interface{}) {
+	switch ov := o.(type) {
+	case map[string]interface{}:
+		uv, ok := u.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(ov)+len(uv))
+		for k := range ov {
+			keys = append(keys, k)
+		}
+		for k := range uv {
+			if _, ok := ov[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p := path + "/" + escapePointer(k)
+			oe, inOrig := ov[k]
+			ue, inUpdated := uv[k]
+			switch {
+			case !inOrig:
+				c.add(ChangeAdd, p, nil, ue)
+			case !inUpdated:
+				c.add(ChangeRemove, p, oe, nil)
+			default:
+				c.diff(p, oe, ue)
+			}
+		}
+		return
+
+	case []interface{}:
+		uv, ok := u.([]interface{})
+		if !ok {
+			break
+		}
+		i := 0
+		for ; i < len(ov) && i < len(uv); i++ {
+			c.diff(path+"/"+strconv.Itoa(i), ov[i], uv[i])
+		}
+		for ; i < len(uv); i++ {
+			c.add(ChangeAdd, path+"/"+strconv.Itoa(i), nil, uv[i])
+		}
+		// remove from the end, so indices stay valid when patches are applied
+		for j := len(ov) - 1; j >= i; j-- {
+			c.add(ChangeRemove, path+"/"+strconv.Itoa(j), ov[j], nil)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(o, u) {
+		if path == "" {
+			path = "/"
+		}
+		c.add(ChangeReplace, path, o, u)
+	}
+}
+
+func (c *Changes) add(op, path string, o, u interface{}) {
+	*c = append(*c, &Change{
+		Op:    op,
+		Path:  path,
+		Value: u,
+		Old:   o,
+	})
+}
+
+func diffValue(m proto.Message) (interface{}, error) {
+	v := map[string]interface{}{}
+	if m == nil || reflect.ValueOf(m).IsNil() {
+		return v, nil
+	}
+
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func diffString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
+func escapePointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
@@ -15,3 +15,5 @@
 */
 
 package api
+
+//go:generate go run generate_deepcopy.go
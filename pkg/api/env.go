@@ -58,3 +58,10 @@ func (e *KeyValue) IsMarkedForRemoval() (string, bool) {
 	key, marked := IsMarkedForRemoval(e.Key)
 	return key, marked
 }
+
+// IsMarkedForSetIfAbsent checks if an environment variable is marked to be
+// set only if absent.
+func (e *KeyValue) IsMarkedForSetIfAbsent() (string, bool) {
+	key, marked := IsMarkedForSetIfAbsent(e.Key)
+	return key, marked
+}
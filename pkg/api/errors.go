@@ -0,0 +1,259 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ErrorDomain is the domain of NRI errors in the status details of
+	// errors sent between plugins and the runtime.
+	ErrorDomain = "nri.containerd.io"
+
+	errorKindRejected    = "rejected"
+	errorKindRetryable   = "retryable"
+	errorKindUnsupported = "unsupported"
+)
+
+// ErrRejected is returned by a plugin to reject a request, for instance to
+// veto the creation of a pod or container which violates some policy.
+type ErrRejected struct {
+	// Plugin which rejected the request, filled in by the runtime.
+	Plugin string
+	// Object the rejected request was for, like 'pod default/nginx',
+	// filled in by the runtime for RunPodSandbox and CreateContainer.
+	Object string
+	// Reason is a short, machine-readable reason for the rejection.
+	Reason string
+	// Message is a human-readable description of the rejection, suitable
+	// for showing to the user.
+	Message string
+	// Retryable is set if the request might be accepted later, for instance
+	// once some resource the plugin is waiting for becomes available.
+	Retryable bool
+}
+
+// ErrRetryable is returned by a plugin which failed to handle a request
+// because of a transient error, if the request might succeed if retried.
+type ErrRetryable struct {
+	// Plugin which failed the request, filled in by the runtime.
+	Plugin string
+	// Message is a human-readable description of the error.
+	Message string
+}
+
+// ErrUnsupported is returned by a plugin for requests it does not support.
+type ErrUnsupported struct {
+	// Plugin which failed the request, filled in by the runtime.
+	Plugin string
+	// Message is a human-readable description of the error.
+	Message string
+}
+
+// Error returns the error message for the rejection.
+func (e *ErrRejected) Error() string {
+	what := "request"
+	if e.Object != "" {
+		what = e.Object
+	}
+	what = "rejected " + what + " (" + e.Reason
+	if e.Retryable {
+		what += ", retryable"
+	}
+	return errorString(e.Plugin, what+")", e.Message)
+}
+
+// GRPCStatus returns the gRPC status to send the rejection as.
+func (e *ErrRejected) GRPCStatus() *status.Status {
+	return errorStatus(codes.PermissionDenied, errorKindRejected, e.Reason, e.Message, e.Retryable)
+}
+
+// Error returns the error message for the error.
+func (e *ErrRetryable) Error() string {
+	return errorString(e.Plugin, "failed request (retryable)", e.Message)
+}
+
+// GRPCStatus returns the gRPC status to send the error as.
+func (e *ErrRetryable) GRPCStatus() *status.Status {
+	return errorStatus(codes.Unavailable, errorKindRetryable, "", e.Message, true)
+}
+
+// Error returns the error message for the error.
+func (e *ErrUnsupported) Error() string {
+	return errorString(e.Plugin, "unsupported request", e.Message)
+}
+
+// GRPCStatus returns the gRPC status to send the error as.
+func (e *ErrUnsupported) GRPCStatus() *status.Status {
+	return errorStatus(codes.Unimplemented, errorKindUnsupported, "", e.Message, false)
+}
+
+// ToStatusError converts any ErrRejected, ErrRetryable or ErrUnsupported in
+// the chain of err to an error which can be sent to the peer. Other errors
+// are returned as such.
+func ToStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		rejected    *ErrRejected
+		retryable   *ErrRetryable
+		unsupported *ErrUnsupported
+	)
+
+	switch {
+	case errors.As(err, &rejected):
+		return rejected.GRPCStatus().Err()
+	case errors.As(err, &retryable):
+		return retryable.GRPCStatus().Err()
+	case errors.As(err, &unsupported):
+		return unsupported.GRPCStatus().Err()
+	}
+
+	return err
+}
+
+// FromStatusError converts an error received from the peer back to an
+// ErrRejected, ErrRetryable or ErrUnsupported, setting plugin as the
+// origin of the error. Other errors are returned as such.
+func FromStatusError(err error, plugin string) error {
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return err
+	}
+
+	if info := errorInfo(st); info != nil {
+		if e := fromErrorKind(info.Metadata["kind"], info.Reason, st.Message(),
+			info.Metadata["retryable"] == "true", plugin); e != nil {
+			return e
+		}
+	}
+
+	return err
+}
+
+// ToErrorDetails returns the details and message of any ErrRejected,
+// ErrRetryable or ErrUnsupported in the chain of err, for sending the error
+// to the peer in a message instead of as a gRPC status. Other errors have
+// no details, and their full error string as the message.
+func ToErrorDetails(err error) (*ErrorDetails, string) {
+	if err == nil {
+		return nil, ""
+	}
+
+	st, ok := status.FromError(ToStatusError(err))
+	if !ok || st == nil {
+		return nil, err.Error()
+	}
+	info := errorInfo(st)
+	if info == nil {
+		return nil, err.Error()
+	}
+
+	return &ErrorDetails{
+		Kind:      info.Metadata["kind"],
+		Reason:    info.Reason,
+		Retryable: info.Metadata["retryable"] == "true",
+	}, st.Message()
+}
+
+// FromErrorDetails converts an error received from the peer in a message,
+// with the given details, back to an ErrRejected, ErrRetryable or
+// ErrUnsupported, setting plugin as the origin of the error. Errors without
+// details are returned as plain errors with the message.
+func FromErrorDetails(details *ErrorDetails, msg, plugin string) error {
+	if details != nil {
+		if e := fromErrorKind(details.Kind, details.Reason, msg, details.Retryable, plugin); e != nil {
+			return e
+		}
+	}
+	return errors.New(msg)
+}
+
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain {
+			return info
+		}
+	}
+	return nil
+}
+
+func fromErrorKind(kind, reason, msg string, retryable bool, plugin string) error {
+	switch kind {
+	case errorKindRejected:
+		return &ErrRejected{
+			Plugin:    plugin,
+			Reason:    reason,
+			Message:   msg,
+			Retryable: retryable,
+		}
+	case errorKindRetryable:
+		return &ErrRetryable{Plugin: plugin, Message: msg}
+	case errorKindUnsupported:
+		return &ErrUnsupported{Plugin: plugin, Message: msg}
+	}
+	return nil
+}
+
+// IsRetryable returns true if err is an ErrRetryable, or a retryable
+// ErrRejected, so the failed request might succeed if retried later.
+func IsRetryable(err error) bool {
+	var (
+		rejected  *ErrRejected
+		retryable *ErrRetryable
+	)
+	if errors.As(err, &rejected) {
+		return rejected.Retryable
+	}
+	return errors.As(err, &retryable)
+}
+
+func errorString(plugin, what, msg string) string {
+	s := what
+	if plugin != "" {
+		s = "plugin " + plugin + " " + s
+	}
+	if msg != "" {
+		s += ": " + msg
+	}
+	return s
+}
+
+func errorStatus(code codes.Code, kind, reason, msg string, retryable bool) *status.Status {
+	st := status.New(code, msg)
+	info := &errdetails.ErrorInfo{
+		Domain: ErrorDomain,
+		Reason: reason,
+		Metadata: map[string]string{
+			"kind":      kind,
+			"retryable": strconv.FormatBool(retryable),
+		},
+	}
+	if withDetails, err := st.WithDetails(info); err == nil {
+		return withDetails
+	}
+	return status.New(code, fmt.Sprintf("%s: %s", kind, msg))
+}
@@ -30,7 +30,6 @@ const (
 type (
 	// Define *Request/*Response type aliases for *Event/Empty pairs.
 
-	StateChangeResponse         = Empty
 	RunPodSandboxRequest        = StateChangeEvent
 	RunPodSandboxResponse       = Empty
 	StopPodSandboxRequest       = StateChangeEvent
@@ -48,6 +47,39 @@ type (
 	PostUpdateContainerRequest  = StateChangeEvent
 	PostUpdateContainerResponse = Empty
 
+	CheckpointPodSandboxRequest  = StateChangeEvent
+	CheckpointPodSandboxResponse = Empty
+	RestorePodSandboxRequest     = StateChangeEvent
+	RestorePodSandboxResponse    = Empty
+	CheckpointContainerRequest   = StateChangeEvent
+	CheckpointContainerResponse  = Empty
+	RestoreContainerRequest      = StateChangeEvent
+	RestoreContainerResponse     = Empty
+	ImagePulledRequest           = StateChangeEvent
+	ImagePulledResponse          = Empty
+	ImageRemovedRequest          = StateChangeEvent
+	ImageRemovedResponse         = Empty
+	PreExecRequest               = StateChangeEvent
+	PreExecResponse              = Empty
+	PostExecRequest              = StateChangeEvent
+	PostExecResponse             = Empty
+	ContainerOOMRequest          = StateChangeEvent
+	ContainerOOMResponse         = Empty
+	ContainerExitRequest         = StateChangeEvent
+	ContainerExitResponse        = Empty
+	UpdatePodSandboxRequest      = StateChangeEvent
+	UpdatePodSandboxResponse     = Empty
+	PostUpdatePodSandboxRequest  = StateChangeEvent
+	PostUpdatePodSandboxResponse = Empty
+	ResourcePressureRequest      = StateChangeEvent
+	ResourcePressureResponse     = Empty
+	NetworkStatusRequest         = StateChangeEvent
+	NetworkStatusResponse        = Empty
+	NetworkGCRequest             = StateChangeEvent
+	NetworkGCResponse            = Empty
+	SetupNetworkFailedRequest    = StateChangeEvent
+	SetupNetworkFailedResponse   = StateChangeResponse
+
 	ShutdownRequest  = Empty
 	ShutdownResponse = Empty
 )
@@ -71,6 +103,30 @@ func ParseEventMask(events ...string) (EventMask, error) {
 		"postupdatecontainer": Event_POST_UPDATE_CONTAINER,
 		"stopcontainer":       Event_STOP_CONTAINER,
 		"removecontainer":     Event_REMOVE_CONTAINER,
+
+		"checkpointpodsandbox": Event_CHECKPOINT_POD_SANDBOX,
+		"restorepodsandbox":    Event_RESTORE_POD_SANDBOX,
+		"checkpointcontainer":  Event_CHECKPOINT_CONTAINER,
+		"restorecontainer":     Event_RESTORE_CONTAINER,
+
+		"imagepulled":  Event_IMAGE_PULLED,
+		"imageremoved": Event_IMAGE_REMOVED,
+
+		"preexec":  Event_PRE_EXEC,
+		"postexec": Event_POST_EXEC,
+
+		"containeroom":  Event_CONTAINER_OOM,
+		"containerexit": Event_CONTAINER_EXIT,
+
+		"updatepodsandbox":     Event_UPDATE_POD_SANDBOX,
+		"postupdatepodsandbox": Event_POST_UPDATE_POD_SANDBOX,
+
+		"resourcepressure": Event_RESOURCE_PRESSURE,
+
+		"networkstatus": Event_NETWORK_STATUS,
+		"networkgc":     Event_NETWORK_GC,
+
+		"setupnetworkfailed": Event_SETUP_NETWORK_FAILED,
 	}
 
 	for _, event := range events {
@@ -130,6 +186,30 @@ func (m *EventMask) PrettyString() string {
 		Event_POST_UPDATE_CONTAINER: "PostUpdateContainer",
 		Event_STOP_CONTAINER:        "StopContainer",
 		Event_REMOVE_CONTAINER:      "RemoveContainer",
+
+		Event_CHECKPOINT_POD_SANDBOX: "CheckpointPodSandbox",
+		Event_RESTORE_POD_SANDBOX:    "RestorePodSandbox",
+		Event_CHECKPOINT_CONTAINER:   "CheckpointContainer",
+		Event_RESTORE_CONTAINER:      "RestoreContainer",
+
+		Event_IMAGE_PULLED:  "ImagePulled",
+		Event_IMAGE_REMOVED: "ImageRemoved",
+
+		Event_PRE_EXEC:  "PreExec",
+		Event_POST_EXEC: "PostExec",
+
+		Event_CONTAINER_OOM:  "ContainerOOM",
+		Event_CONTAINER_EXIT: "ContainerExit",
+
+		Event_UPDATE_POD_SANDBOX:      "UpdatePodSandbox",
+		Event_POST_UPDATE_POD_SANDBOX: "PostUpdatePodSandbox",
+
+		Event_RESOURCE_PRESSURE: "ResourcePressure",
+
+		Event_NETWORK_STATUS: "NetworkStatus",
+		Event_NETWORK_GC:     "NetworkGC",
+
+		Event_SETUP_NETWORK_FAILED: "SetupNetworkFailed",
 	}
 
 	mask := *m
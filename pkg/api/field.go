@@ -0,0 +1,161 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// NewFieldMask returns a mask with the given optional fields set.
+func NewFieldMask(fields ...Field) *FieldMask {
+	return (&FieldMask{}).Set(fields...)
+}
+
+// ParseFieldMask parses optional field names, like container_env or
+// CONTAINER_ENV, into a mask.
+func ParseFieldMask(names ...string) (*FieldMask, error) {
+	m := &FieldMask{}
+	for _, name := range names {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		f, ok := Field_value[name]
+		if !ok || Field(f) == Field_FIELD_UNKNOWN || Field(f) == Field_FIELD_LAST {
+			return nil, fmt.Errorf("unknown optional field %q", name)
+		}
+		m.Set(Field(f))
+	}
+	return m, nil
+}
+
+// Set sets the given optional fields in the mask.
+func (m *FieldMask) Set(fields ...Field) *FieldMask {
+	for _, f := range fields {
+		m.Fields |= 1 << (f - 1)
+	}
+	return m
+}
+
+// IsSet checks if the given optional field is set in the mask. All fields
+// are set in a nil mask.
+func (m *FieldMask) IsSet(f Field) bool {
+	if m == nil {
+		return true
+	}
+	return m.Fields&(1<<(f-1)) != 0
+}
+
+// FilterPod returns the pod with the optional fields not in the mask
+// omitted. The pod is returned as such if there is nothing to omit,
+// otherwise a shallow copy of it is returned.
+func (m *FieldMask) FilterPod(pod *PodSandbox) *PodSandbox {
+	var (
+		linux = pod.GetLinux() != nil && !m.IsSet(Field_POD_LINUX)
+		cni   = pod.GetNetwork().GetCniResult() != "" && !m.IsSet(Field_POD_CNI_RESULT)
+	)
+
+	if !linux && !cni {
+		return pod
+	}
+
+	p := &PodSandbox{}
+	shallowCopy(p, pod)
+	if linux {
+		p.Linux = nil
+	}
+	if cni {
+		p.Network = &PodSandboxNetworkStatus{}
+		shallowCopy(p.Network, pod.Network)
+		p.Network.CniResult = ""
+	}
+
+	return p
+}
+
+// FilterContainer returns the container with the optional fields not in
+// the mask omitted. The container is returned as such if there is nothing
+// to omit, otherwise a shallow copy of it is returned.
+func (m *FieldMask) FilterContainer(ctr *Container) *Container {
+	var (
+		args    = len(ctr.GetArgs()) > 0 && !m.IsSet(Field_CONTAINER_ARGS)
+		env     = len(ctr.GetEnv()) > 0 && !m.IsSet(Field_CONTAINER_ENV)
+		mounts  = len(ctr.GetMounts()) > 0 && !m.IsSet(Field_CONTAINER_MOUNTS)
+		hooks   = ctr.GetHooks() != nil && !m.IsSet(Field_CONTAINER_HOOKS)
+		linux   = ctr.GetLinux() != nil && !m.IsSet(Field_CONTAINER_LINUX)
+		rlimits = len(ctr.GetRlimits()) > 0 && !m.IsSet(Field_CONTAINER_RLIMITS)
+	)
+
+	if !args && !env && !mounts && !hooks && !linux && !rlimits {
+		return ctr
+	}
+
+	c := &Container{}
+	shallowCopy(c, ctr)
+	if args {
+		c.Args = nil
+	}
+	if env {
+		c.Env = nil
+	}
+	if mounts {
+		c.Mounts = nil
+	}
+	if hooks {
+		c.Hooks = nil
+	}
+	if linux {
+		c.Linux = nil
+	}
+	if rlimits {
+		c.Rlimits = nil
+	}
+
+	return c
+}
+
+// FilterEvent returns the event with the optional fields not in the mask
+// omitted from its pod and container. The event is returned as such if
+// there is nothing to omit, otherwise a shallow copy of it is returned.
+func (m *FieldMask) FilterEvent(evt *StateChangeEvent) *StateChangeEvent {
+	var (
+		pod = m.FilterPod(evt.GetPod())
+		ctr = m.FilterContainer(evt.GetContainer())
+	)
+
+	if pod == evt.GetPod() && ctr == evt.GetContainer() {
+		return evt
+	}
+
+	e := &StateChangeEvent{}
+	shallowCopy(e, evt)
+	e.Pod = pod
+	e.Container = ctr
+
+	return e
+}
+
+// shallowCopy copies the populated fields of src to dst, sharing any
+// nested messages, lists and maps.
+func shallowCopy(dst, src proto.Message) {
+	d := dst.ProtoReflect()
+	src.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		d.Set(fd, v)
+		return true
+	})
+}
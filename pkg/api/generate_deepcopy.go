@@ -0,0 +1,117 @@
+//go:build ignore
+
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// This program generates DeepCopy and Equal methods for all messages
+// defined in api.proto. It is run by go generate from this directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"reflect"
+	"sort"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+const (
+	output = "api_deepcopy.go"
+	header = `/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Code generated by generate_deepcopy.go. DO NOT EDIT.
+
+package api
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+`
+	methods = `
+// DeepCopy returns a deep copy of the %[1]s.
+func (x *%[1]s) DeepCopy() *%[1]s {
+	if x == nil {
+		return nil
+	}
+	return proto.Clone(x).(*%[1]s)
+}
+
+// Equal returns true if the %[1]s is equal to o.
+func (x *%[1]s) Equal(o *%[1]s) bool {
+	return proto.Equal(x, o)
+}
+`
+)
+
+func main() {
+	names := []string{}
+	collect(api.File_pkg_api_api_proto.Messages(), &names)
+	sort.Strings(names)
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(header)
+	for _, name := range names {
+		fmt.Fprintf(buf, methods, name)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fail("failed to format generated code: %v", err)
+	}
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		fail("failed to write %s: %v", output, err)
+	}
+}
+
+func collect(messages protoreflect.MessageDescriptors, names *[]string) {
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+		if err != nil {
+			fail("failed to look up message %s: %v", md.FullName(), err)
+		}
+		*names = append(*names, reflect.TypeOf(mt.Zero().Interface()).Elem().Name())
+		collect(md.Messages(), names)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
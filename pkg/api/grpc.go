@@ -0,0 +1,194 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// Transport is an RPC transport used on NRI plugin connections.
+type Transport string
+
+const (
+	// TransportTTRPC is the default, ttRPC transport.
+	TransportTTRPC Transport = "ttrpc"
+	// TransportGRPC is the gRPC transport.
+	TransportGRPC Transport = "grpc"
+)
+
+const (
+	runtimeServiceName = "nri.pkg.api.v1alpha1.Runtime"
+	pluginServiceName  = "nri.pkg.api.v1alpha1.Plugin"
+)
+
+// RegisterRuntimeGRPCService registers a RuntimeService with a gRPC server.
+func RegisterRuntimeGRPCService(srv *grpc.Server, svc RuntimeService) {
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: runtimeServiceName,
+		HandlerType: (*RuntimeService)(nil),
+		Methods: []grpc.MethodDesc{
+			grpcMethod(runtimeServiceName, "RegisterPlugin", RuntimeService.RegisterPlugin),
+			grpcMethod(runtimeServiceName, "RegisterClient", RuntimeService.RegisterClient),
+			grpcMethod(runtimeServiceName, "UpdateContainers", RuntimeService.UpdateContainers),
+			grpcMethod(runtimeServiceName, "ListPlugins", RuntimeService.ListPlugins),
+			grpcMethod(runtimeServiceName, "GetStats", RuntimeService.GetStats),
+			grpcMethod(runtimeServiceName, "RestartContainer", RuntimeService.RestartContainer),
+			grpcMethod(runtimeServiceName, "SetNetworkReadiness", RuntimeService.SetNetworkReadiness),
+			grpcMethod(runtimeServiceName, "DeliverCreateContainerResponse", RuntimeService.DeliverCreateContainerResponse),
+			grpcMethod(runtimeServiceName, "SimulatePod", RuntimeService.SimulatePod),
+		},
+	}, svc)
+}
+
+// RegisterPluginGRPCService registers a PluginService with a gRPC server.
+func RegisterPluginGRPCService(srv *grpc.Server, svc PluginService) {
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: pluginServiceName,
+		HandlerType: (*PluginService)(nil),
+		Methods: []grpc.MethodDesc{
+			grpcMethod(pluginServiceName, "Configure", PluginService.Configure),
+			grpcMethod(pluginServiceName, "Synchronize", PluginService.Synchronize),
+			grpcMethod(pluginServiceName, "Shutdown", PluginService.Shutdown),
+			grpcMethod(pluginServiceName, "CreateContainer", PluginService.CreateContainer),
+			grpcMethod(pluginServiceName, "UpdateContainer", PluginService.UpdateContainer),
+			grpcMethod(pluginServiceName, "StopContainer", PluginService.StopContainer),
+			grpcMethod(pluginServiceName, "StateChange", PluginService.StateChange),
+		},
+	}, svc)
+}
+
+// grpcMethod creates a gRPC unary method descriptor for a service method.
+func grpcMethod[S, Req, Rpl any](service, method string, call func(S, context.Context, *Req) (*Rpl, error)) grpc.MethodDesc {
+	fullMethod := "/" + service + "/" + method
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			req := new(Req)
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(S), ctx, req)
+			}
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: fullMethod,
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(srv.(S), ctx, req.(*Req))
+			}
+			return interceptor(ctx, req, info, handler)
+		},
+	}
+}
+
+// grpcInvoke invokes a unary gRPC method.
+func grpcInvoke[Req, Rpl any](ctx context.Context, cc grpc.ClientConnInterface, service, method string, req *Req) (*Rpl, error) {
+	rpl := new(Rpl)
+	if err := cc.Invoke(ctx, "/"+service+"/"+method, req, rpl); err != nil {
+		return nil, err
+	}
+	return rpl, nil
+}
+
+type runtimeGRPCClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewRuntimeGRPCClient creates a RuntimeService client for a gRPC connection.
+func NewRuntimeGRPCClient(cc grpc.ClientConnInterface) RuntimeService {
+	return &runtimeGRPCClient{
+		cc: cc,
+	}
+}
+
+func (c *runtimeGRPCClient) RegisterPlugin(ctx context.Context, req *RegisterPluginRequest) (*Empty, error) {
+	return grpcInvoke[RegisterPluginRequest, Empty](ctx, c.cc, runtimeServiceName, "RegisterPlugin", req)
+}
+
+func (c *runtimeGRPCClient) RegisterClient(ctx context.Context, req *RegisterClientRequest) (*Empty, error) {
+	return grpcInvoke[RegisterClientRequest, Empty](ctx, c.cc, runtimeServiceName, "RegisterClient", req)
+}
+
+func (c *runtimeGRPCClient) UpdateContainers(ctx context.Context, req *UpdateContainersRequest) (*UpdateContainersResponse, error) {
+	return grpcInvoke[UpdateContainersRequest, UpdateContainersResponse](ctx, c.cc, runtimeServiceName, "UpdateContainers", req)
+}
+
+func (c *runtimeGRPCClient) ListPlugins(ctx context.Context, req *ListPluginsRequest) (*ListPluginsResponse, error) {
+	return grpcInvoke[ListPluginsRequest, ListPluginsResponse](ctx, c.cc, runtimeServiceName, "ListPlugins", req)
+}
+
+func (c *runtimeGRPCClient) GetStats(ctx context.Context, req *GetStatsRequest) (*GetStatsResponse, error) {
+	return grpcInvoke[GetStatsRequest, GetStatsResponse](ctx, c.cc, runtimeServiceName, "GetStats", req)
+}
+
+func (c *runtimeGRPCClient) RestartContainer(ctx context.Context, req *RestartContainerRequest) (*RestartContainerResponse, error) {
+	return grpcInvoke[RestartContainerRequest, RestartContainerResponse](ctx, c.cc, runtimeServiceName, "RestartContainer", req)
+}
+
+func (c *runtimeGRPCClient) SetNetworkReadiness(ctx context.Context, req *SetNetworkReadinessRequest) (*SetNetworkReadinessResponse, error) {
+	return grpcInvoke[SetNetworkReadinessRequest, SetNetworkReadinessResponse](ctx, c.cc, runtimeServiceName, "SetNetworkReadiness", req)
+}
+
+func (c *runtimeGRPCClient) DeliverCreateContainerResponse(ctx context.Context, req *DeliverCreateContainerResponseRequest) (*Empty, error) {
+	return grpcInvoke[DeliverCreateContainerResponseRequest, Empty](ctx, c.cc, runtimeServiceName, "DeliverCreateContainerResponse", req)
+}
+
+func (c *runtimeGRPCClient) SimulatePod(ctx context.Context, req *SimulatePodRequest) (*SimulatePodResponse, error) {
+	return grpcInvoke[SimulatePodRequest, SimulatePodResponse](ctx, c.cc, runtimeServiceName, "SimulatePod", req)
+}
+
+type pluginGRPCClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewPluginGRPCClient creates a PluginService client for a gRPC connection.
+func NewPluginGRPCClient(cc grpc.ClientConnInterface) PluginService {
+	return &pluginGRPCClient{
+		cc: cc,
+	}
+}
+
+func (c *pluginGRPCClient) Configure(ctx context.Context, req *ConfigureRequest) (*ConfigureResponse, error) {
+	return grpcInvoke[ConfigureRequest, ConfigureResponse](ctx, c.cc, pluginServiceName, "Configure", req)
+}
+
+func (c *pluginGRPCClient) Synchronize(ctx context.Context, req *SynchronizeRequest) (*SynchronizeResponse, error) {
+	return grpcInvoke[SynchronizeRequest, SynchronizeResponse](ctx, c.cc, pluginServiceName, "Synchronize", req)
+}
+
+func (c *pluginGRPCClient) Shutdown(ctx context.Context, req *Empty) (*Empty, error) {
+	return grpcInvoke[Empty, Empty](ctx, c.cc, pluginServiceName, "Shutdown", req)
+}
+
+func (c *pluginGRPCClient) CreateContainer(ctx context.Context, req *CreateContainerRequest) (*CreateContainerResponse, error) {
+	return grpcInvoke[CreateContainerRequest, CreateContainerResponse](ctx, c.cc, pluginServiceName, "CreateContainer", req)
+}
+
+func (c *pluginGRPCClient) UpdateContainer(ctx context.Context, req *UpdateContainerRequest) (*UpdateContainerResponse, error) {
+	return grpcInvoke[UpdateContainerRequest, UpdateContainerResponse](ctx, c.cc, pluginServiceName, "UpdateContainer", req)
+}
+
+func (c *pluginGRPCClient) StopContainer(ctx context.Context, req *StopContainerRequest) (*StopContainerResponse, error) {
+	return grpcInvoke[StopContainerRequest, StopContainerResponse](ctx, c.cc, pluginServiceName, "StopContainer", req)
+}
+
+func (c *pluginGRPCClient) StateChange(ctx context.Context, req *StateChangeEvent) (*StateChangeResponse, error) {
+	return grpcInvoke[StateChangeEvent, StateChangeResponse](ctx, c.cc, pluginServiceName, "StateChange", req)
+}
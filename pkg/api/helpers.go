@@ -57,3 +57,24 @@ func IsMarkedForRemoval(key string) (string, bool) {
 func MarkForRemoval(key string) string {
 	return "-" + key
 }
+
+// IsMarkedForSetIfAbsent checks if a key is marked to be set only if absent.
+//
+// The key can be an annotation name, a label name, or an environment variable
+// name. These are marked in adjustments by preceding their corresponding key
+// with a '?'. Such a key is only set if it is not already present, either
+// originally or set by another plugin.
+func IsMarkedForSetIfAbsent(key string) (string, bool) {
+	if key == "" {
+		return "", false
+	}
+	if key[0] != '?' {
+		return key, false
+	}
+	return key[1:], true
+}
+
+// MarkForSetIfAbsent returns a key marked to be set only if absent.
+func MarkForSetIfAbsent(key string) string {
+	return "?" + key
+}
@@ -17,9 +17,39 @@
 package api
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
+// HookTemplateData is the data available for expanding templated hooks.
+// The arguments and environment variables of hooks can be Go templates,
+// for instance "{{.NetNSPath}}" or "{{join .IPs \",\"}}". The runtime
+// expands these when it adds the hooks to the OCI Spec.
+type HookTemplateData struct {
+	// PodName is the name of the pod.
+	PodName string
+	// PodNamespace is the namespace of the pod.
+	PodNamespace string
+	// PodUID is the UID of the pod.
+	PodUID string
+	// ContainerName is the name of the container.
+	ContainerName string
+	// ContainerID is the ID of the container.
+	ContainerID string
+	// NetNSPath is the path of the network namespace of the pod.
+	NetNSPath string
+	// IPs are the IP addresses of the pod, as assigned by CNI.
+	IPs []string
+}
+
+var hookTemplateFuncs = template.FuncMap{
+	"join": strings.Join,
+}
+
 // Append appends the given hooks to the existing ones.
 func (hooks *Hooks) Append(h *Hooks) *Hooks {
 	if h == nil {
@@ -63,6 +93,116 @@ func (hooks *Hooks) Hooks() *Hooks {
 	return nil
 }
 
+// CheckTemplates checks that all templated hooks can be parsed.
+func (hooks *Hooks) CheckTemplates() error {
+	if hooks == nil {
+		return nil
+	}
+	for _, slice := range [][]*Hook{
+		hooks.Prestart,
+		hooks.CreateRuntime,
+		hooks.CreateContainer,
+		hooks.StartContainer,
+		hooks.Poststart,
+		hooks.Poststop,
+	} {
+		for _, h := range slice {
+			if err := h.CheckTemplates(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsTemplated returns true if any argument or environment variable of the
+// hook is a template.
+func (h *Hook) IsTemplated() bool {
+	for _, s := range h.Args {
+		if isTemplate(s) {
+			return true
+		}
+	}
+	for _, s := range h.Env {
+		if isTemplate(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTemplates checks that all templates of the hook can be parsed.
+func (h *Hook) CheckTemplates() error {
+	for _, s := range append(append([]string{}, h.Args...), h.Env...) {
+		if !isTemplate(s) {
+			continue
+		}
+		if _, err := parseHookTemplate(s); err != nil {
+			return fmt.Errorf("hook %q: %w", h.Path, err)
+		}
+	}
+	return nil
+}
+
+// Expand returns a copy of the hook with all templates expanded using the
+// given data.
+func (h *Hook) Expand(data *HookTemplateData) (*Hook, error) {
+	var err error
+
+	x := &Hook{
+		Path:    h.Path,
+		Args:    DupStringSlice(h.Args),
+		Env:     DupStringSlice(h.Env),
+		Timeout: h.Timeout,
+	}
+
+	for i, s := range x.Args {
+		if x.Args[i], err = expandHookTemplate(s, data); err != nil {
+			return nil, fmt.Errorf("hook %q: %w", h.Path, err)
+		}
+	}
+	for i, s := range x.Env {
+		if x.Env[i], err = expandHookTemplate(s, data); err != nil {
+			return nil, fmt.Errorf("hook %q: %w", h.Path, err)
+		}
+	}
+
+	return x, nil
+}
+
+func isTemplate(s string) bool {
+	return strings.Contains(s, "{{")
+}
+
+func parseHookTemplate(s string) (*template.Template, error) {
+	t, err := template.New("hook").Funcs(hookTemplateFuncs).Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %q: %w", s, err)
+	}
+	return t, nil
+}
+
+func expandHookTemplate(s string, data *HookTemplateData) (string, error) {
+	if !isTemplate(s) {
+		return s, nil
+	}
+	if data == nil {
+		return "", fmt.Errorf("no data to expand template %q", s)
+	}
+
+	t, err := parseHookTemplate(s)
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, data); err != nil {
+		return "", fmt.Errorf("failed to expand template %q: %w", s, err)
+	}
+
+	return buf.String(), nil
+}
+
 // ToOCI returns the hook for an OCI runtime Spec.
 func (h *Hook) ToOCI() rspec.Hook {
 	return rspec.Hook{
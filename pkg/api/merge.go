@@ -0,0 +1,258 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MergePolicy determines how conflicting adjustments are merged.
+type MergePolicy int
+
+const (
+	// MergeFailOnConflict fails merging if adjustments conflict.
+	MergeFailOnConflict MergePolicy = iota
+	// MergeFirstWins keeps the conflicting value of the first adjustment.
+	MergeFirstWins
+	// MergeLastWins keeps the conflicting value of the last adjustment.
+	MergeLastWins
+)
+
+// MergeAdjustments merges container adjustments into a single one.
+//
+// Adjustments are merged in the order given. Annotations, labels, sysctls,
+// and unified resources are merged by key, mounts by container path, devices
+// by path, environment variables by name, rlimits by type, and CDI devices
+// by name. Hooks, ID mappings and device cgroup rules are appended. Any other
+// value conflicts if it is set to a different value in several adjustments.
+// Keys marked for removal conflict with the same key being set. Keys marked
+// to be set only if absent never conflict and are dropped if the same key
+// is set. Conflicts are resolved according to the given policy.
+//
+// MergeAdjustments is meant for plugins which combine the adjustments of
+// their own policy modules before replying to the runtime. It does not
+// implement how the runtime combines the adjustments of several plugins.
+// The runtime tracks which plugin adjusted each value, treats any value
+// set by two plugins as a conflict even if the values are equal, lets a
+// plugin remove values set by earlier plugins, and resolves values to be
+// set only if absent against the container itself.
+func MergeAdjustments(policy MergePolicy, adjustments ...*ContainerAdjustment) (*ContainerAdjustment, error) {
+	merged := &ContainerAdjustment{}
+	for _, a := range adjustments {
+		if a == nil {
+			continue
+		}
+		if err := mergeMessage(policy, "", merged.ProtoReflect(), a.ProtoReflect()); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
+
+func mergeMessage(policy MergePolicy, path string, dst, src protoreflect.Message) error {
+	var err error
+
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		p := path + string(fd.Name())
+		switch {
+		case fd.IsMap():
+			err = mergeMap(policy, p, dst.Mutable(fd).Map(), v.Map())
+		case fd.IsList():
+			err = mergeList(policy, p, dst.Mutable(fd).List(), v.List())
+		case fd.Message() != nil && isMergedByField(v.Message()):
+			err = mergeMessage(policy, p+".", dst.Mutable(fd).Message(), v.Message())
+		default:
+			if !dst.Has(fd) || mergeEqual(dst.Get(fd), v) {
+				dst.Set(fd, mergeClone(v))
+				break
+			}
+			if err = mergeConflict(policy, p); err == nil && policy == MergeLastWins {
+				dst.Set(fd, mergeClone(v))
+			}
+		}
+		return err == nil
+	})
+
+	return err
+}
+
+func mergeMap(policy MergePolicy, path string, dst, src protoreflect.Map) error {
+	var err error
+
+	src.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+		key := k.String()
+		name := mergeName(key)
+		p := path + "[" + name + "]"
+
+		var (
+			old    protoreflect.MapKey
+			oldVal protoreflect.Value
+			found  bool
+		)
+		dst.Range(func(dk protoreflect.MapKey, dv protoreflect.Value) bool {
+			if mergeName(dk.String()) == name {
+				old, oldVal, found = dk, dv, true
+			}
+			return !found
+		})
+
+		switch {
+		case !found:
+			dst.Set(k, v)
+		case old.String() == key && mergeEqual(oldVal, v):
+		case isSetIfAbsent(key):
+		case isSetIfAbsent(old.String()):
+			dst.Clear(old)
+			dst.Set(k, v)
+		default:
+			if err = mergeConflict(policy, p); err == nil && policy == MergeLastWins {
+				dst.Clear(old)
+				dst.Set(k, v)
+			}
+		}
+		return err == nil
+	})
+
+	return err
+}
+
+func mergeList(policy MergePolicy, path string, dst, src protoreflect.List) error {
+	for i := 0; i < src.Len(); i++ {
+		v := src.Get(i)
+		key, ok := mergeKey(v)
+		if !ok {
+			dst.Append(mergeClone(v))
+			continue
+		}
+
+		name := mergeName(key)
+		idx := -1
+		for j := 0; j < dst.Len(); j++ {
+			if k, _ := mergeKey(dst.Get(j)); mergeName(k) == name {
+				idx = j
+				break
+			}
+		}
+
+		if idx < 0 {
+			dst.Append(mergeClone(v))
+			continue
+		}
+
+		oldKey, _ := mergeKey(dst.Get(idx))
+		switch {
+		case oldKey == key && mergeEqual(dst.Get(idx), v):
+		case isSetIfAbsent(key):
+		case isSetIfAbsent(oldKey):
+			dst.Set(idx, mergeClone(v))
+		default:
+			if err := mergeConflict(policy, path+"["+name+"]"); err != nil {
+				return err
+			}
+			if policy == MergeLastWins {
+				dst.Set(idx, mergeClone(v))
+			}
+		}
+	}
+
+	return nil
+}
+
+// isMergedByField returns true if a message is merged field by field
+// instead of being treated as a single value.
+func isMergedByField(m protoreflect.Message) bool {
+	switch m.Interface().(type) {
+	case *ContainerAdjustment, *LinuxContainerAdjustment, *Hooks,
+		*LinuxResources, *LinuxMemory, *LinuxCPU, *LinuxBlockIO,
+		*LinuxCapabilities:
+		return true
+	}
+	return false
+}
+
+// mergeKey returns the key used to merge a list item, or false if items
+// are appended instead.
+func mergeKey(v protoreflect.Value) (string, bool) {
+	if s, ok := v.Interface().(string); ok {
+		return s, true
+	}
+
+	m, ok := v.Interface().(protoreflect.Message)
+	if !ok {
+		return "", false
+	}
+
+	switch o := m.Interface().(type) {
+	case *Mount:
+		return o.Destination, true
+	case *KeyValue:
+		return o.Key, true
+	case *LinuxDevice:
+		return o.Path, true
+	case *CDIDevice:
+		return o.Name, true
+	case *POSIXRlimit:
+		return o.Type, true
+	case *HugepageLimit:
+		return o.PageSize, true
+	case *LinuxWeightDevice:
+		return strconv.FormatInt(o.Major, 10) + ":" + strconv.FormatInt(o.Minor, 10), true
+	case *LinuxThrottleDevice:
+		return strconv.FormatInt(o.Major, 10) + ":" + strconv.FormatInt(o.Minor, 10), true
+	}
+
+	return "", false
+}
+
+// mergeName strips any removal or set-if-absent marker from a key.
+func mergeName(key string) string {
+	name, _ := IsMarkedForRemoval(key)
+	name, _ = IsMarkedForSetIfAbsent(name)
+	return name
+}
+
+func isSetIfAbsent(key string) bool {
+	_, marked := IsMarkedForSetIfAbsent(key)
+	return marked
+}
+
+func mergeEqual(a, b protoreflect.Value) bool {
+	if am, ok := a.Interface().(protoreflect.Message); ok {
+		bm, ok := b.Interface().(protoreflect.Message)
+		return ok && proto.Equal(am.Interface(), bm.Interface())
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+func mergeClone(v protoreflect.Value) protoreflect.Value {
+	if m, ok := v.Interface().(protoreflect.Message); ok {
+		return protoreflect.ValueOfMessage(proto.Clone(m.Interface()).ProtoReflect())
+	}
+	return v
+}
+
+func mergeConflict(policy MergePolicy, path string) error {
+	if policy == MergeFailOnConflict {
+		return fmt.Errorf("conflicting adjustments for %s", path)
+	}
+	return nil
+}
@@ -36,21 +36,51 @@ func FromOCIMounts(o []rspec.Mount) []*Mount {
 			Type:        m.Type,
 			Source:      m.Source,
 			Options:     DupStringSlice(m.Options),
+			UidMappings: FromOCILinuxIDMappings(m.UIDMappings),
+			GidMappings: FromOCILinuxIDMappings(m.GIDMappings),
 		})
 	}
 	return mounts
 }
 
+// FromOCILinuxIDMappings returns ID mappings from an OCI runtime Spec.
+func FromOCILinuxIDMappings(o []rspec.LinuxIDMapping) []*LinuxIDMapping {
+	var mappings []*LinuxIDMapping
+	for _, m := range o {
+		mappings = append(mappings, &LinuxIDMapping{
+			ContainerId: m.ContainerID,
+			HostId:      m.HostID,
+			Size:        m.Size,
+		})
+	}
+	return mappings
+}
+
+// ToOCILinuxIDMappings returns ID mappings for an OCI runtime Spec.
+func ToOCILinuxIDMappings(mappings []*LinuxIDMapping) []rspec.LinuxIDMapping {
+	var o []rspec.LinuxIDMapping
+	for _, m := range mappings {
+		o = append(o, rspec.LinuxIDMapping{
+			ContainerID: m.ContainerId,
+			HostID:      m.HostId,
+			Size:        m.Size,
+		})
+	}
+	return o
+}
+
 // ToOCI returns a Mount for an OCI runtime Spec.
 func (m *Mount) ToOCI(propagationQuery *string) rspec.Mount {
 	o := rspec.Mount{
 		Destination: m.Destination,
 		Type:        m.Type,
 		Source:      m.Source,
+		UIDMappings: ToOCILinuxIDMappings(m.UidMappings),
+		GIDMappings: ToOCILinuxIDMappings(m.GidMappings),
 	}
 	for _, opt := range m.Options {
 		o.Options = append(o.Options, opt)
-		if propagationQuery != nil && (opt == "rprivate" || opt == "rshared" || opt == "rslave") {
+		if propagationQuery != nil && isPropagationOption(opt) {
 			*propagationQuery = opt
 		}
 	}
@@ -66,6 +96,10 @@ func (m *Mount) Cmp(v *Mount) bool {
 		len(m.Options) != len(v.Options) {
 		return false
 	}
+	if !cmpLinuxIDMappings(m.UidMappings, v.UidMappings) ||
+		!cmpLinuxIDMappings(m.GidMappings, v.GidMappings) {
+		return false
+	}
 
 	mOpts := make([]string, len(m.Options))
 	vOpts := make([]string, len(m.Options))
@@ -81,6 +115,39 @@ func (m *Mount) Cmp(v *Mount) bool {
 	return true
 }
 
+// SetPropagation sets the propagation mode of the mount, replacing any
+// propagation mode already present in the mount options.
+func (m *Mount) SetPropagation(mode string) {
+	var options []string
+	for _, opt := range m.Options {
+		if !isPropagationOption(opt) {
+			options = append(options, opt)
+		}
+	}
+	m.Options = append(options, mode)
+}
+
+func isPropagationOption(opt string) bool {
+	switch opt {
+	case "rprivate", "rshared", "rslave", "private", "shared", "slave":
+		return true
+	}
+	return false
+}
+
+func cmpLinuxIDMappings(a, b []*LinuxIDMapping) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ContainerId != b[i].ContainerId || a[i].HostId != b[i].HostId ||
+			a[i].Size != b[i].Size {
+			return false
+		}
+	}
+	return true
+}
+
 // IsMarkedForRemoval checks if a Mount is marked for removal.
 func (m *Mount) IsMarkedForRemoval() (string, bool) {
 	key, marked := IsMarkedForRemoval(m.Destination)
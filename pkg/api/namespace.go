@@ -20,6 +20,11 @@ import (
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
 )
 
+const (
+	// NamedNetworkNamespaceDir is the directory of named network namespaces.
+	NamedNetworkNamespaceDir = "/var/run/netns"
+)
+
 // FromOCILinuxNamespaces returns a namespace slice from an OCI runtime Spec.
 func FromOCILinuxNamespaces(o []rspec.LinuxNamespace) []*LinuxNamespace {
 	var namespaces []*LinuxNamespace
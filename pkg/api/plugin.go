@@ -18,7 +18,10 @@ package api
 
 import (
 	"fmt"
+	"path/filepath"
+	"runtime/debug"
 	"strings"
+	"time"
 )
 
 const (
@@ -30,8 +33,45 @@ const (
 	PluginNameEnvVar = "NRI_PLUGIN_NAME"
 	// PluginIdxEnvVar is used to inform NRI-launched plugins about their ID.
 	PluginIdxEnvVar = "NRI_PLUGIN_IDX"
+	// CompressionZstd is the name of zstd compression in protocol negotiation.
+	CompressionZstd = "zstd"
 )
 
+// Known plugin configuration formats.
+const (
+	ConfigTypeYAML = "yaml"
+	ConfigTypeJSON = "json"
+	ConfigTypeTOML = "toml"
+	ConfigTypeHCL  = "hcl"
+)
+
+// ConfigTypeForFile returns the configuration format for a configuration
+// file based on its extension, or an empty string for an unknown format.
+// The traditional .conf extension is considered an unknown format.
+func ConfigTypeForFile(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ConfigTypeYAML
+	case ".json":
+		return ConfigTypeJSON
+	case ".toml":
+		return ConfigTypeTOML
+	case ".hcl":
+		return ConfigTypeHCL
+	}
+	return ""
+}
+
+// HasCompression checks if a compression algorithm is in a list of algorithms.
+func HasCompression(algorithms []string, algorithm string) bool {
+	for _, a := range algorithms {
+		if a == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
 // ParsePluginName parses the (file)name of a plugin into an index and a base.
 func ParsePluginName(name string) (string, string, error) {
 	split := strings.SplitN(name, "-", 2)
@@ -56,3 +96,116 @@ func CheckPluginIndex(idx string) error {
 	}
 	return nil
 }
+
+// FullName returns the indexed name of the plugin.
+func (p *PluginInfo) FullName() string {
+	return p.Index + "-" + p.Name
+}
+
+// EventMask returns the events the plugin is subscribed to.
+func (p *PluginInfo) EventMask() EventMask {
+	return EventMask(p.GetEvents())
+}
+
+// CheckPluginOrder checks that the given plugins are all present in a
+// plugin chain and in the given order. Plugins can be identified either
+// by their name or by their full indexed name.
+func CheckPluginOrder(chain []*PluginInfo, names ...string) error {
+	pos := -1
+	for _, name := range names {
+		found := -1
+		for i, p := range chain {
+			if p.Name == name || p.FullName() == name {
+				found = i
+				break
+			}
+		}
+		if found < 0 {
+			return fmt.Errorf("plugin %q not found in plugin chain", name)
+		}
+		if found < pos {
+			return fmt.Errorf("plugin %q is invoked before plugin %q", name, chain[pos].FullName())
+		}
+		pos = found
+	}
+	return nil
+}
+
+// GetBuildInfo returns the build information of the running binary, with
+// the given version. If version is empty, the main module version is used.
+func GetBuildInfo(version string) *BuildInfo {
+	b := &BuildInfo{
+		Version: version,
+	}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return b
+	}
+
+	if b.Version == "" && info.Main.Version != "(devel)" {
+		b.Version = info.Main.Version
+	}
+	b.GoVersion = info.GoVersion
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			b.Revision = s.Value
+		case "vcs.time":
+			b.RevisionTime = s.Value
+		case "vcs.modified":
+			b.Modified = s.Value == "true"
+		}
+	}
+
+	return b
+}
+
+// Summary returns a short, human-readable summary of the build information,
+// for instance "v1.2.0 (rev 1a2b3c4d5e6f, modified, go1.19.4)".
+func (b *BuildInfo) Summary() string {
+	if b == nil {
+		return "<unknown build>"
+	}
+
+	version := b.Version
+	if version == "" {
+		version = "<unknown version>"
+	}
+
+	var details []string
+	if rev := b.Revision; rev != "" {
+		if len(rev) > 12 {
+			rev = rev[:12]
+		}
+		details = append(details, "rev "+rev)
+	}
+	if b.Modified {
+		details = append(details, "modified")
+	}
+	if b.GoVersion != "" {
+		details = append(details, b.GoVersion)
+	}
+	if len(details) == 0 {
+		return version
+	}
+
+	return version + " (" + strings.Join(details, ", ") + ")"
+}
+
+// AverageLatency returns the average latency of requests to the hook.
+func (h *HookStats) AverageLatency() time.Duration {
+	if h.GetCalls() == 0 {
+		return 0
+	}
+	return time.Duration(h.GetTotalLatency() / int64(h.GetCalls()))
+}
+
+// LastErrorAt returns the time of the last error returned by the plugin,
+// or the zero time if the plugin has not returned any errors.
+func (s *PluginStats) LastErrorAt() time.Time {
+	if s.GetLastErrorTime() == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, s.GetLastErrorTime())
+}
@@ -0,0 +1,175 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"net/netip"
+	"strings"
+)
+
+// SetPluginData attaches a key/value pair to the pod for later plugins.
+func (p *PodSandbox) SetPluginData(key, value string) {
+	if p.PluginData == nil {
+		p.PluginData = make(map[string]string)
+	}
+	p.PluginData[key] = value
+}
+
+// RemovePluginData removes a key/value pair from the pod's plugin data.
+func (p *PodSandbox) RemovePluginData(key string) {
+	delete(p.PluginData, key)
+}
+
+// LookupPluginData returns the value of a key in the pod's plugin data.
+func (p *PodSandbox) LookupPluginData(key string) (string, bool) {
+	value, ok := p.GetPluginData()[key]
+	return value, ok
+}
+
+// PrimaryIP returns the primary IP address of the pod, or an empty string if
+// the pod has no network status.
+func (p *PodSandbox) PrimaryIP() string {
+	if ips := p.GetNetwork().GetIps(); len(ips) > 0 {
+		return ips[0]
+	}
+	return ""
+}
+
+// IPFamily is the family of an IP address.
+type IPFamily string
+
+// IP address families.
+const (
+	IPFamilyIPv4 IPFamily = "IPv4"
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
+// FamilyOf returns the family of an IP address, or an empty string if it is
+// not a valid IP address.
+func FamilyOf(ip string) IPFamily {
+	addr, err := netip.ParseAddr(ip)
+	switch {
+	case err != nil:
+		return ""
+	case addr.Unmap().Is4():
+		return IPFamilyIPv4
+	default:
+		return IPFamilyIPv6
+	}
+}
+
+// IPOfFamily returns the first IP address of the pod of the given family, or
+// an empty string if the pod has none.
+func (p *PodSandbox) IPOfFamily(family IPFamily) string {
+	for _, ip := range p.GetNetwork().GetIps() {
+		if FamilyOf(ip) == family {
+			return ip
+		}
+	}
+	return ""
+}
+
+// PreferredIP returns the first IP address of the pod of the first of the
+// given families it has an address of. If the pod has no address of any of
+// the families, the primary IP address of the pod is returned.
+func (p *PodSandbox) PreferredIP(families ...IPFamily) string {
+	for _, family := range families {
+		if ip := p.IPOfFamily(family); ip != "" {
+			return ip
+		}
+	}
+	return p.PrimaryIP()
+}
+
+// IsDualStack returns true if the pod has both IPv4 and IPv6 addresses.
+func (p *PodSandbox) IsDualStack() bool {
+	return p.IPOfFamily(IPFamilyIPv4) != "" && p.IPOfFamily(IPFamilyIPv6) != ""
+}
+
+// Well-known kinds of workloads owning pods.
+const (
+	WorkloadDeployment  = "Deployment"
+	WorkloadStatefulSet = "StatefulSet"
+	WorkloadDaemonSet   = "DaemonSet"
+	WorkloadJob         = "Job"
+)
+
+// Workload returns the workload owning the pod. If the runtime did not set
+// the owner of the pod, it is inferred from the labels Kubernetes workload
+// controllers set on the pods they create. Inferred owners have no UID.
+// Workload returns nil if the owner of the pod is unknown.
+func (p *PodSandbox) Workload() *PodOwner {
+	if o := p.GetOwner(); o.GetKind() != "" && o.GetName() != "" {
+		return o
+	}
+
+	var (
+		labels = p.GetLabels()
+		name   = p.GetName()
+	)
+
+	if job := labels["batch.kubernetes.io/job-name"]; job != "" {
+		return &PodOwner{Kind: WorkloadJob, Name: job}
+	}
+	if job := labels["job-name"]; job != "" {
+		return &PodOwner{Kind: WorkloadJob, Name: job}
+	}
+	if _, ok := labels["statefulset.kubernetes.io/pod-name"]; ok {
+		if idx := strings.LastIndex(name, "-"); idx > 0 {
+			return &PodOwner{Kind: WorkloadStatefulSet, Name: name[:idx]}
+		}
+	}
+	if _, ok := labels["pod-template-generation"]; ok {
+		if idx := strings.LastIndex(name, "-"); idx > 0 {
+			return &PodOwner{Kind: WorkloadDaemonSet, Name: name[:idx]}
+		}
+	}
+	if hash := labels["pod-template-hash"]; hash != "" {
+		if idx := strings.LastIndex(name, "-"+hash+"-"); idx > 0 {
+			return &PodOwner{Kind: WorkloadDeployment, Name: name[:idx]}
+		}
+	}
+
+	return nil
+}
+
+// DiffPluginData returns the changes necessary to turn one set of plugin
+// data into another. Removed keys are marked for removal.
+func DiffPluginData(old, new map[string]string) map[string]string {
+	var diff map[string]string
+
+	for k, v := range new {
+		if ov, ok := old[k]; ok && ov == v {
+			continue
+		}
+		if diff == nil {
+			diff = make(map[string]string)
+		}
+		diff[k] = v
+	}
+	for k := range old {
+		if _, ok := new[k]; ok {
+			continue
+		}
+		if diff == nil {
+			diff = make(map[string]string)
+		}
+		diff[MarkForRemoval(k)] = ""
+	}
+
+	return diff
+}
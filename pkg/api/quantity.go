@@ -0,0 +1,181 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+)
+
+const (
+	// DefaultCPUPeriod is the default CFS period, in microseconds.
+	DefaultCPUPeriod uint64 = 100000
+	// MinCPUQuota is the smallest CFS quota, in microseconds, the kernel allows.
+	MinCPUQuota int64 = 1000
+	// MinCPUShares is the smallest number of CPU shares the kernel allows.
+	MinCPUShares uint64 = 2
+	// MaxCPUShares is the largest number of CPU shares the kernel allows.
+	MaxCPUShares uint64 = 262144
+
+	sharesPerCPU = 1024
+	milliPerCPU  = 1000
+)
+
+// quantityRe matches Kubernetes resource quantities, like 500m, 1.5Gi or 1e3.
+var quantityRe = regexp.MustCompile(`^([0-9]+(?:\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+|[a-zA-Z]*)$`)
+
+// exponentRe matches the decimal exponent of a quantity, like e3 or E-2.
+var exponentRe = regexp.MustCompile(`^[eE][+-]?[0-9]+$`)
+
+// quantitySuffixes are the multipliers of Kubernetes quantity suffixes.
+var quantitySuffixes = map[string]*big.Rat{
+	"n":  big.NewRat(1, 1000000000),
+	"u":  big.NewRat(1, 1000000),
+	"m":  big.NewRat(1, 1000),
+	"":   big.NewRat(1, 1),
+	"k":  big.NewRat(1e3, 1),
+	"M":  big.NewRat(1e6, 1),
+	"G":  big.NewRat(1e9, 1),
+	"T":  big.NewRat(1e12, 1),
+	"P":  big.NewRat(1e15, 1),
+	"E":  big.NewRat(1e18, 1),
+	"Ki": big.NewRat(1<<10, 1),
+	"Mi": big.NewRat(1<<20, 1),
+	"Gi": big.NewRat(1<<30, 1),
+	"Ti": big.NewRat(1<<40, 1),
+	"Pi": big.NewRat(1<<50, 1),
+	"Ei": big.NewRat(1<<60, 1),
+}
+
+// parseQuantity parses a non-negative Kubernetes resource quantity.
+func parseQuantity(quantity string) (*big.Rat, error) {
+	m := quantityRe.FindStringSubmatch(quantity)
+	if m == nil {
+		return nil, fmt.Errorf("invalid resource quantity %q", quantity)
+	}
+
+	value, ok := new(big.Rat).SetString(m[1])
+	if !ok {
+		return nil, fmt.Errorf("invalid resource quantity %q", quantity)
+	}
+
+	if suffix := m[2]; exponentRe.MatchString(suffix) {
+		exp, ok := new(big.Rat).SetString("1" + suffix)
+		if !ok {
+			return nil, fmt.Errorf("invalid resource quantity %q", quantity)
+		}
+		return value.Mul(value, exp), nil
+	}
+
+	mult, ok := quantitySuffixes[m[2]]
+	if !ok {
+		return nil, fmt.Errorf("invalid suffix in resource quantity %q", quantity)
+	}
+	return value.Mul(value, mult), nil
+}
+
+// ceilInt64 rounds a non-negative rational up to an int64.
+func ceilInt64(value *big.Rat, quantity string) (int64, error) {
+	q, r := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if r.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if !q.IsInt64() {
+		return 0, fmt.Errorf("resource quantity %q out of range", quantity)
+	}
+	return q.Int64(), nil
+}
+
+// ParseCPUQuantity parses a Kubernetes CPU quantity, like 500m or 1.5, to
+// milliCPUs, as used by CRI. Fractions of milliCPUs are rounded up.
+func ParseCPUQuantity(quantity string) (int64, error) {
+	value, err := parseQuantity(quantity)
+	if err != nil {
+		return 0, err
+	}
+	return ceilInt64(value.Mul(value, big.NewRat(milliPerCPU, 1)), quantity)
+}
+
+// ParseMemoryQuantity parses a Kubernetes memory quantity, like 128Mi or
+// 1G, to bytes. Fractions of bytes are rounded up.
+func ParseMemoryQuantity(quantity string) (int64, error) {
+	value, err := parseQuantity(quantity)
+	if err != nil {
+		return 0, err
+	}
+	return ceilInt64(value, quantity)
+}
+
+// MilliCPUToShares converts a CPU request in milliCPUs to CPU shares, the
+// same way the kubelet does.
+func MilliCPUToShares(milliCPU int64) uint64 {
+	if milliCPU <= 0 {
+		return MinCPUShares
+	}
+	shares := uint64(milliCPU) * sharesPerCPU / milliPerCPU
+	switch {
+	case shares < MinCPUShares:
+		return MinCPUShares
+	case shares > MaxCPUShares:
+		return MaxCPUShares
+	}
+	return shares
+}
+
+// SharesToMilliCPU converts CPU shares to a CPU request in milliCPUs. It
+// is the inverse of MilliCPUToShares, rounding up.
+func SharesToMilliCPU(shares uint64) int64 {
+	if shares <= MinCPUShares {
+		return 0
+	}
+	return int64((shares*milliPerCPU + sharesPerCPU - 1) / sharesPerCPU)
+}
+
+// MilliCPUToQuota converts a CPU limit in milliCPUs to a CFS quota for the
+// given period, or DefaultCPUPeriod if it is 0, the same way the kubelet
+// does. A limit of 0 means no limit, for which 0 is returned.
+func MilliCPUToQuota(milliCPU int64, period uint64) int64 {
+	if milliCPU <= 0 {
+		return 0
+	}
+	if period == 0 {
+		period = DefaultCPUPeriod
+	}
+	if uint64(milliCPU) > math.MaxInt64/period {
+		return math.MaxInt64
+	}
+	quota := milliCPU * int64(period) / milliPerCPU
+	if quota < MinCPUQuota {
+		return MinCPUQuota
+	}
+	return quota
+}
+
+// QuotaToMilliCPU converts a CFS quota for the given period, or for
+// DefaultCPUPeriod if it is 0, to a CPU limit in milliCPUs. A quota of 0
+// or less means no limit, for which 0 is returned.
+func QuotaToMilliCPU(quota int64, period uint64) int64 {
+	if quota <= 0 {
+		return 0
+	}
+	if period == 0 {
+		period = DefaultCPUPeriod
+	}
+	return int64((uint64(quota)*milliPerCPU + period - 1) / period)
+}
@@ -17,6 +17,9 @@
 package api
 
 import (
+	"fmt"
+	"regexp"
+
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
 	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
@@ -56,6 +59,7 @@ func FromOCILinuxResources(o *rspec.LinuxResources, ann map[string]string) *Linu
 			Limit:    h.Limit,
 		})
 	}
+	l.Blockio = FromOCILinuxBlockIO(o.BlockIO)
 	for _, d := range o.Devices {
 		l.Devices = append(l.Devices, &LinuxDeviceCgroup{
 			Allow:  d.Allow,
@@ -148,6 +152,7 @@ func (r *LinuxResources) ToOCI() *rspec.LinuxResources {
 			Access: d.Access,
 		})
 	}
+	o.BlockIO = r.Blockio.ToOCI()
 
 	return o
 }
@@ -228,6 +233,137 @@ func (r *LinuxResources) Copy() *LinuxResources {
 	}
 	o.BlockioClass = String(r.BlockioClass)
 	o.RdtClass = String(r.RdtClass)
+	o.RdtClosId = String(r.RdtClosId)
+	o.Blockio = r.Blockio.Copy()
+
+	return o
+}
+
+// hugepageSizeRe matches hugepage sizes, like 2MB, 1GB, or 64K.
+var hugepageSizeRe = regexp.MustCompile(`^[1-9][0-9]*[KMGT]B?$`)
+
+// Validate checks that the hugepage limit has a valid page size.
+func (l *HugepageLimit) Validate() error {
+	if !hugepageSizeRe.MatchString(l.PageSize) {
+		return fmt.Errorf("invalid hugepage size %q", l.PageSize)
+	}
+	return nil
+}
+
+// SetHugepageLimit sets the limit for the same page size in the given slice,
+// or appends it if the slice has no limit for that page size. The updated
+// slice is returned.
+func SetHugepageLimit(limits []*HugepageLimit, l *HugepageLimit) []*HugepageLimit {
+	for i, o := range limits {
+		if o.PageSize == l.PageSize {
+			limits[i] = l
+			return limits
+		}
+	}
+	return append(limits, l)
+}
+
+// FromOCILinuxBlockIO returns block I/O parameters from an OCI runtime Spec.
+func FromOCILinuxBlockIO(o *rspec.LinuxBlockIO) *LinuxBlockIO {
+	if o == nil {
+		return nil
+	}
+	b := &LinuxBlockIO{}
+	if o.Weight != nil {
+		b.Weight = UInt32(uint32(*o.Weight))
+	}
+	for _, d := range o.WeightDevice {
+		if d.Weight == nil {
+			continue
+		}
+		b.WeightDevice = append(b.WeightDevice, &LinuxWeightDevice{
+			Major:  d.Major,
+			Minor:  d.Minor,
+			Weight: uint32(*d.Weight),
+		})
+	}
+	b.ThrottleReadBpsDevice = fromOCIThrottleDevices(o.ThrottleReadBpsDevice)
+	b.ThrottleWriteBpsDevice = fromOCIThrottleDevices(o.ThrottleWriteBpsDevice)
+	b.ThrottleReadIopsDevice = fromOCIThrottleDevices(o.ThrottleReadIOPSDevice)
+	b.ThrottleWriteIopsDevice = fromOCIThrottleDevices(o.ThrottleWriteIOPSDevice)
+	return b
+}
+
+// ToOCI returns block I/O parameters for an OCI runtime Spec.
+func (b *LinuxBlockIO) ToOCI() *rspec.LinuxBlockIO {
+	if b == nil {
+		return nil
+	}
+	o := &rspec.LinuxBlockIO{}
+	if b.Weight != nil {
+		w := uint16(b.Weight.GetValue())
+		o.Weight = &w
+	}
+	for _, d := range b.WeightDevice {
+		w := uint16(d.Weight)
+		wd := rspec.LinuxWeightDevice{Weight: &w}
+		wd.Major, wd.Minor = d.Major, d.Minor
+		o.WeightDevice = append(o.WeightDevice, wd)
+	}
+	o.ThrottleReadBpsDevice = toOCIThrottleDevices(b.ThrottleReadBpsDevice)
+	o.ThrottleWriteBpsDevice = toOCIThrottleDevices(b.ThrottleWriteBpsDevice)
+	o.ThrottleReadIOPSDevice = toOCIThrottleDevices(b.ThrottleReadIopsDevice)
+	o.ThrottleWriteIOPSDevice = toOCIThrottleDevices(b.ThrottleWriteIopsDevice)
+	return o
+}
 
+// Copy creates a copy of the block I/O parameters.
+func (b *LinuxBlockIO) Copy() *LinuxBlockIO {
+	if b == nil {
+		return nil
+	}
+	o := &LinuxBlockIO{
+		Weight: UInt32(b.Weight),
+	}
+	for _, d := range b.WeightDevice {
+		o.WeightDevice = append(o.WeightDevice, &LinuxWeightDevice{
+			Major:  d.Major,
+			Minor:  d.Minor,
+			Weight: d.Weight,
+		})
+	}
+	o.ThrottleReadBpsDevice = copyThrottleDevices(b.ThrottleReadBpsDevice)
+	o.ThrottleWriteBpsDevice = copyThrottleDevices(b.ThrottleWriteBpsDevice)
+	o.ThrottleReadIopsDevice = copyThrottleDevices(b.ThrottleReadIopsDevice)
+	o.ThrottleWriteIopsDevice = copyThrottleDevices(b.ThrottleWriteIopsDevice)
 	return o
 }
+
+func fromOCIThrottleDevices(devices []rspec.LinuxThrottleDevice) []*LinuxThrottleDevice {
+	var result []*LinuxThrottleDevice
+	for _, d := range devices {
+		result = append(result, &LinuxThrottleDevice{
+			Major: d.Major,
+			Minor: d.Minor,
+			Rate:  d.Rate,
+		})
+	}
+	return result
+}
+
+func toOCIThrottleDevices(devices []*LinuxThrottleDevice) []rspec.LinuxThrottleDevice {
+	var result []rspec.LinuxThrottleDevice
+	for _, d := range devices {
+		td := rspec.LinuxThrottleDevice{Rate: d.Rate}
+		td.Major, td.Minor = d.Major, d.Minor
+		result = append(result, td)
+	}
+	return result
+}
+
+func copyThrottleDevices(devices []*LinuxThrottleDevice) []*LinuxThrottleDevice {
+	var result []*LinuxThrottleDevice
+	for _, d := range devices {
+		result = append(result, &LinuxThrottleDevice{
+			Major: d.Major,
+			Minor: d.Minor,
+			Rate:  d.Rate,
+		})
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+
+	rspec "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// rlimitTypes are the known POSIX rlimit types.
+var rlimitTypes = map[string]struct{}{
+	"RLIMIT_AS":         {},
+	"RLIMIT_CORE":       {},
+	"RLIMIT_CPU":        {},
+	"RLIMIT_DATA":       {},
+	"RLIMIT_FSIZE":      {},
+	"RLIMIT_LOCKS":      {},
+	"RLIMIT_MEMLOCK":    {},
+	"RLIMIT_MSGQUEUE":   {},
+	"RLIMIT_NICE":       {},
+	"RLIMIT_NOFILE":     {},
+	"RLIMIT_NPROC":      {},
+	"RLIMIT_RSS":        {},
+	"RLIMIT_RTPRIO":     {},
+	"RLIMIT_RTTIME":     {},
+	"RLIMIT_SIGPENDING": {},
+	"RLIMIT_STACK":      {},
+}
+
+// IsValidRlimitType returns true if the given type is a known POSIX rlimit.
+func IsValidRlimitType(typ string) bool {
+	_, ok := rlimitTypes[typ]
+	return ok
+}
+
+// FromOCIRlimits returns rlimits from an OCI runtime Spec.
+func FromOCIRlimits(o []rspec.POSIXRlimit) []*POSIXRlimit {
+	var rlimits []*POSIXRlimit
+	for _, r := range o {
+		rlimits = append(rlimits, &POSIXRlimit{
+			Type: r.Type,
+			Hard: r.Hard,
+			Soft: r.Soft,
+		})
+	}
+	return rlimits
+}
+
+// ToOCI returns the rlimit for an OCI runtime Spec.
+func (r *POSIXRlimit) ToOCI() rspec.POSIXRlimit {
+	if r == nil {
+		return rspec.POSIXRlimit{}
+	}
+	return rspec.POSIXRlimit{
+		Type: r.Type,
+		Hard: r.Hard,
+		Soft: r.Soft,
+	}
+}
+
+// Validate checks that the rlimit has a known type and that its soft limit
+// does not exceed its hard limit.
+func (r *POSIXRlimit) Validate() error {
+	if !IsValidRlimitType(r.Type) {
+		return fmt.Errorf("unknown rlimit type %q", r.Type)
+	}
+	if r.Soft > r.Hard {
+		return fmt.Errorf("rlimit %s: soft limit %d exceeds hard limit %d",
+			r.Type, r.Soft, r.Hard)
+	}
+	return nil
+}
+
+// SetRlimit sets the rlimit of the same type in the given slice, or appends
+// it if the slice has no such rlimit. The updated slice is returned.
+func SetRlimit(rlimits []*POSIXRlimit, r *POSIXRlimit) []*POSIXRlimit {
+	for i, o := range rlimits {
+		if o.Type == r.Type {
+			rlimits[i] = r
+			return rlimits
+		}
+	}
+	return append(rlimits, r)
+}
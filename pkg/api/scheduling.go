@@ -0,0 +1,73 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+)
+
+// Validate checks that the I/O priority has a known class and a valid
+// priority level.
+func (p *LinuxIOPriority) Validate() error {
+	if _, ok := IOPrioClass_name[int32(p.Class)]; !ok {
+		return fmt.Errorf("unknown I/O priority class %d", p.Class)
+	}
+	if p.Priority < 0 || p.Priority > 7 {
+		return fmt.Errorf("invalid I/O priority %d, must be in range 0-7", p.Priority)
+	}
+	return nil
+}
+
+// Validate checks that the scheduling policy is known and that its
+// parameters are valid for the policy.
+func (s *LinuxScheduler) Validate() error {
+	if _, ok := LinuxSchedulerPolicy_name[int32(s.Policy)]; !ok {
+		return fmt.Errorf("unknown scheduling policy %d", s.Policy)
+	}
+	if s.Nice < -20 || s.Nice > 19 {
+		return fmt.Errorf("invalid nice value %d, must be in range -20-19", s.Nice)
+	}
+	for _, f := range s.Flags {
+		if _, ok := LinuxSchedulerFlag_name[int32(f)]; !ok {
+			return fmt.Errorf("unknown scheduling flag %d", f)
+		}
+	}
+
+	switch s.Policy {
+	case LinuxSchedulerPolicy_SCHED_FIFO, LinuxSchedulerPolicy_SCHED_RR:
+		if s.Priority < 1 || s.Priority > 99 {
+			return fmt.Errorf("invalid %s priority %d, must be in range 1-99",
+				s.Policy, s.Priority)
+		}
+	default:
+		if s.Priority != 0 {
+			return fmt.Errorf("invalid %s priority %d, must be 0", s.Policy, s.Priority)
+		}
+	}
+
+	if s.Policy == LinuxSchedulerPolicy_SCHED_DEADLINE {
+		if s.Runtime == 0 || s.Runtime > s.Deadline ||
+			(s.Period != 0 && s.Deadline > s.Period) {
+			return fmt.Errorf("invalid %s parameters, need 0 < runtime <= deadline <= period",
+				s.Policy)
+		}
+	} else if s.Runtime != 0 || s.Deadline != 0 || s.Period != 0 {
+		return fmt.Errorf("%s does not take runtime, deadline or period", s.Policy)
+	}
+
+	return nil
+}
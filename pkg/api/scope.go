@@ -0,0 +1,211 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewScopeMask returns a mask with the given adjustment scopes set.
+func NewScopeMask(scopes ...Scope) *ScopeMask {
+	return (&ScopeMask{}).Set(scopes...)
+}
+
+// ParseScopeMask parses adjustment scope names, like mounts, SCOPE_MOUNTS
+// or scope_mounts, into a mask.
+func ParseScopeMask(names ...string) (*ScopeMask, error) {
+	m := &ScopeMask{}
+	for _, name := range names {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if !strings.HasPrefix(name, "SCOPE_") {
+			name = "SCOPE_" + name
+		}
+		s, ok := Scope_value[name]
+		if !ok || Scope(s) == Scope_SCOPE_UNKNOWN || Scope(s) == Scope_SCOPE_LAST {
+			return nil, fmt.Errorf("unknown adjustment scope %q", name)
+		}
+		m.Set(Scope(s))
+	}
+	return m, nil
+}
+
+// Set sets the given adjustment scopes in the mask.
+func (m *ScopeMask) Set(scopes ...Scope) *ScopeMask {
+	for _, s := range scopes {
+		m.Scopes |= 1 << (s - 1)
+	}
+	return m
+}
+
+// IsSet checks if the given adjustment scope is set in the mask. All scopes
+// are set in a nil mask.
+func (m *ScopeMask) IsSet(s Scope) bool {
+	if m == nil {
+		return true
+	}
+	return m.Scopes&(1<<(s-1)) != 0
+}
+
+// List returns the adjustment scopes set in the mask.
+func (m *ScopeMask) List() []Scope {
+	var scopes []Scope
+	for s := Scope_SCOPE_UNKNOWN + 1; s < Scope_SCOPE_LAST; s++ {
+		if m.IsSet(s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// Missing returns the adjustment scopes set in the other mask but not in
+// this one. Nothing is missing from a nil mask.
+func (m *ScopeMask) Missing(other *ScopeMask) []Scope {
+	if m == nil || other == nil {
+		return nil
+	}
+	var missing []Scope
+	for _, s := range other.List() {
+		if !m.IsSet(s) {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
+
+// Scopes returns the scopes the adjustment touches.
+func (a *ContainerAdjustment) Scopes() *ScopeMask {
+	m := &ScopeMask{}
+	if a == nil {
+		return m
+	}
+	if len(a.Annotations) > 0 {
+		m.Set(Scope_SCOPE_ANNOTATIONS)
+	}
+	if len(a.Labels) > 0 {
+		m.Set(Scope_SCOPE_LABELS)
+	}
+	if len(a.Mounts) > 0 {
+		m.Set(Scope_SCOPE_MOUNTS)
+	}
+	if len(a.Env) > 0 {
+		m.Set(Scope_SCOPE_ENV)
+	}
+	if a.Hooks != nil {
+		m.Set(Scope_SCOPE_HOOKS)
+	}
+	if len(a.CDIDevices) > 0 {
+		m.Set(Scope_SCOPE_DEVICES)
+	}
+	if len(a.Rlimits) > 0 {
+		m.Set(Scope_SCOPE_RLIMITS)
+	}
+	if a.ReadonlyRootfs != nil {
+		m.Set(Scope_SCOPE_SECURITY)
+	}
+
+	l := a.Linux
+	if l == nil {
+		return m
+	}
+	// Device cgroup rules grant access to host devices, so they need the
+	// devices scope, not just resources.
+	if len(l.Devices) > 0 || len(l.GetResources().GetDevices()) > 0 {
+		m.Set(Scope_SCOPE_DEVICES)
+	}
+	if l.Resources != nil || l.CgroupsPath != "" {
+		m.Set(Scope_SCOPE_RESOURCES)
+	}
+	if l.SeccompProfile != nil || l.ApparmorProfile != nil || l.Capabilities != nil ||
+		len(l.MaskedPaths) > 0 || len(l.ReadonlyPaths) > 0 {
+		m.Set(Scope_SCOPE_SECURITY)
+	}
+	if len(l.Sysctl) > 0 {
+		m.Set(Scope_SCOPE_SYSCTLS)
+	}
+	if len(l.UidMappings) > 0 || len(l.GidMappings) > 0 {
+		m.Set(Scope_SCOPE_NAMESPACES)
+	}
+	if l.IoPriority != nil || l.Scheduler != nil || l.MemoryPolicy != nil || l.TopologyHints != nil {
+		m.Set(Scope_SCOPE_SCHEDULING)
+	}
+
+	return m
+}
+
+// Scopes returns the scopes the adjustment touches.
+func (a *PodSandboxAdjustment) Scopes() *ScopeMask {
+	m := &ScopeMask{}
+	if a == nil {
+		return m
+	}
+	if len(a.Annotations) > 0 {
+		m.Set(Scope_SCOPE_ANNOTATIONS)
+	}
+	if len(a.Labels) > 0 {
+		m.Set(Scope_SCOPE_LABELS)
+	}
+	if a.Hostname != "" || a.Domainname != "" || len(a.ExtraHosts) > 0 {
+		m.Set(Scope_SCOPE_HOSTS)
+	}
+	if len(a.Rlimits) > 0 {
+		m.Set(Scope_SCOPE_RLIMITS)
+	}
+
+	l := a.Linux
+	if l == nil {
+		return m
+	}
+	if len(l.Sysctl) > 0 {
+		m.Set(Scope_SCOPE_SYSCTLS)
+	}
+	if l.NetworkNamespace != nil || len(l.UidMappings) > 0 || len(l.GidMappings) > 0 {
+		m.Set(Scope_SCOPE_NAMESPACES)
+	}
+	if l.Resources != nil || l.ConntrackMax != 0 {
+		m.Set(Scope_SCOPE_RESOURCES)
+	}
+
+	return m
+}
+
+// Scopes returns the scopes the network setup retry touches.
+func (r *NetworkSetupRetry) Scopes() *ScopeMask {
+	m := &ScopeMask{}
+	if len(r.GetNetworks()) > 0 || len(r.GetCapabilityArgs()) > 0 {
+		m.Set(Scope_SCOPE_NAMESPACES)
+	}
+	return m
+}
+
+// UpdateScopes returns the scopes the container updates touch.
+func UpdateScopes(updates []*ContainerUpdate) *ScopeMask {
+	m := &ScopeMask{}
+	for _, u := range updates {
+		l := u.GetLinux()
+		if l.GetResources() != nil {
+			m.Set(Scope_SCOPE_RESOURCES)
+		}
+		if len(l.GetResources().GetDevices()) > 0 {
+			m.Set(Scope_SCOPE_DEVICES)
+		}
+		if l.GetIoPriority() != nil || l.GetScheduler() != nil {
+			m.Set(Scope_SCOPE_SCHEDULING)
+		}
+	}
+	return m
+}
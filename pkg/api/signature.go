@@ -0,0 +1,113 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SignedResponse is a plugin response which can be signed. These are the
+// responses which carry adjustments, updates or plugin data: the responses
+// to CreateContainer, UpdateContainer, StopContainer and state changes.
+type SignedResponse interface {
+	proto.Message
+	GetSignature() []byte
+}
+
+const (
+	// signatureContext separates response signatures from other uses of
+	// the same keys.
+	signatureContext = "nri-response-v1"
+)
+
+var (
+	// ErrInvalidSignature is returned for responses with a missing or
+	// invalid signature.
+	ErrInvalidSignature = errors.New("invalid response signature")
+)
+
+// SignResponse signs a plugin response with an Ed25519 key. The subject is
+// the ID of the container or pod the response is for, which binds the
+// response to it.
+func SignResponse(key ed25519.PrivateKey, subject string, rpl SignedResponse) error {
+	data, err := signedData(subject, rpl)
+	if err != nil {
+		return err
+	}
+	return setSignature(rpl, ed25519.Sign(key, data))
+}
+
+// VerifyResponse verifies the signature of a plugin response for the given
+// subject with an Ed25519 public key.
+func VerifyResponse(key ed25519.PublicKey, subject string, rpl SignedResponse) error {
+	sig := rpl.GetSignature()
+	if len(sig) == 0 {
+		return fmt.Errorf("%w: no signature", ErrInvalidSignature)
+	}
+	data, err := signedData(subject, rpl)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(key, data, sig) {
+		return fmt.Errorf("%w: verification failed", ErrInvalidSignature)
+	}
+	return nil
+}
+
+// signedData returns the data to sign for a response, which is the response
+// without its signature, prefixed by the signature context, the type of the
+// response and its subject.
+func signedData(subject string, rpl SignedResponse) ([]byte, error) {
+	unsigned := proto.Clone(rpl).(SignedResponse)
+	if err := setSignature(unsigned, nil); err != nil {
+		return nil, err
+	}
+
+	msg, err := proto.MarshalOptions{Deterministic: true}.Marshal(unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %T for signing: %w", rpl, err)
+	}
+
+	name := string(rpl.ProtoReflect().Descriptor().FullName())
+	data := make([]byte, 0, len(signatureContext)+len(name)+len(subject)+len(msg)+3)
+	data = append(data, signatureContext...)
+	data = append(data, 0)
+	data = append(data, name...)
+	data = append(data, 0)
+	data = append(data, subject...)
+	data = append(data, 0)
+	return append(data, msg...), nil
+}
+
+func setSignature(rpl SignedResponse, sig []byte) error {
+	m := rpl.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("signature")
+	if fd == nil || fd.Kind() != protoreflect.BytesKind {
+		return fmt.Errorf("cannot sign %T, no signature field", rpl)
+	}
+	if sig == nil {
+		m.Clear(fd)
+	} else {
+		m.Set(fd, protoreflect.ValueOfBytes(sig))
+	}
+	return nil
+}
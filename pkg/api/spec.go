@@ -0,0 +1,54 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+
+	rspec "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// SetOCISpec sets the OCI Spec generated for the container being created.
+// Runtimes use this to pass the Spec to plugins which asked for it.
+func (r *CreateContainerRequest) SetOCISpec(spec *rspec.Spec) error {
+	if spec == nil {
+		r.OciSpec = nil
+		return nil
+	}
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("failed to encode OCI Spec: %w", err)
+	}
+	r.OciSpec = data
+	return nil
+}
+
+// OCISpec returns the OCI Spec generated for the container being created,
+// or nil if the request has no Spec.
+func (r *CreateContainerRequest) OCISpec() (*rspec.Spec, error) {
+	if len(r.GetOciSpec()) == 0 {
+		return nil, nil
+	}
+
+	spec := &rspec.Spec{}
+	if err := json.Unmarshal(r.OciSpec, spec); err != nil {
+		return nil, fmt.Errorf("failed to decode OCI Spec: %w", err)
+	}
+	return spec, nil
+}
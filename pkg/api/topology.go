@@ -0,0 +1,90 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Validate checks that the memory policy has a known mode and flags, and
+// that it has NUMA nodes if and only if its mode takes them.
+func (p *LinuxMemoryPolicy) Validate() error {
+	if _, ok := MemoryPolicyMode_name[int32(p.Mode)]; !ok {
+		return fmt.Errorf("unknown memory policy mode %d", p.Mode)
+	}
+	for _, f := range p.Flags {
+		if _, ok := MemoryPolicyFlag_name[int32(f)]; !ok {
+			return fmt.Errorf("unknown memory policy flag %d", f)
+		}
+	}
+
+	switch p.Mode {
+	case MemoryPolicyMode_MPOL_DEFAULT, MemoryPolicyMode_MPOL_LOCAL:
+		if p.Nodes != "" {
+			return fmt.Errorf("memory policy %s does not take nodes", p.Mode)
+		}
+	case MemoryPolicyMode_MPOL_PREFERRED:
+		if p.Nodes != "" {
+			return validateNodeList(p.Nodes)
+		}
+	default:
+		if p.Nodes == "" {
+			return fmt.Errorf("memory policy %s needs nodes", p.Mode)
+		}
+		return validateNodeList(p.Nodes)
+	}
+
+	return nil
+}
+
+// Validate checks that the NUMA nodes of the topology hints are in cpuset
+// list format.
+func (h *LinuxTopologyHints) Validate() error {
+	if h.NumaNodes != "" {
+		if err := validateNodeList(h.NumaNodes); err != nil {
+			return err
+		}
+	}
+	for _, dev := range h.PreferredNetworkDevices {
+		if dev == "" {
+			return fmt.Errorf("empty preferred network device")
+		}
+	}
+	return nil
+}
+
+// validateNodeList checks that the given NUMA nodes are in cpuset list
+// format, a comma-separated list of node IDs and ranges of node IDs.
+func validateNodeList(nodes string) error {
+	for _, item := range strings.Split(nodes, ",") {
+		first, last, isRange := strings.Cut(item, "-")
+		beg, err := strconv.ParseUint(first, 10, 16)
+		if err != nil {
+			return fmt.Errorf("invalid NUMA node list %q", nodes)
+		}
+		if !isRange {
+			continue
+		}
+		end, err := strconv.ParseUint(last, 10, 16)
+		if err != nil || end < beg {
+			return fmt.Errorf("invalid NUMA node list %q", nodes)
+		}
+	}
+	return nil
+}
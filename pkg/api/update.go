@@ -115,7 +115,7 @@ func (u *ContainerUpdate) SetLinuxCPUSetMems(value string) {
 // AddLinuxHugepageLimit records adding a hugepage limit for a container.
 func (u *ContainerUpdate) AddLinuxHugepageLimit(pageSize string, value uint64) {
 	u.initLinuxResources()
-	u.Linux.Resources.HugepageLimits = append(u.Linux.Resources.HugepageLimits,
+	u.Linux.Resources.HugepageLimits = SetHugepageLimit(u.Linux.Resources.HugepageLimits,
 		&HugepageLimit{
 			PageSize: pageSize,
 			Limit:    value,
@@ -128,12 +128,79 @@ func (u *ContainerUpdate) SetLinuxBlockIOClass(value string) {
 	u.Linux.Resources.BlockioClass = String(value)
 }
 
+// SetLinuxBlockIOWeight records setting the block I/O weight for a container.
+func (u *ContainerUpdate) SetLinuxBlockIOWeight(value uint16) {
+	u.initLinuxResourcesBlockIO()
+	u.Linux.Resources.Blockio.Weight = UInt32(uint32(value))
+}
+
+// AddLinuxBlockIOWeightDevice records setting the block I/O weight of a device for a container.
+func (u *ContainerUpdate) AddLinuxBlockIOWeightDevice(major, minor int64, value uint16) {
+	u.initLinuxResourcesBlockIO()
+	u.Linux.Resources.Blockio.WeightDevice = append(u.Linux.Resources.Blockio.WeightDevice,
+		&LinuxWeightDevice{
+			Major:  major,
+			Minor:  minor,
+			Weight: uint32(value),
+		})
+}
+
+// AddLinuxBlockIOThrottleReadBps records setting the read bandwidth limit of a device for a container.
+func (u *ContainerUpdate) AddLinuxBlockIOThrottleReadBps(major, minor int64, rate uint64) {
+	u.initLinuxResourcesBlockIO()
+	u.Linux.Resources.Blockio.ThrottleReadBpsDevice = append(u.Linux.Resources.Blockio.ThrottleReadBpsDevice,
+		&LinuxThrottleDevice{Major: major, Minor: minor, Rate: rate})
+}
+
+// AddLinuxBlockIOThrottleWriteBps records setting the write bandwidth limit of a device for a container.
+func (u *ContainerUpdate) AddLinuxBlockIOThrottleWriteBps(major, minor int64, rate uint64) {
+	u.initLinuxResourcesBlockIO()
+	u.Linux.Resources.Blockio.ThrottleWriteBpsDevice = append(u.Linux.Resources.Blockio.ThrottleWriteBpsDevice,
+		&LinuxThrottleDevice{Major: major, Minor: minor, Rate: rate})
+}
+
+// AddLinuxBlockIOThrottleReadIOPS records setting the read IOPS limit of a device for a container.
+func (u *ContainerUpdate) AddLinuxBlockIOThrottleReadIOPS(major, minor int64, rate uint64) {
+	u.initLinuxResourcesBlockIO()
+	u.Linux.Resources.Blockio.ThrottleReadIopsDevice = append(u.Linux.Resources.Blockio.ThrottleReadIopsDevice,
+		&LinuxThrottleDevice{Major: major, Minor: minor, Rate: rate})
+}
+
+// AddLinuxBlockIOThrottleWriteIOPS records setting the write IOPS limit of a device for a container.
+func (u *ContainerUpdate) AddLinuxBlockIOThrottleWriteIOPS(major, minor int64, rate uint64) {
+	u.initLinuxResourcesBlockIO()
+	u.Linux.Resources.Blockio.ThrottleWriteIopsDevice = append(u.Linux.Resources.Blockio.ThrottleWriteIopsDevice,
+		&LinuxThrottleDevice{Major: major, Minor: minor, Rate: rate})
+}
+
 // SetLinuxRDTClass records setting the RDT class for a container.
 func (u *ContainerUpdate) SetLinuxRDTClass(value string) {
 	u.initLinuxResources()
 	u.Linux.Resources.RdtClass = String(value)
 }
 
+// SetLinuxRDTClosID records setting the RDT CLOS ID (resctrl group) for a container.
+func (u *ContainerUpdate) SetLinuxRDTClosID(value string) {
+	u.initLinuxResources()
+	u.Linux.Resources.RdtClosId = String(value)
+}
+
+// SetLinuxIOPriority records setting the I/O priority for a container.
+func (u *ContainerUpdate) SetLinuxIOPriority(class IOPrioClass, priority int32) {
+	u.initLinux()
+	u.Linux.IoPriority = &LinuxIOPriority{
+		Class:    class,
+		Priority: priority,
+	}
+}
+
+// SetLinuxScheduler records setting the CPU scheduling policy and
+// parameters for a container.
+func (u *ContainerUpdate) SetLinuxScheduler(s *LinuxScheduler) {
+	u.initLinux()
+	u.Linux.Scheduler = s
+}
+
 // AddLinuxUnified sets a cgroupv2 unified resource.
 func (u *ContainerUpdate) AddLinuxUnified(key, value string) {
 	u.initLinuxResourcesUnified()
@@ -184,3 +251,10 @@ func (u *ContainerUpdate) initLinuxResourcesUnified() {
 		u.Linux.Resources.Unified = make(map[string]string)
 	}
 }
+
+func (u *ContainerUpdate) initLinuxResourcesBlockIO() {
+	u.initLinuxResources()
+	if u.Linux.Resources.Blockio == nil {
+		u.Linux.Resources.Blockio = &LinuxBlockIO{}
+	}
+}
@@ -0,0 +1,426 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package deploy generates Kubernetes manifests for deploying NRI plugins.
+// A plugin declares what it needs to run, like its configuration, the host
+// paths and sockets it uses and the Kubernetes API access it needs, in a
+// Spec. The package turns the Spec into a DaemonSet running the plugin on
+// every node, together with a ConfigMap for its configuration and RBAC
+// objects for its API access, so every plugin is deployed the same way.
+package deploy
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+const (
+	// DefaultNamespace is the namespace plugins are deployed to by default.
+	DefaultNamespace = "kube-system"
+	// DefaultConfigFlag is the command line flag passing the configuration
+	// file to a plugin by default.
+	DefaultConfigFlag = "-config"
+	// configDir is the directory the configuration is mounted at.
+	configDir = "/etc/nri/config"
+	// configFile is the name of the configuration file.
+	configFile = "config.yaml"
+)
+
+var (
+	// ErrInvalidSpec is returned for invalid plugin specs.
+	ErrInvalidSpec = errors.New("deploy: invalid plugin spec")
+
+	// valid plugin names, which are used in object names
+	validName = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	// valid plugin indices
+	validIndex = regexp.MustCompile(`^[0-9][0-9]$`)
+)
+
+// Spec declares what a plugin needs to be deployed.
+type Spec struct {
+	// Name of the plugin, also used to name its objects.
+	Name string `json:"name"`
+	// Index of the plugin, two digits.
+	Index string `json:"index"`
+	// Image of the plugin, <name>:latest by default.
+	Image string `json:"image,omitempty"`
+	// Namespace to deploy the plugin to, DefaultNamespace by default.
+	Namespace string `json:"namespace,omitempty"`
+	// Args are extra command line arguments for the plugin.
+	Args []string `json:"args,omitempty"`
+	// Config is the default configuration of the plugin. If set, it is
+	// put in a ConfigMap and passed to the plugin as a file.
+	Config string `json:"config,omitempty"`
+	// ConfigFile is a file with the default configuration, relative to the
+	// spec file. It is read into Config by LoadSpec.
+	ConfigFile string `json:"configFile,omitempty"`
+	// ConfigFlag is the flag passing the configuration file to the plugin,
+	// DefaultConfigFlag by default.
+	ConfigFlag string `json:"configFlag,omitempty"`
+	// Socket is the NRI socket of the runtime, api.DefaultSocketPath by
+	// default.
+	Socket string `json:"socket,omitempty"`
+	// HostPaths are other host files, directories and sockets the plugin
+	// needs access to.
+	HostPaths []*HostPath `json:"hostPaths,omitempty"`
+	// Privileged runs the plugin in a privileged container.
+	Privileged bool `json:"privileged,omitempty"`
+	// Capabilities are the capabilities the plugin needs, if it is not
+	// privileged, like NET_ADMIN.
+	Capabilities []string `json:"capabilities,omitempty"`
+	// HostNetwork runs the plugin in the host network namespace.
+	HostNetwork bool `json:"hostNetwork,omitempty"`
+	// HostPID runs the plugin in the host PID namespace.
+	HostPID bool `json:"hostPID,omitempty"`
+	// Rules are the Kubernetes API access rules of the plugin. If set, the
+	// plugin gets a ServiceAccount bound to a ClusterRole with these rules.
+	Rules []*Rule `json:"rules,omitempty"`
+}
+
+// HostPath is a host path a plugin needs access to.
+type HostPath struct {
+	// Path on the host.
+	Path string `json:"path"`
+	// MountPath in the plugin container, Path by default.
+	MountPath string `json:"mountPath,omitempty"`
+	// Type of the path, like Directory or Socket. Not checked by default.
+	Type string `json:"type,omitempty"`
+	// ReadOnly mounts the path read-only.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Bidirectional mount propagation, for paths the plugin mounts on, like
+	// network namespaces.
+	Bidirectional bool `json:"bidirectional,omitempty"`
+}
+
+// Rule is a Kubernetes API access rule.
+type Rule struct {
+	APIGroups []string `json:"apiGroups"`
+	Resources []string `json:"resources"`
+	Verbs     []string `json:"verbs"`
+}
+
+// ParseSpec parses a plugin spec from YAML, setting defaults and checking it.
+func ParseSpec(data []byte) (*Spec, error) {
+	spec := &Spec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSpec, err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// LoadSpec loads a plugin spec from a YAML file, reading the configuration
+// file it refers to, if any.
+func LoadSpec(file string) (*Spec, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin spec: %w", err)
+	}
+
+	spec, err := ParseSpec(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.ConfigFile != "" {
+		if spec.Config != "" {
+			return nil, fmt.Errorf("%w: both config and configFile set", ErrInvalidSpec)
+		}
+		cfgFile := spec.ConfigFile
+		if !filepath.IsAbs(cfgFile) {
+			cfgFile = filepath.Join(filepath.Dir(file), cfgFile)
+		}
+		data, err = os.ReadFile(cfgFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read plugin configuration: %w", err)
+		}
+		spec.Config = string(data)
+	}
+
+	return spec, nil
+}
+
+// Validate sets defaults for unset fields of the spec, then checks it.
+func (s *Spec) Validate() error {
+	if !validName.MatchString(s.Name) || len(s.Name) > 40 {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidSpec, s.Name)
+	}
+	if !validIndex.MatchString(s.Index) {
+		return fmt.Errorf("%w: invalid index %q", ErrInvalidSpec, s.Index)
+	}
+	if s.Image == "" {
+		s.Image = s.Name + ":latest"
+	}
+	if s.Namespace == "" {
+		s.Namespace = DefaultNamespace
+	}
+	if s.ConfigFlag == "" {
+		s.ConfigFlag = DefaultConfigFlag
+	}
+	if s.Socket == "" {
+		s.Socket = api.DefaultSocketPath
+	}
+	if !path.IsAbs(s.Socket) {
+		return fmt.Errorf("%w: relative socket path %q", ErrInvalidSpec, s.Socket)
+	}
+
+	for _, hp := range s.HostPaths {
+		if !path.IsAbs(hp.Path) {
+			return fmt.Errorf("%w: relative host path %q", ErrInvalidSpec, hp.Path)
+		}
+		if hp.MountPath == "" {
+			hp.MountPath = hp.Path
+		}
+		if !path.IsAbs(hp.MountPath) {
+			return fmt.Errorf("%w: relative mount path %q", ErrInvalidSpec, hp.MountPath)
+		}
+	}
+
+	for _, r := range s.Rules {
+		if len(r.Resources) == 0 || len(r.Verbs) == 0 {
+			return fmt.Errorf("%w: rule without resources or verbs", ErrInvalidSpec)
+		}
+		if len(r.APIGroups) == 0 {
+			r.APIGroups = []string{""}
+		}
+	}
+
+	return nil
+}
+
+// Manifests returns the manifests deploying the plugin, as a multi-document
+// YAML stream.
+func (s *Spec) Manifests() ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	for i, obj := range s.Objects() {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", obj["kind"], err)
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Objects returns the Kubernetes objects deploying the plugin. The spec is
+// expected to be valid.
+func (s *Spec) Objects() []map[string]interface{} {
+	var objs []map[string]interface{}
+
+	if len(s.Rules) > 0 {
+		objs = append(objs, s.serviceAccount(), s.clusterRole(), s.clusterRoleBinding())
+	}
+	if s.Config != "" {
+		objs = append(objs, s.configMap())
+	}
+
+	return append(objs, s.daemonSet())
+}
+
+// objectName returns the name of the objects of the plugin.
+func (s *Spec) objectName() string {
+	return "nri-plugin-" + s.Name
+}
+
+func (s *Spec) metadata(namespaced bool) map[string]interface{} {
+	meta := map[string]interface{}{
+		"name": s.objectName(),
+		"labels": map[string]string{
+			"app.kubernetes.io/name": s.objectName(),
+		},
+	}
+	if namespaced {
+		meta["namespace"] = s.Namespace
+	}
+	return meta
+}
+
+func (s *Spec) serviceAccount() map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ServiceAccount",
+		"metadata":   s.metadata(true),
+	}
+}
+
+func (s *Spec) clusterRole() map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "rbac.authorization.k8s.io/v1",
+		"kind":       "ClusterRole",
+		"metadata":   s.metadata(false),
+		"rules":      s.Rules,
+	}
+}
+
+func (s *Spec) clusterRoleBinding() map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "rbac.authorization.k8s.io/v1",
+		"kind":       "ClusterRoleBinding",
+		"metadata":   s.metadata(false),
+		"roleRef": map[string]string{
+			"apiGroup": "rbac.authorization.k8s.io",
+			"kind":     "ClusterRole",
+			"name":     s.objectName(),
+		},
+		"subjects": []map[string]string{
+			{
+				"kind":      "ServiceAccount",
+				"name":      s.objectName(),
+				"namespace": s.Namespace,
+			},
+		},
+	}
+}
+
+func (s *Spec) configMap() map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   s.metadata(true),
+		"data": map[string]string{
+			configFile: s.Config,
+		},
+	}
+}
+
+func (s *Spec) daemonSet() map[string]interface{} {
+	var (
+		args    = append([]string{"-name", s.Name, "-idx", s.Index}, s.Args...)
+		mounts  []map[string]interface{}
+		volumes []map[string]interface{}
+	)
+
+	addVolume := func(name string, source map[string]interface{}, mount map[string]interface{}) {
+		mount["name"] = name
+		mounts = append(mounts, mount)
+		source["name"] = name
+		volumes = append(volumes, source)
+	}
+
+	addVolume("nri-socket",
+		map[string]interface{}{
+			"hostPath": map[string]string{"path": s.Socket, "type": "Socket"},
+		},
+		map[string]interface{}{"mountPath": s.Socket},
+	)
+
+	if s.Config != "" {
+		args = append(args, s.ConfigFlag, path.Join(configDir, configFile))
+		addVolume("config",
+			map[string]interface{}{
+				"configMap": map[string]string{"name": s.objectName()},
+			},
+			map[string]interface{}{"mountPath": configDir, "readOnly": true},
+		)
+	}
+
+	for i, hp := range s.HostPaths {
+		hostPath := map[string]string{"path": hp.Path}
+		if hp.Type != "" {
+			hostPath["type"] = hp.Type
+		}
+		mount := map[string]interface{}{"mountPath": hp.MountPath}
+		if hp.ReadOnly {
+			mount["readOnly"] = true
+		}
+		if hp.Bidirectional {
+			mount["mountPropagation"] = "Bidirectional"
+		}
+		addVolume(volumeName(i, hp.Path), map[string]interface{}{"hostPath": hostPath}, mount)
+	}
+
+	security := map[string]interface{}{}
+	if s.Privileged {
+		security["privileged"] = true
+	} else if len(s.Capabilities) > 0 {
+		security["capabilities"] = map[string][]string{"add": s.Capabilities}
+	}
+
+	container := map[string]interface{}{
+		"name":         "plugin",
+		"image":        s.Image,
+		"args":         args,
+		"volumeMounts": mounts,
+	}
+	if len(security) > 0 {
+		container["securityContext"] = security
+	}
+
+	podSpec := map[string]interface{}{
+		"containers": []interface{}{container},
+		"volumes":    volumes,
+	}
+	if s.HostNetwork {
+		podSpec["hostNetwork"] = true
+	}
+	if s.HostPID {
+		podSpec["hostPID"] = true
+	}
+	if len(s.Rules) > 0 {
+		podSpec["serviceAccountName"] = s.objectName()
+	}
+
+	labels := map[string]string{"app.kubernetes.io/name": s.objectName()}
+
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "DaemonSet",
+		"metadata":   s.metadata(true),
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": labels},
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{"labels": labels},
+				"spec":     podSpec,
+			},
+		},
+	}
+}
+
+// volumeName returns a unique volume name for a host path.
+func volumeName(idx int, hostPath string) string {
+	name := strings.Trim(strings.ToLower(hostPath), "/")
+	name = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '-'
+	}, name)
+	name = fmt.Sprintf("host-%d-%s", idx, name)
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
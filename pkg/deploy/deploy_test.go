@@ -0,0 +1,158 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package deploy_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/containerd/nri/pkg/deploy"
+
+	require "github.com/stretchr/testify/require"
+)
+
+func TestMinimalSpec(t *testing.T) {
+	spec, err := deploy.ParseSpec([]byte("name: logger\nindex: \"10\"\n"))
+	require.NoError(t, err, "ParseSpec()")
+	require.Equal(t, "logger:latest", spec.Image)
+	require.Equal(t, deploy.DefaultNamespace, spec.Namespace)
+
+	objs := spec.Objects()
+	require.Len(t, objs, 1, "only a DaemonSet")
+	require.Equal(t, "DaemonSet", objs[0]["kind"])
+
+	data, err := spec.Manifests()
+	require.NoError(t, err, "Manifests()")
+
+	ds := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal(data, &ds), "parse DaemonSet")
+	require.Equal(t, "nri-plugin-logger", lookup(t, ds, "metadata", "name"))
+
+	ctr := lookup(t, ds, "spec", "template", "spec", "containers").([]interface{})[0].(map[string]interface{})
+	require.Equal(t, []interface{}{"-name", "logger", "-idx", "10"}, ctr["args"])
+	require.NotContains(t, ctr, "securityContext")
+
+	vol := lookup(t, ds, "spec", "template", "spec", "volumes").([]interface{})[0]
+	require.Equal(t, "/var/run/nri/nri.sock", lookup(t, vol.(map[string]interface{}), "hostPath", "path"))
+}
+
+func TestFullSpec(t *testing.T) {
+	spec, err := deploy.ParseSpec([]byte(`
+name: egress-firewall
+index: "20"
+image: example.com/egress-firewall:v1
+namespace: nri
+args: [-dry-run]
+config: |
+  tenants: []
+socket: /run/containerd/nri.sock
+hostPaths:
+  - path: /var/run/netns
+    type: Directory
+    bidirectional: true
+capabilities: [NET_ADMIN, SYS_ADMIN]
+hostPID: true
+rules:
+  - resources: [pods]
+    verbs: [get, list, watch]
+`))
+	require.NoError(t, err, "ParseSpec()")
+
+	data, err := spec.Manifests()
+	require.NoError(t, err, "Manifests()")
+
+	docs := strings.Split(string(data), "---\n")
+	require.Len(t, docs, 5, "number of manifests")
+
+	var kinds []string
+	for _, doc := range docs {
+		obj := map[string]interface{}{}
+		require.NoError(t, yaml.Unmarshal([]byte(doc), &obj))
+		kinds = append(kinds, obj["kind"].(string))
+
+		switch obj["kind"] {
+		case "ClusterRole":
+			rule := obj["rules"].([]interface{})[0].(map[string]interface{})
+			require.Equal(t, []interface{}{""}, rule["apiGroups"], "default API group")
+		case "ConfigMap":
+			require.Equal(t, "tenants: []\n", lookup(t, obj, "data", "config.yaml"))
+		case "DaemonSet":
+			require.Equal(t, "nri", lookup(t, obj, "metadata", "namespace"))
+			pod := lookup(t, obj, "spec", "template", "spec").(map[string]interface{})
+			require.Equal(t, true, pod["hostPID"])
+			require.Equal(t, "nri-plugin-egress-firewall", pod["serviceAccountName"])
+
+			ctr := pod["containers"].([]interface{})[0].(map[string]interface{})
+			require.Equal(t, "example.com/egress-firewall:v1", ctr["image"])
+			require.Equal(t, []interface{}{"-name", "egress-firewall", "-idx", "20", "-dry-run",
+				"-config", "/etc/nri/config/config.yaml"}, ctr["args"])
+			require.Equal(t, []interface{}{"NET_ADMIN", "SYS_ADMIN"},
+				lookup(t, ctr, "securityContext", "capabilities", "add"))
+
+			mounts := ctr["volumeMounts"].([]interface{})
+			require.Len(t, mounts, 3, "socket, config and host path mounts")
+			netns := mounts[2].(map[string]interface{})
+			require.Equal(t, "host-0-var-run-netns", netns["name"])
+			require.Equal(t, "Bidirectional", netns["mountPropagation"])
+		}
+	}
+	require.Equal(t, []string{"ServiceAccount", "ClusterRole", "ClusterRoleBinding", "ConfigMap", "DaemonSet"}, kinds)
+}
+
+func TestInvalidSpec(t *testing.T) {
+	for name, spec := range map[string]string{
+		"no name":            "index: \"10\"",
+		"invalid name":       "name: My_Plugin\nindex: \"10\"",
+		"invalid index":      "name: p\nindex: \"1\"",
+		"unknown field":      "name: p\nindex: \"10\"\nprivileges: true",
+		"relative socket":    "name: p\nindex: \"10\"\nsocket: nri.sock",
+		"relative host path": "name: p\nindex: \"10\"\nhostPaths: [{path: sys}]",
+		"rule without verbs": "name: p\nindex: \"10\"\nrules: [{resources: [pods]}]",
+	} {
+		_, err := deploy.ParseSpec([]byte(spec))
+		require.ErrorIs(t, err, deploy.ErrInvalidSpec, name)
+	}
+}
+
+func TestLoadSpec(t *testing.T) {
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "deploy.yaml")
+	require.NoError(t, os.WriteFile(spec, []byte("name: p\nindex: \"10\"\nconfigFile: config.yaml\n"), 0o644))
+
+	_, err := deploy.LoadSpec(spec)
+	require.Error(t, err, "LoadSpec() with missing config file")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("debug: true\n"), 0o644))
+	s, err := deploy.LoadSpec(spec)
+	require.NoError(t, err, "LoadSpec()")
+	require.Equal(t, "debug: true\n", s.Config)
+}
+
+// lookup returns a nested field of an unmarshalled object.
+func lookup(t *testing.T, obj map[string]interface{}, keys ...string) interface{} {
+	var v interface{} = obj
+	for _, key := range keys {
+		m, ok := v.(map[string]interface{})
+		require.True(t, ok, "%s is not an object", key)
+		v = m[key]
+	}
+	return v
+}
@@ -0,0 +1,333 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package ipam provides an IP address allocator for IPAM-style NRI
+// plugins. Addresses are allocated from named CIDR pools to owners, which
+// are typically pods. An owner gets at most one address from each pool,
+// either the next free one or a specific one it reserves. Allocations can
+// be persisted in a state.Store, and released for owners which are gone
+// during synchronization.
+package ipam
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"sort"
+	"sync"
+
+	"github.com/containerd/nri/pkg/state"
+)
+
+var (
+	// ErrUnknownPool is returned for allocations from a nonexistent pool.
+	ErrUnknownPool = errors.New("ipam: unknown pool")
+	// ErrExhausted is returned if a pool has no free addresses left.
+	ErrExhausted = errors.New("ipam: pool exhausted")
+	// ErrConflict is returned for reserving an address allocated to
+	// another owner, or an address which cannot be allocated at all.
+	ErrConflict = errors.New("ipam: address conflict")
+)
+
+const (
+	// stateKeyPrefix is the prefix of the state keys for pools.
+	stateKeyPrefix = "ipam/"
+)
+
+// Allocation is an address allocated from a pool to an owner.
+type Allocation struct {
+	Owner string
+	Pool  string
+	Addr  netip.Addr
+}
+
+// Allocator allocates addresses from CIDR pools.
+type Allocator struct {
+	sync.Mutex
+	pools map[string]*pool
+	store *state.Store
+}
+
+// Option to apply to an allocator.
+type Option func(*Allocator) error
+
+// WithPool returns an option to add a pool of the addresses in the given
+// CIDR, except for the given excluded ones, like gateway addresses. The
+// network address, and for IPv4 the broadcast address, is never allocated.
+// Pools may not overlap.
+func WithPool(name, cidr string, exclude ...string) Option {
+	return func(a *Allocator) error {
+		if _, ok := a.pools[name]; ok {
+			return fmt.Errorf("pool %q already exists", name)
+		}
+		p, err := newPool(name, cidr, exclude...)
+		if err != nil {
+			return err
+		}
+		for _, o := range a.pools {
+			if p.overlaps(o) {
+				return fmt.Errorf("%w: pool %q (%s) overlaps pool %q (%s)",
+					ErrConflict, p.name, p.prefix, o.name, o.prefix)
+			}
+		}
+		a.pools[name] = p
+		return nil
+	}
+}
+
+// WithStore returns an option to persist allocations in the given store.
+// Allocations found in the store are restored when the allocator is
+// created.
+func WithStore(store *state.Store) Option {
+	return func(a *Allocator) error {
+		a.store = store
+		return nil
+	}
+}
+
+// New creates an allocator with the given options.
+func New(options ...Option) (*Allocator, error) {
+	a := &Allocator{
+		pools: make(map[string]*pool),
+	}
+
+	for _, o := range options {
+		if err := o(a); err != nil {
+			return nil, fmt.Errorf("failed to create IP allocator: %w", err)
+		}
+	}
+
+	if err := a.restore(); err != nil {
+		return nil, fmt.Errorf("failed to create IP allocator: %w", err)
+	}
+
+	return a, nil
+}
+
+// Allocate allocates the next free address of the pool to the owner. If
+// the owner already has an address from the pool, that one is returned.
+func (a *Allocator) Allocate(owner, pool string) (netip.Addr, error) {
+	a.Lock()
+	defer a.Unlock()
+
+	p, ok := a.pools[pool]
+	if !ok {
+		return netip.Addr{}, fmt.Errorf("%w %q", ErrUnknownPool, pool)
+	}
+	if addr, ok := p.lookup(owner); ok {
+		return addr, nil
+	}
+
+	next := p.next
+	addr, ok := p.take(owner)
+	if !ok {
+		return netip.Addr{}, fmt.Errorf("%w: no free address in pool %q (%s)",
+			ErrExhausted, pool, p.prefix)
+	}
+
+	if err := a.save(p); err != nil {
+		p.free(addr)
+		p.next = next
+		return netip.Addr{}, err
+	}
+
+	return addr, nil
+}
+
+// Reserve allocates the given address to the owner. It fails with
+// ErrConflict if the address is allocated to another owner, is excluded,
+// or if the owner already has another address from the pool.
+func (a *Allocator) Reserve(owner, pool string, addr netip.Addr) error {
+	a.Lock()
+	defer a.Unlock()
+
+	p, ok := a.pools[pool]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnknownPool, pool)
+	}
+	if !p.prefix.Contains(addr) {
+		return fmt.Errorf("%w: address %s not in pool %q (%s)", ErrConflict, addr, pool, p.prefix)
+	}
+	if other, ok := p.used[addr]; ok {
+		if other == owner {
+			return nil
+		}
+		return fmt.Errorf("%w: address %s already allocated to %s", ErrConflict, addr, other)
+	}
+	if _, ok := p.excluded[addr]; ok {
+		return fmt.Errorf("%w: address %s is excluded from pool %q", ErrConflict, addr, pool)
+	}
+	if old, ok := p.lookup(owner); ok {
+		return fmt.Errorf("%w: %s already has address %s from pool %q", ErrConflict, owner, old, pool)
+	}
+
+	p.assign(addr, owner)
+	if err := a.save(p); err != nil {
+		p.free(addr)
+		return err
+	}
+
+	return nil
+}
+
+// Release releases all addresses allocated to the owner, returning them.
+func (a *Allocator) Release(owner string) ([]*Allocation, error) {
+	a.Lock()
+	defer a.Unlock()
+
+	return a.release(func(o string) bool { return o == owner })
+}
+
+// Reconcile releases the addresses of all owners other than the given
+// ones, returning the released allocations. Plugins typically call it
+// with the IDs of the pods passed to their Synchronize handler.
+func (a *Allocator) Reconcile(owners []string) ([]*Allocation, error) {
+	a.Lock()
+	defer a.Unlock()
+
+	keep := make(map[string]struct{}, len(owners))
+	for _, o := range owners {
+		keep[o] = struct{}{}
+	}
+
+	return a.release(func(o string) bool {
+		_, ok := keep[o]
+		return !ok
+	})
+}
+
+// Lookup returns the address allocated to the owner from the pool.
+func (a *Allocator) Lookup(owner, pool string) (netip.Addr, bool) {
+	a.Lock()
+	defer a.Unlock()
+
+	p, ok := a.pools[pool]
+	if !ok {
+		return netip.Addr{}, false
+	}
+	return p.lookup(owner)
+}
+
+// Allocations returns all allocations, sorted by pool and address.
+func (a *Allocator) Allocations() []*Allocation {
+	a.Lock()
+	defer a.Unlock()
+
+	var allocs []*Allocation
+	for _, p := range a.pools {
+		for addr, owner := range p.used {
+			allocs = append(allocs, &Allocation{Owner: owner, Pool: p.name, Addr: addr})
+		}
+	}
+	sortAllocations(allocs)
+
+	return allocs
+}
+
+// release the addresses of owners matching the given function.
+func (a *Allocator) release(match func(string) bool) ([]*Allocation, error) {
+	var (
+		released []*Allocation
+		changed  []*pool
+	)
+
+	for _, p := range a.pools {
+		n := len(released)
+		for addr, owner := range p.used {
+			if match(owner) {
+				released = append(released, &Allocation{Owner: owner, Pool: p.name, Addr: addr})
+			}
+		}
+		if len(released) > n {
+			changed = append(changed, p)
+		}
+	}
+
+	for _, alloc := range released {
+		a.pools[alloc.Pool].free(alloc.Addr)
+	}
+
+	if err := a.save(changed...); err != nil {
+		for _, alloc := range released {
+			a.pools[alloc.Pool].assign(alloc.Addr, alloc.Owner)
+		}
+		return nil, err
+	}
+
+	sortAllocations(released)
+	return released, nil
+}
+
+// save the allocations of the given pools to the store.
+func (a *Allocator) save(pools ...*pool) error {
+	if a.store == nil || len(pools) == 0 {
+		return nil
+	}
+
+	return a.store.Update(func(tx *state.Txn) error {
+		for _, p := range pools {
+			used := make(map[string]string, len(p.used))
+			for addr, owner := range p.used {
+				used[addr.String()] = owner
+			}
+			if err := tx.PutJSON(stateKeyPrefix+p.name, used); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// restore allocations from the store.
+func (a *Allocator) restore() error {
+	if a.store == nil {
+		return nil
+	}
+
+	return a.store.View(func(tx *state.Txn) error {
+		for _, p := range a.pools {
+			used := map[string]string{}
+			if _, err := tx.GetJSON(stateKeyPrefix+p.name, &used); err != nil {
+				return err
+			}
+			for s, owner := range used {
+				addr, err := netip.ParseAddr(s)
+				if err != nil {
+					return fmt.Errorf("invalid stored address %q in pool %q: %w", s, p.name, err)
+				}
+				if !p.prefix.Contains(addr) {
+					return fmt.Errorf("%w: stored address %s not in pool %q (%s)",
+						ErrConflict, addr, p.name, p.prefix)
+				}
+				if old, ok := p.lookup(owner); ok {
+					return fmt.Errorf("%w: %s has stored addresses %s and %s in pool %q",
+						ErrConflict, owner, old, addr, p.name)
+				}
+				p.assign(addr, owner)
+			}
+		}
+		return nil
+	})
+}
+
+func sortAllocations(allocs []*Allocation) {
+	sort.Slice(allocs, func(i, j int) bool {
+		if allocs[i].Pool != allocs[j].Pool {
+			return allocs[i].Pool < allocs[j].Pool
+		}
+		return allocs[i].Addr.Less(allocs[j].Addr)
+	})
+}
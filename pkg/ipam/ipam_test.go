@@ -0,0 +1,128 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package ipam_test
+
+import (
+	"net/netip"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/nri/pkg/ipam"
+	"github.com/containerd/nri/pkg/state"
+
+	require "github.com/stretchr/testify/require"
+)
+
+func TestAllocate(t *testing.T) {
+	a, err := ipam.New(ipam.WithPool("net", "10.0.0.0/29", "10.0.0.1"))
+	require.NoError(t, err, "New()")
+
+	// 10.0.0.0 (network), 10.0.0.1 (excluded) and 10.0.0.7 (broadcast)
+	// are never allocated, leaving 5 addresses.
+	var addrs []string
+	for _, owner := range []string{"pod0", "pod1", "pod2", "pod3", "pod4"} {
+		addr, err := a.Allocate(owner, "net")
+		require.NoError(t, err, "Allocate(%s)", owner)
+		addrs = append(addrs, addr.String())
+	}
+	require.Equal(t, []string{"10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"}, addrs)
+
+	addr, err := a.Allocate("pod2", "net")
+	require.NoError(t, err, "Allocate() again")
+	require.Equal(t, "10.0.0.4", addr.String(), "Allocate() again")
+
+	_, err = a.Allocate("pod5", "net")
+	require.ErrorIs(t, err, ipam.ErrExhausted, "Allocate() from full pool")
+	_, err = a.Allocate("pod5", "other")
+	require.ErrorIs(t, err, ipam.ErrUnknownPool, "Allocate() from unknown pool")
+
+	released, err := a.Release("pod1")
+	require.NoError(t, err, "Release()")
+	require.Len(t, released, 1, "Release()")
+	require.Equal(t, "10.0.0.3", released[0].Addr.String(), "Release()")
+
+	addr, err = a.Allocate("pod5", "net")
+	require.NoError(t, err, "Allocate() after Release()")
+	require.Equal(t, "10.0.0.3", addr.String(), "Allocate() after Release()")
+}
+
+func TestReserve(t *testing.T) {
+	a, err := ipam.New(
+		ipam.WithPool("v4", "192.168.0.0/24", "192.168.0.1"),
+		ipam.WithPool("v6", "fd00::/64"),
+	)
+	require.NoError(t, err, "New()")
+
+	_, err = ipam.New(
+		ipam.WithPool("a", "10.0.0.0/16"),
+		ipam.WithPool("b", "10.0.1.0/24"),
+	)
+	require.ErrorIs(t, err, ipam.ErrConflict, "New() with overlapping pools")
+
+	require.NoError(t, a.Reserve("pod0", "v4", netip.MustParseAddr("192.168.0.10")), "Reserve()")
+	require.NoError(t, a.Reserve("pod0", "v4", netip.MustParseAddr("192.168.0.10")), "Reserve() again")
+	require.ErrorIs(t, a.Reserve("pod1", "v4", netip.MustParseAddr("192.168.0.10")),
+		ipam.ErrConflict, "Reserve() address of other owner")
+	require.ErrorIs(t, a.Reserve("pod1", "v4", netip.MustParseAddr("192.168.0.1")),
+		ipam.ErrConflict, "Reserve() excluded address")
+	require.ErrorIs(t, a.Reserve("pod1", "v4", netip.MustParseAddr("10.0.0.1")),
+		ipam.ErrConflict, "Reserve() address outside pool")
+	require.ErrorIs(t, a.Reserve("pod0", "v4", netip.MustParseAddr("192.168.0.11")),
+		ipam.ErrConflict, "Reserve() second address")
+
+	addr, err := a.Allocate("pod0", "v6")
+	require.NoError(t, err, "Allocate() IPv6")
+	require.Equal(t, "fd00::1", addr.String(), "Allocate() IPv6")
+}
+
+func TestPersistAndReconcile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	store, err := state.Open(path)
+	require.NoError(t, err, "state.Open()")
+
+	a, err := ipam.New(ipam.WithPool("net", "10.0.0.0/24"), ipam.WithStore(store))
+	require.NoError(t, err, "New()")
+	for _, owner := range []string{"pod0", "pod1", "pod2"} {
+		_, err := a.Allocate(owner, "net")
+		require.NoError(t, err, "Allocate(%s)", owner)
+	}
+
+	store, err = state.Open(path)
+	require.NoError(t, err, "state.Open() again")
+	a, err = ipam.New(ipam.WithPool("net", "10.0.0.0/24"), ipam.WithStore(store))
+	require.NoError(t, err, "New() with stored allocations")
+	require.Len(t, a.Allocations(), 3, "Allocations() restored")
+
+	released, err := a.Reconcile([]string{"pod0", "pod2"})
+	require.NoError(t, err, "Reconcile()")
+	require.Equal(t, []*ipam.Allocation{
+		{Owner: "pod1", Pool: "net", Addr: netip.MustParseAddr("10.0.0.2")},
+	}, released, "Reconcile()")
+
+	store, err = state.Open(path)
+	require.NoError(t, err, "state.Open() after Reconcile()")
+	a, err = ipam.New(ipam.WithPool("net", "10.0.0.0/24"), ipam.WithStore(store))
+	require.NoError(t, err, "New() after Reconcile()")
+	require.Equal(t, []*ipam.Allocation{
+		{Owner: "pod0", Pool: "net", Addr: netip.MustParseAddr("10.0.0.1")},
+		{Owner: "pod2", Pool: "net", Addr: netip.MustParseAddr("10.0.0.3")},
+	}, a.Allocations(), "Allocations() after Reconcile()")
+
+	_, err = ipam.New(ipam.WithPool("net", "10.1.0.0/24"), ipam.WithStore(store))
+	require.ErrorIs(t, err, ipam.ErrConflict, "New() with stored address outside pool")
+}
@@ -0,0 +1,168 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package ipam
+
+import (
+	"fmt"
+	"math"
+	"net/netip"
+)
+
+// pool is a range of addresses allocated from a CIDR.
+type pool struct {
+	name     string
+	prefix   netip.Prefix
+	first    netip.Addr
+	last     netip.Addr
+	size     uint64
+	next     netip.Addr
+	excluded map[netip.Addr]struct{}
+	used     map[netip.Addr]string
+	owners   map[string]netip.Addr
+}
+
+func newPool(name, cidr string, exclude ...string) (*pool, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q for pool %q: %w", cidr, name, err)
+	}
+	prefix = prefix.Masked()
+
+	p := &pool{
+		name:     name,
+		prefix:   prefix,
+		first:    prefix.Addr(),
+		last:     lastAddr(prefix),
+		size:     prefixSize(prefix),
+		excluded: make(map[netip.Addr]struct{}),
+		used:     make(map[netip.Addr]string),
+		owners:   make(map[string]netip.Addr),
+	}
+
+	// Don't hand out the network address, or the IPv4 broadcast address.
+	if p.size > 2 {
+		p.exclude(p.first)
+		if prefix.Addr().Is4() {
+			p.exclude(p.last)
+		}
+	}
+
+	for _, e := range exclude {
+		addr, err := netip.ParseAddr(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid excluded address %q for pool %q: %w", e, name, err)
+		}
+		if !prefix.Contains(addr) {
+			return nil, fmt.Errorf("excluded address %s not in pool %q (%s)", addr, name, prefix)
+		}
+		p.exclude(addr)
+	}
+
+	p.next = p.first
+
+	return p, nil
+}
+
+func (p *pool) exclude(addr netip.Addr) {
+	p.excluded[addr] = struct{}{}
+}
+
+// overlaps checks if the pool overlaps with another one.
+func (p *pool) overlaps(o *pool) bool {
+	return p.prefix.Overlaps(o.prefix)
+}
+
+// isFree checks if the given address of the pool can be allocated.
+func (p *pool) isFree(addr netip.Addr) bool {
+	if _, ok := p.excluded[addr]; ok {
+		return false
+	}
+	_, ok := p.used[addr]
+	return !ok
+}
+
+// isFull checks if all addresses of the pool are allocated or excluded.
+func (p *pool) isFull() bool {
+	return uint64(len(p.used)+len(p.excluded)) >= p.size
+}
+
+// take allocates the next free address of the pool, after the last one
+// allocated, to the given owner.
+func (p *pool) take(owner string) (netip.Addr, bool) {
+	if p.isFull() {
+		return netip.Addr{}, false
+	}
+
+	addr := p.next
+	for i := uint64(0); i < p.size; i++ {
+		if p.isFree(addr) {
+			p.assign(addr, owner)
+			p.next = p.after(addr)
+			return addr, true
+		}
+		addr = p.after(addr)
+	}
+
+	return netip.Addr{}, false
+}
+
+// assign the given address to the owner.
+func (p *pool) assign(addr netip.Addr, owner string) {
+	p.used[addr] = owner
+	p.owners[owner] = addr
+}
+
+// free the given address.
+func (p *pool) free(addr netip.Addr) {
+	if owner, ok := p.used[addr]; ok {
+		delete(p.used, addr)
+		delete(p.owners, owner)
+	}
+}
+
+// lookup returns the address assigned to the owner.
+func (p *pool) lookup(owner string) (netip.Addr, bool) {
+	addr, ok := p.owners[owner]
+	return addr, ok
+}
+
+// after returns the address following addr in the pool, wrapping around.
+func (p *pool) after(addr netip.Addr) netip.Addr {
+	if addr == p.last {
+		return p.first
+	}
+	return addr.Next()
+}
+
+// lastAddr returns the last address of a masked prefix.
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Addr().AsSlice()
+	for i := prefix.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+// prefixSize returns the number of addresses in a prefix, saturated.
+func prefixSize(prefix netip.Prefix) uint64 {
+	bits := prefix.Addr().BitLen() - prefix.Bits()
+	if bits >= 64 {
+		return math.MaxUint64
+	}
+	return 1 << bits
+}
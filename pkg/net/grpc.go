@@ -0,0 +1,73 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package net
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NewGRPCClientConn creates a gRPC client connection over an existing
+// net.Conn. Since the connection cannot be re-established, onClose is
+// called once the connection is lost after it has been established.
+func NewGRPCClientConn(conn net.Conn, onClose func()) (*grpc.ClientConn, error) {
+	var once sync.Once
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		c := net.Conn(nil)
+		once.Do(func() { c = conn })
+		if c == nil {
+			return nil, errors.New("gRPC connection already used")
+		}
+		return c, nil
+	}
+
+	cc, err := grpc.Dial("nri",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialer),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ctx := context.Background()
+		ready := false
+		for {
+			state := cc.GetState()
+			switch {
+			case state == connectivity.Ready:
+				ready = true
+			case state == connectivity.Shutdown,
+				ready && state != connectivity.Ready:
+				if onClose != nil {
+					onClose()
+				}
+				return
+			}
+			cc.WaitForStateChange(ctx, state)
+		}
+	}()
+
+	return cc, nil
+}
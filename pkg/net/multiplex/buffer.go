@@ -0,0 +1,69 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package multiplex
+
+import (
+	"sync"
+)
+
+// Frame buffers are recycled to reduce GC pressure on busy connections.
+// They come in a few size classes. Pools hold pointers to fixed-size arrays,
+// so recycling a buffer does not allocate.
+const (
+	smallBufferSize  = 4 * 1024
+	mediumBufferSize = 16 * 1024
+	largeBufferSize  = 64 * 1024
+)
+
+var (
+	smallBuffers = sync.Pool{
+		New: func() interface{} { return new([smallBufferSize]byte) },
+	}
+	mediumBuffers = sync.Pool{
+		New: func() interface{} { return new([mediumBufferSize]byte) },
+	}
+	largeBuffers = sync.Pool{
+		New: func() interface{} { return new([largeBufferSize]byte) },
+	}
+)
+
+// getBuffer returns a buffer of the given size, recycled if possible.
+func getBuffer(size int) []byte {
+	switch {
+	case size <= smallBufferSize:
+		return smallBuffers.Get().(*[smallBufferSize]byte)[:size]
+	case size <= mediumBufferSize:
+		return mediumBuffers.Get().(*[mediumBufferSize]byte)[:size]
+	case size <= largeBufferSize:
+		return largeBuffers.Get().(*[largeBufferSize]byte)[:size]
+	}
+	return make([]byte, size)
+}
+
+// putBuffer recycles a buffer obtained from getBuffer. The buffer must not
+// be used after it is recycled. Buffers which were not obtained from
+// getBuffer, or have been reallocated since, are left to GC.
+func putBuffer(buf []byte) {
+	switch cap(buf) {
+	case smallBufferSize:
+		smallBuffers.Put((*[smallBufferSize]byte)(buf[:smallBufferSize]))
+	case mediumBufferSize:
+		mediumBuffers.Put((*[mediumBufferSize]byte)(buf[:mediumBufferSize]))
+	case largeBufferSize:
+		largeBuffers.Put((*[largeBufferSize]byte)(buf[:largeBufferSize]))
+	}
+}
@@ -0,0 +1,71 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package multiplex
+
+import (
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// flag in the frame header payload length for compressed payloads
+	compressedFlag = 1 << 31
+)
+
+var (
+	zstdOnce    sync.Once
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+func initZstd() error {
+	zstdOnce.Do(func() {
+		zstdEncoder, zstdErr = zstd.NewWriter(nil)
+		if zstdErr != nil {
+			return
+		}
+		zstdDecoder, zstdErr = zstd.NewReader(nil,
+			zstd.WithDecoderMaxMemory(maxPayloadSize),
+		)
+	})
+	return zstdErr
+}
+
+// compress a payload, returning nil if it is not worth compressing. The
+// returned buffer can be recycled with putBuffer once written.
+func compress(buf []byte) []byte {
+	if initZstd() != nil {
+		return nil
+	}
+	cbuf := zstdEncoder.EncodeAll(buf, getBuffer(0))
+	if len(cbuf) >= len(buf) {
+		putBuffer(cbuf)
+		return nil
+	}
+	return cbuf
+}
+
+// decompress a compressed payload. The returned buffer can be recycled
+// with putBuffer once read.
+func decompress(cbuf []byte) ([]byte, error) {
+	if err := initZstd(); err != nil {
+		return nil, err
+	}
+	return zstdDecoder.DecodeAll(cbuf, getBuffer(0))
+}
@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -82,6 +84,11 @@ type Mux interface {
 
 	// Unblock unblocks the Mux reader.
 	Unblock()
+
+	// SetCompression enables compression of payloads of at least the
+	// given size. It should only be enabled once the other end of the
+	// Mux is known to support compression. A zero size disables it.
+	SetCompression(size int)
 }
 
 // ConnID uniquely identifies a logical connection within a Mux.
@@ -131,6 +138,7 @@ type mux struct {
 	blockC    chan struct{}
 	closeOnce sync.Once
 	doneC     chan struct{}
+	compress  int64
 }
 
 const (
@@ -147,6 +155,8 @@ type conn struct {
 	id        ConnID
 	mux       *mux
 	readC     chan []byte
+	frame     []byte
+	pending   []byte
 	closeOnce sync.Once
 	doneC     chan error
 }
@@ -173,6 +183,10 @@ func newMux(trunk net.Conn, options ...Option) *mux {
 	return m
 }
 
+func (m *mux) SetCompression(size int) {
+	atomic.StoreInt64(&m.compress, int64(size))
+}
+
 func (m *mux) Trunk() net.Conn {
 	return m.trunk
 }
@@ -234,21 +248,40 @@ func (m *mux) Listen(id ConnID) (net.Listener, error) {
 }
 
 func (m *mux) write(id ConnID, buf []byte) (int, error) {
-	var hdr [headerLen]byte
-
 	if len(buf) > maxPayloadSize {
 		return 0, syscall.EMSGSIZE
 	}
 
-	binary.BigEndian.PutUint32(hdr[0:4], uint32(id))
-	binary.BigEndian.PutUint32(hdr[4:8], uint32(len(buf)))
+	payload, cnt := buf, uint32(len(buf))
+	if size := atomic.LoadInt64(&m.compress); size > 0 && int64(len(buf)) >= size {
+		if cbuf := compress(buf); cbuf != nil {
+			defer putBuffer(cbuf)
+			payload, cnt = cbuf, uint32(len(cbuf))|compressedFlag
+		}
+	}
+
+	// Assemble header and payload in a recycled buffer, writing the frame
+	// to the trunk at once. Payloads too large for recycled buffers are
+	// written separately instead of being copied.
+	var frame []byte
+	if size := headerLen + len(payload); size <= largeBufferSize {
+		frame = getBuffer(size)
+		copy(frame[headerLen:], payload)
+		payload = nil
+	} else {
+		frame = getBuffer(headerLen)
+	}
+	defer putBuffer(frame)
+
+	binary.BigEndian.PutUint32(frame[0:4], uint32(id))
+	binary.BigEndian.PutUint32(frame[4:8], cnt)
 
 	m.writeLock.Lock()
 	defer m.writeLock.Unlock()
 
-	n, err := m.trunk.Write(hdr[:])
+	n, err := m.trunk.Write(frame)
 	if err != nil {
-		err = fmt.Errorf("failed to write header to trunk: %w", err)
+		err = fmt.Errorf("failed to write frame to trunk: %w", err)
 		if n != 0 {
 			m.setError(err)
 			m.Close()
@@ -256,16 +289,19 @@ func (m *mux) write(id ConnID, buf []byte) (int, error) {
 		return 0, err
 	}
 
-	n, err = m.trunk.Write(buf)
-	if err != nil {
-		err = fmt.Errorf("failed to write payload to trunk: %w", err)
-		if n != 0 {
-			m.setError(err)
-			m.Close()
+	if payload != nil {
+		n, err = m.trunk.Write(payload)
+		if err != nil {
+			err = fmt.Errorf("failed to write payload to trunk: %w", err)
+			if n != 0 {
+				m.setError(err)
+				m.Close()
+			}
+			return n, err
 		}
 	}
 
-	return n, err
+	return len(buf), nil
 }
 
 func (m *mux) reader() {
@@ -306,7 +342,9 @@ func (m *mux) reader() {
 
 		cid = binary.BigEndian.Uint32(hdr[0:4])
 		cnt = binary.BigEndian.Uint32(hdr[4:8])
-		buf = make([]byte, int(cnt))
+		compressed := cnt&compressedFlag != 0
+		cnt &^= compressedFlag
+		buf = getBuffer(int(cnt))
 
 		_, err = io.ReadFull(m.trunk, buf)
 		if err != nil {
@@ -326,6 +364,17 @@ func (m *mux) reader() {
 			return
 		}
 
+		if compressed {
+			cbuf := buf
+			buf, err = decompress(cbuf)
+			putBuffer(cbuf)
+			if err != nil {
+				m.setError(fmt.Errorf("failed to decompress payload: %w", err))
+				m.Close()
+				return
+			}
+		}
+
 		m.connLock.RLock()
 		conn, ok := m.conns[ConnID(cid)]
 		m.connLock.RUnlock()
@@ -361,7 +410,9 @@ func (m *mux) error() error {
 // multiplexed connections
 //
 
-// Reads reads the next message from the multiplexed connection.
+// Reads reads the next message from the multiplexed connection. If the
+// buffer is too small for the message, the rest is returned by the next
+// Read.
 func (c *conn) Read(buf []byte) (int, error) {
 	var (
 		msg []byte
@@ -369,6 +420,13 @@ func (c *conn) Read(buf []byte) (int, error) {
 		ok  bool
 	)
 
+	if len(c.pending) > 0 {
+		n := copy(buf, c.pending)
+		c.pending = c.pending[n:]
+		c.recycle()
+		return n, nil
+	}
+
 	select {
 	case err, ok = <-c.doneC:
 		if !ok || err == nil {
@@ -379,13 +437,20 @@ func (c *conn) Read(buf []byte) (int, error) {
 		if !ok {
 			return 0, c.mux.error()
 		}
-		if cap(buf) < len(msg) {
-			return 0, syscall.ENOMEM
-		}
 	}
 
-	copy(buf, msg)
-	return len(msg), nil
+	n := copy(buf, msg)
+	c.frame, c.pending = msg, msg[n:]
+	c.recycle()
+	return n, nil
+}
+
+// recycle the buffer of the last received message once fully read.
+func (c *conn) recycle() {
+	if len(c.pending) == 0 && c.frame != nil {
+		putBuffer(c.frame)
+		c.frame, c.pending = nil, nil
+	}
 }
 
 // Write writes the given data to the multiplexed connection.
@@ -418,14 +483,27 @@ func (c *conn) close() error {
 	return nil
 }
 
-// LocalAddr is the unimplemented stub for the corresponding net.Conn function.
+// LocalAddr returns the address of the multiplexed connection.
 func (c *conn) LocalAddr() net.Addr {
-	return nil
+	return connAddr(c.id)
 }
 
-// RemoteAddr is the unimplemented stub for the corresponding net.Conn function.
+// RemoteAddr returns the address of the multiplexed connection.
 func (c *conn) RemoteAddr() net.Addr {
-	return nil
+	return connAddr(c.id)
+}
+
+// connAddr is the net.Addr of a multiplexed connection.
+type connAddr ConnID
+
+// Network returns the network name of a multiplexed connection.
+func (a connAddr) Network() string {
+	return "mux"
+}
+
+// String returns the string form of a multiplexed connection address.
+func (a connAddr) String() string {
+	return "mux:" + strconv.FormatUint(uint64(a), 10)
 }
 
 // SetDeadline is the unimplemented stub for the corresponding net.Conn function.
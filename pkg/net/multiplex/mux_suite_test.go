@@ -18,7 +18,9 @@ package multiplex_test
 
 import (
 	"fmt"
+	"io"
 	"net"
+	"strings"
 	"sync"
 	"testing"
 
@@ -103,6 +105,90 @@ var _ = Describe("Emulated Connection Setup, Open", func() {
 		Expect(string(buf)).To(Equal(msg))
 	})
 
+	It("Opened net.Conn should allow receiving with a short buffer", func() {
+		// Given
+		pConn, err = pMux.Open(connID)
+		Expect(err).To(BeNil())
+		Expect(pConn).ToNot(BeNil())
+
+		// When
+		lConn, err = lMux.Open(connID)
+		Expect(err).To(BeNil())
+		Expect(lConn).ToNot(BeNil())
+
+		msg := "this is a test message"
+		_, err = lConn.Write([]byte(msg))
+		Expect(err).To(BeNil())
+
+		// Then
+		buf := make([]byte, 10)
+		n, err := pConn.Read(buf)
+		Expect(err).To(BeNil())
+		Expect(string(buf[:n])).To(Equal(msg[:10]))
+
+		rest := make([]byte, len(msg))
+		n, err = pConn.Read(rest)
+		Expect(err).To(BeNil())
+		Expect(string(rest[:n])).To(Equal(msg[10:]))
+	})
+
+	It("Opened net.Conn should allow receiving compressed messages", func() {
+		// Given
+		lMux.SetCompression(1024)
+		pMux.SetCompression(1024)
+
+		pConn, err = pMux.Open(connID)
+		Expect(err).To(BeNil())
+		Expect(pConn).ToNot(BeNil())
+
+		// When
+		lConn, err = lMux.Open(connID)
+		Expect(err).To(BeNil())
+		Expect(lConn).ToNot(BeNil())
+
+		msg := strings.Repeat("this is a compressible test message", 1024)
+		n, err := lConn.Write([]byte(msg))
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(len(msg)))
+
+		// Then
+		buf := make([]byte, len(msg))
+		_, err = io.ReadFull(pConn, buf)
+		Expect(err).To(BeNil())
+		Expect(string(buf)).To(Equal(msg))
+	})
+
+	It("Opened net.Conn should allow receiving queued messages of varying size", func() {
+		// Given
+		lMux.SetCompression(64 * 1024)
+		pMux.SetCompression(64 * 1024)
+
+		pConn, err = pMux.Open(connID)
+		Expect(err).To(BeNil())
+		Expect(pConn).ToNot(BeNil())
+
+		// When
+		lConn, err = lMux.Open(connID)
+		Expect(err).To(BeNil())
+		Expect(lConn).ToNot(BeNil())
+
+		var msgs []string
+		for i, size := range []int{1, 100, 5000, 70000, 4096, 200000, 10} {
+			msg := strings.Repeat(fmt.Sprintf("%d", i%10), size)
+			_, err = lConn.Write([]byte(msg))
+			Expect(err).To(BeNil())
+			msgs = append(msgs, msg)
+		}
+
+		// Then
+		for _, msg := range msgs {
+			buf := make([]byte, len(msg))
+			_, err = io.ReadFull(pConn, buf)
+			Expect(err).To(BeNil())
+			Expect(string(buf)).To(Equal(msg))
+		}
+	})
+
 })
 
 var _ = Describe("Emulated Connection Setup, Close", func() {
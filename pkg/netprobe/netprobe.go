@@ -0,0 +1,300 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package netprobe probes which network enforcement mechanisms a node
+// supports, like traffic control qdiscs, eBPF or the CNI bandwidth plugin.
+// Network plugins can use it to check that their configuration can be
+// enforced on the node before they start, and fail fast if it cannot, or
+// to select a fallback backend which can enforce it.
+package netprobe
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Feature is a network enforcement mechanism a node may support.
+type Feature string
+
+const (
+	// FeatureTC is the tc binary for configuring traffic control.
+	FeatureTC Feature = "tc"
+	// FeatureHTB is the hierarchical token bucket qdisc.
+	FeatureHTB Feature = "htb"
+	// FeatureTBF is the token bucket filter qdisc.
+	FeatureTBF Feature = "tbf"
+	// FeatureFQCodel is the fair queuing controlled delay qdisc.
+	FeatureFQCodel Feature = "fq_codel"
+	// FeatureNetem is the network emulator qdisc.
+	FeatureNetem Feature = "netem"
+	// FeatureIngress is shaping ingress traffic, with the ingress qdisc,
+	// the mirred action and intermediate functional block devices.
+	FeatureIngress Feature = "ingress"
+	// FeatureEBPF is loading and pinning eBPF programs, with a mounted
+	// BPF filesystem.
+	FeatureEBPF Feature = "ebpf"
+	// FeatureCNIBandwidth is the CNI bandwidth plugin.
+	FeatureCNIBandwidth Feature = "cni-bandwidth"
+)
+
+var (
+	// ErrUnsupported is returned if a node does not support the features
+	// a network configuration needs.
+	ErrUnsupported = errors.New("netprobe: unsupported feature")
+
+	// featureModules are the kernel modules needed for features.
+	featureModules = map[Feature][]string{
+		FeatureHTB:     {"sch_htb"},
+		FeatureTBF:     {"sch_tbf"},
+		FeatureFQCodel: {"sch_fq_codel"},
+		FeatureNetem:   {"sch_netem"},
+		FeatureIngress: {"sch_ingress", "act_mirred", "ifb"},
+	}
+)
+
+const (
+	// DefaultCNIBinDir is the default directory of CNI plugin binaries.
+	DefaultCNIBinDir = "/opt/cni/bin"
+)
+
+// Prober probes a node for supported features.
+type Prober struct {
+	root       string
+	binDirs    []string
+	cniBinDirs []string
+}
+
+// Option to apply to a prober.
+type Option func(*Prober) error
+
+// WithRoot returns an option to probe the node with its root filesystem,
+// including /proc and /sys, mounted at the given directory. This is useful
+// for plugins running in a container with the host filesystem mounted.
+func WithRoot(dir string) Option {
+	return func(p *Prober) error {
+		p.root = dir
+		return nil
+	}
+}
+
+// WithCNIBinDirs returns an option to set the directories to look for CNI
+// plugin binaries in, instead of DefaultCNIBinDir.
+func WithCNIBinDirs(dirs ...string) Option {
+	return func(p *Prober) error {
+		p.cniBinDirs = dirs
+		return nil
+	}
+}
+
+// New creates a prober with the given options.
+func New(options ...Option) (*Prober, error) {
+	p := &Prober{
+		root:       "/",
+		binDirs:    []string{"/usr/sbin", "/sbin", "/usr/bin", "/bin"},
+		cniBinDirs: []string{DefaultCNIBinDir},
+	}
+	for _, o := range options {
+		if err := o(p); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Report is the result of probing a node. It tells for each feature if the
+// node supports it, and if not, why.
+type Report struct {
+	missing map[Feature]string
+}
+
+// Probe probes the node for all known features.
+func (p *Prober) Probe() *Report {
+	r := &Report{
+		missing: make(map[Feature]string),
+	}
+
+	if !p.hasExecutable(p.binDirs, "tc") {
+		r.missing[FeatureTC] = "tc binary not found"
+	}
+
+	modules, err := p.kernelModules()
+	for f, names := range featureModules {
+		if err != nil {
+			r.missing[f] = err.Error()
+			continue
+		}
+		for _, name := range names {
+			if _, ok := modules[name]; !ok {
+				r.missing[f] = "kernel module " + name + " not available"
+				break
+			}
+		}
+	}
+
+	if ok, err := p.hasBPFFS(); err != nil {
+		r.missing[FeatureEBPF] = err.Error()
+	} else if !ok {
+		r.missing[FeatureEBPF] = "BPF filesystem not mounted"
+	}
+
+	if !p.hasExecutable(p.cniBinDirs, "bandwidth") {
+		r.missing[FeatureCNIBandwidth] = "CNI bandwidth plugin not found"
+	}
+
+	return r
+}
+
+// Supports checks if the node supports the given feature.
+func (r *Report) Supports(f Feature) bool {
+	_, missing := r.missing[f]
+	return !missing
+}
+
+// Check checks if the node supports all of the given features. The error
+// returned for unsupported features tells why they are not supported.
+func (r *Report) Check(features ...Feature) error {
+	if reasons := r.reasons(features); len(reasons) > 0 {
+		return fmt.Errorf("%w: %s", ErrUnsupported, strings.Join(reasons, ", "))
+	}
+	return nil
+}
+
+// reasons returns why the given features are not supported, if they aren't.
+func (r *Report) reasons(features []Feature) []string {
+	var reasons []string
+	for _, f := range features {
+		if reason, ok := r.missing[f]; ok {
+			reasons = append(reasons, string(f)+": "+reason)
+		}
+	}
+	return reasons
+}
+
+// Missing returns the features the node does not support, sorted by name.
+func (r *Report) Missing() []Feature {
+	var features []Feature
+	for f := range r.missing {
+		features = append(features, f)
+	}
+	sort.Slice(features, func(i, j int) bool { return features[i] < features[j] })
+	return features
+}
+
+// Backend is a way to enforce network configuration, with the features
+// it needs.
+type Backend struct {
+	Name     string
+	Requires []Feature
+}
+
+// Select returns the first of the given backends in order of preference
+// the node supports.
+func (r *Report) Select(backends ...Backend) (*Backend, error) {
+	var reasons []string
+	for i, b := range backends {
+		missing := r.reasons(b.Requires)
+		if len(missing) == 0 {
+			return &backends[i], nil
+		}
+		reasons = append(reasons, b.Name+" ("+strings.Join(missing, ", ")+")")
+	}
+	return nil, fmt.Errorf("%w: no supported backend: %s", ErrUnsupported, strings.Join(reasons, ", "))
+}
+
+// path returns the given path under the root of the node.
+func (p *Prober) path(path string) string {
+	return filepath.Join(p.root, path)
+}
+
+// hasExecutable checks if an executable file exists in any of the dirs.
+func (p *Prober) hasExecutable(dirs []string, name string) bool {
+	for _, dir := range dirs {
+		info, err := os.Stat(p.path(filepath.Join(dir, name)))
+		if err == nil && info.Mode().IsRegular() && info.Mode()&0o111 != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// kernelModules returns the kernel modules which are loaded, built into
+// the kernel, or can be loaded on demand.
+func (p *Prober) kernelModules() (map[string]struct{}, error) {
+	modules := make(map[string]struct{})
+
+	entries, err := os.ReadDir(p.path("/sys/module"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list loaded kernel modules: %w", err)
+	}
+	for _, e := range entries {
+		modules[e.Name()] = struct{}{}
+	}
+
+	release, err := os.ReadFile(p.path("/proc/sys/kernel/osrelease"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kernel release: %w", err)
+	}
+	dir := filepath.Join("/lib/modules", strings.TrimSpace(string(release)))
+
+	for _, file := range []string{"modules.builtin", "modules.dep"} {
+		err := scanLines(p.path(filepath.Join(dir, file)), func(line string) {
+			path, _, _ := strings.Cut(line, ":")
+			name := filepath.Base(path)
+			if i := strings.Index(name, ".ko"); i > 0 {
+				modules[strings.ReplaceAll(name[:i], "-", "_")] = struct{}{}
+			}
+		})
+		if err != nil && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read kernel modules: %w", err)
+		}
+	}
+
+	return modules, nil
+}
+
+// hasBPFFS checks if a BPF filesystem is mounted.
+func (p *Prober) hasBPFFS() (bool, error) {
+	found := false
+	err := scanLines(p.path("/proc/mounts"), func(line string) {
+		if fields := strings.Fields(line); len(fields) > 2 && fields[2] == "bpf" {
+			found = true
+		}
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to read mounts: %w", err)
+	}
+	return found, nil
+}
+
+// scanLines calls fn for each line of a file.
+func scanLines(path string, fn func(string)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fn(s.Text())
+	}
+	return s.Err()
+}
@@ -0,0 +1,92 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package netprobe_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/nri/pkg/netprobe"
+
+	require "github.com/stretchr/testify/require"
+)
+
+func TestProbe(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "/usr/sbin/tc", 0o755, "")
+	writeFile(t, root, "/proc/sys/kernel/osrelease", 0o644, "6.1.0\n")
+	writeFile(t, root, "/proc/mounts", 0o644,
+		"sysfs /sys sysfs rw 0 0\nbpf /sys/fs/bpf bpf rw 0 0\n")
+	writeFile(t, root, "/lib/modules/6.1.0/modules.builtin", 0o644,
+		"kernel/net/sched/sch_fq_codel.ko\n")
+	writeFile(t, root, "/lib/modules/6.1.0/modules.dep", 0o644,
+		"kernel/net/sched/sch_tbf.ko.xz:\nkernel/net/sched/sch_netem.ko.xz:\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "/sys/module/sch_htb"), 0o755))
+
+	p, err := netprobe.New(netprobe.WithRoot(root))
+	require.NoError(t, err, "New()")
+	r := p.Probe()
+
+	for _, f := range []netprobe.Feature{
+		netprobe.FeatureTC,
+		netprobe.FeatureHTB,
+		netprobe.FeatureTBF,
+		netprobe.FeatureFQCodel,
+		netprobe.FeatureNetem,
+		netprobe.FeatureEBPF,
+	} {
+		require.True(t, r.Supports(f), "%s should be supported", f)
+	}
+	require.Equal(t, []netprobe.Feature{netprobe.FeatureCNIBandwidth, netprobe.FeatureIngress}, r.Missing())
+
+	require.NoError(t, r.Check(netprobe.FeatureTC, netprobe.FeatureHTB))
+	err = r.Check(netprobe.FeatureTC, netprobe.FeatureIngress)
+	require.ErrorIs(t, err, netprobe.ErrUnsupported)
+	require.Contains(t, err.Error(), "sch_ingress")
+}
+
+func TestSelect(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "/proc/sys/kernel/osrelease", 0o644, "6.1.0\n")
+	writeFile(t, root, "/proc/mounts", 0o644, "")
+	writeFile(t, root, "/cni/bandwidth", 0o755, "")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "/sys/module"), 0o755))
+
+	var (
+		tc   = netprobe.Backend{Name: "tc", Requires: []netprobe.Feature{netprobe.FeatureTC, netprobe.FeatureHTB}}
+		cni  = netprobe.Backend{Name: "cni", Requires: []netprobe.Feature{netprobe.FeatureCNIBandwidth}}
+		ebpf = netprobe.Backend{Name: "ebpf", Requires: []netprobe.Feature{netprobe.FeatureEBPF}}
+	)
+
+	p, err := netprobe.New(netprobe.WithRoot(root), netprobe.WithCNIBinDirs("/cni"))
+	require.NoError(t, err, "New()")
+	r := p.Probe()
+
+	b, err := r.Select(tc, cni, ebpf)
+	require.NoError(t, err, "Select()")
+	require.Equal(t, "cni", b.Name)
+
+	_, err = r.Select(tc, ebpf)
+	require.ErrorIs(t, err, netprobe.ErrUnsupported)
+}
+
+func writeFile(t *testing.T, root, path string, mode os.FileMode, data string) {
+	path = filepath.Join(root, path)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(data), mode))
+}
@@ -17,6 +17,7 @@
 package generate
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -41,6 +42,11 @@ type Generator struct {
 	resolveBlockIO    func(string) (*rspec.LinuxBlockIO, error)
 	resolveRdt        func(string) (*rspec.LinuxIntelRdt, error)
 	checkResources    func(*rspec.LinuxResources) error
+	injectCDIDevices  func(*rspec.Spec, []string) error
+	resolveSeccomp    func(*nri.SecurityProfile) (*rspec.LinuxSeccomp, error)
+	resolveAppArmor   func(*nri.SecurityProfile) (string, error)
+	resolvePodNetNS   func(string) (string, error)
+	hookTemplateData  *nri.HookTemplateData
 }
 
 // SpecGenerator returns a wrapped OCI Spec Generator.
@@ -91,6 +97,47 @@ func WithResourceChecker(fn func(*rspec.LinuxResources) error) GeneratorOption {
 	}
 }
 
+// WithCDIDeviceInjector specifies a function for injecting CDI devices by name.
+func WithCDIDeviceInjector(fn func(*rspec.Spec, []string) error) GeneratorOption {
+	return func(g *Generator) {
+		g.injectCDIDevices = fn
+	}
+}
+
+// WithSeccompProfileResolver specifies a function for resolving seccomp
+// profiles. The function should validate the profile and return the
+// corresponding OCI seccomp configuration, or nil for unconfined.
+func WithSeccompProfileResolver(fn func(*nri.SecurityProfile) (*rspec.LinuxSeccomp, error)) GeneratorOption {
+	return func(g *Generator) {
+		g.resolveSeccomp = fn
+	}
+}
+
+// WithAppArmorProfileResolver specifies a function for resolving AppArmor
+// profiles. The function should validate the profile and return the name
+// of the corresponding AppArmor profile.
+func WithAppArmorProfileResolver(fn func(*nri.SecurityProfile) (string, error)) GeneratorOption {
+	return func(g *Generator) {
+		g.resolveAppArmor = fn
+	}
+}
+
+// WithPodNetworkNamespaceResolver specifies a function for resolving the
+// path of the network namespace of a pod sandbox by the pod's UID.
+func WithPodNetworkNamespaceResolver(fn func(string) (string, error)) GeneratorOption {
+	return func(g *Generator) {
+		g.resolvePodNetNS = fn
+	}
+}
+
+// WithHookTemplateData specifies the data for expanding templated hooks.
+// Adjustments with templated hooks fail without template data.
+func WithHookTemplateData(data *nri.HookTemplateData) GeneratorOption {
+	return func(g *Generator) {
+		g.hookTemplateData = data
+	}
+}
+
 // Adjust adjusts all aspects of the OCI Spec that NRI knows/cares about.
 func (g *Generator) Adjust(adjust *nri.ContainerAdjustment) error {
 	if adjust == nil {
@@ -101,9 +148,25 @@ func (g *Generator) Adjust(adjust *nri.ContainerAdjustment) error {
 		return fmt.Errorf("failed to adjust annotations in OCI Spec: %w", err)
 	}
 	g.AdjustEnv(adjust.GetEnv())
-	g.AdjustHooks(adjust.GetHooks())
+	if err := g.AdjustHooks(adjust.GetHooks()); err != nil {
+		return fmt.Errorf("failed to adjust hooks in OCI Spec: %w", err)
+	}
 	g.AdjustDevices(adjust.GetLinux().GetDevices())
 	g.AdjustCgroupsPath(adjust.GetLinux().GetCgroupsPath())
+	g.AdjustSysctls(adjust.GetLinux().GetSysctl())
+	g.AdjustIDMappings(adjust.GetLinux().GetUidMappings(), adjust.GetLinux().GetGidMappings())
+	g.AdjustReadonlyRootfs(adjust.GetReadonlyRootfs().Get())
+	g.AdjustMaskedPaths(adjust.GetLinux().GetMaskedPaths())
+	g.AdjustReadonlyPaths(adjust.GetLinux().GetReadonlyPaths())
+	if err := g.AdjustCapabilities(adjust.GetLinux().GetCapabilities()); err != nil {
+		return err
+	}
+	if err := g.AdjustSeccompProfile(adjust.GetLinux().GetSeccompProfile()); err != nil {
+		return err
+	}
+	if err := g.AdjustAppArmorProfile(adjust.GetLinux().GetApparmorProfile()); err != nil {
+		return err
+	}
 
 	resources := adjust.GetLinux().GetResources()
 	if err := g.AdjustResources(resources); err != nil {
@@ -112,17 +175,71 @@ func (g *Generator) Adjust(adjust *nri.ContainerAdjustment) error {
 	if err := g.AdjustBlockIOClass(resources.GetBlockioClass().Get()); err != nil {
 		return err
 	}
+	g.AdjustBlockIO(resources.GetBlockio())
 	if err := g.AdjustRdtClass(resources.GetRdtClass().Get()); err != nil {
 		return err
 	}
+	g.AdjustRdtClosID(resources.GetRdtClosId().Get())
 
 	if err := g.AdjustMounts(adjust.GetMounts()); err != nil {
 		return err
 	}
+	if err := g.AdjustCDIDevices(adjust.GetCDIDevices()); err != nil {
+		return err
+	}
+	g.AdjustRlimits(adjust.GetRlimits())
+
+	return nil
+}
+
+// AdjustPodSandbox adjusts the OCI Spec of a pod sandbox.
+func (g *Generator) AdjustPodSandbox(adjust *nri.PodSandboxAdjustment) error {
+	if adjust == nil {
+		return nil
+	}
+
+	if err := g.AdjustAnnotations(adjust.GetAnnotations()); err != nil {
+		return fmt.Errorf("failed to adjust pod annotations in OCI Spec: %w", err)
+	}
+	g.AdjustSysctls(adjust.GetLinux().GetSysctl())
+	g.AdjustIDMappings(adjust.GetLinux().GetUidMappings(), adjust.GetLinux().GetGidMappings())
+	if err := g.AdjustNetworkNamespace(adjust.GetLinux().GetNetworkNamespace()); err != nil {
+		return err
+	}
+	if hostname := adjust.GetHostname(); hostname != "" {
+		g.SetHostname(hostname)
+	}
+	if domainname := adjust.GetDomainname(); domainname != "" {
+		g.SetDomainName(domainname)
+	}
 
 	return nil
 }
 
+// AdjustNetworkNamespace adjusts the OCI Spec to join an existing network
+// namespace, given either by path or by the UID of the pod to share it with.
+func (g *Generator) AdjustNetworkNamespace(join *nri.LinuxNamespaceJoin) error {
+	if join == nil {
+		return nil
+	}
+
+	path := join.GetPath()
+	if uid := join.GetPodUid(); uid != "" {
+		if g.resolvePodNetNS == nil {
+			return errors.New("failed to adjust network namespace: no pod network namespace resolver")
+		}
+		var err error
+		if path, err = g.resolvePodNetNS(uid); err != nil {
+			return fmt.Errorf("failed to adjust network namespace in OCI Spec: %w", err)
+		}
+	}
+
+	if err := g.AddOrReplaceLinuxNamespace(string(rspec.NetworkNamespace), path); err != nil {
+		return fmt.Errorf("failed to adjust network namespace in OCI Spec: %w", err)
+	}
+	return nil
+}
+
 // AdjustEnv adjusts the environment of the OCI Spec.
 func (g *Generator) AdjustEnv(env []*nri.KeyValue) {
 	mod := map[string]*nri.KeyValue{}
@@ -181,28 +298,41 @@ func (g *Generator) AdjustAnnotations(annotations map[string]string) error {
 	return nil
 }
 
-// AdjustHooks adjusts the OCI hooks in the OCI Spec.
-func (g *Generator) AdjustHooks(hooks *nri.Hooks) {
+// AdjustHooks adjusts the OCI hooks in the OCI Spec. Templated hooks are
+// expanded using the data set with WithHookTemplateData.
+func (g *Generator) AdjustHooks(hooks *nri.Hooks) error {
 	if hooks == nil {
-		return
-	}
-	for _, h := range hooks.Prestart {
-		g.AddPreStartHook(h.ToOCI())
-	}
-	for _, h := range hooks.Poststart {
-		g.AddPostStartHook(h.ToOCI())
-	}
-	for _, h := range hooks.Poststop {
-		g.AddPostStopHook(h.ToOCI())
-	}
-	for _, h := range hooks.CreateRuntime {
-		g.AddCreateRuntimeHook(h.ToOCI())
+		return nil
 	}
-	for _, h := range hooks.CreateContainer {
-		g.AddCreateContainerHook(h.ToOCI())
+	for _, adjust := range []struct {
+		hooks []*nri.Hook
+		add   func(rspec.Hook)
+	}{
+		{hooks.Prestart, g.AddPreStartHook},
+		{hooks.Poststart, g.AddPostStartHook},
+		{hooks.Poststop, g.AddPostStopHook},
+		{hooks.CreateRuntime, g.AddCreateRuntimeHook},
+		{hooks.CreateContainer, g.AddCreateContainerHook},
+		{hooks.StartContainer, g.AddStartContainerHook},
+	} {
+		for _, h := range adjust.hooks {
+			if h.IsTemplated() {
+				x, err := h.Expand(g.hookTemplateData)
+				if err != nil {
+					return err
+				}
+				h = x
+			}
+			adjust.add(h.ToOCI())
+		}
 	}
-	for _, h := range hooks.StartContainer {
-		g.AddStartContainerHook(h.ToOCI())
+	return nil
+}
+
+// AdjustRlimits adjusts the POSIX rlimits in the OCI Spec.
+func (g *Generator) AdjustRlimits(rlimits []*nri.POSIXRlimit) {
+	for _, r := range rlimits {
+		g.AddProcessRlimits(r.Type, r.Hard, r.Soft)
 	}
 }
 
@@ -249,6 +379,10 @@ func (g *Generator) AdjustResources(r *nri.LinuxResources) error {
 	for k, v := range r.Unified {
 		g.AddLinuxResourcesUnified(k, v)
 	}
+	for _, d := range r.Devices {
+		o := d.ToOCI()
+		g.AddLinuxResourcesDevice(o.Allow, o.Type, o.Major, o.Minor, o.Access)
+	}
 
 	if g.checkResources != nil {
 		if err := g.checkResources(g.Config.Linux.Resources); err != nil {
@@ -279,6 +413,33 @@ func (g *Generator) AdjustBlockIOClass(blockIOClass *string) error {
 	return nil
 }
 
+// AdjustBlockIO adjusts the block I/O weights and throttling limits in
+// the OCI Spec. These are applied on top of any block I/O class.
+func (g *Generator) AdjustBlockIO(blkio *nri.LinuxBlockIO) {
+	if blkio == nil {
+		return
+	}
+
+	if w := blkio.GetWeight(); w != nil {
+		g.SetLinuxResourcesBlockIOWeight(uint16(w.GetValue()))
+	}
+	for _, d := range blkio.WeightDevice {
+		g.AddLinuxResourcesBlockIOWeightDevice(d.Major, d.Minor, uint16(d.Weight))
+	}
+	for _, d := range blkio.ThrottleReadBpsDevice {
+		g.AddLinuxResourcesBlockIOThrottleReadBpsDevice(d.Major, d.Minor, d.Rate)
+	}
+	for _, d := range blkio.ThrottleWriteBpsDevice {
+		g.AddLinuxResourcesBlockIOThrottleWriteBpsDevice(d.Major, d.Minor, d.Rate)
+	}
+	for _, d := range blkio.ThrottleReadIopsDevice {
+		g.AddLinuxResourcesBlockIOThrottleReadIOPSDevice(d.Major, d.Minor, d.Rate)
+	}
+	for _, d := range blkio.ThrottleWriteIopsDevice {
+		g.AddLinuxResourcesBlockIOThrottleWriteIOPSDevice(d.Major, d.Minor, d.Rate)
+	}
+}
+
 // AdjustRdtClass adjusts the RDT class in the OCI Spec.
 func (g *Generator) AdjustRdtClass(rdtClass *string) error {
 	if rdtClass == nil || g.resolveRdt == nil {
@@ -299,6 +460,21 @@ func (g *Generator) AdjustRdtClass(rdtClass *string) error {
 	return nil
 }
 
+// AdjustRdtClosID adjusts the RDT CLOS ID in the OCI Spec. This puts the
+// container into an existing resctrl group, overriding any RDT class.
+func (g *Generator) AdjustRdtClosID(closID *string) {
+	if closID == nil {
+		return
+	}
+
+	if *closID == "" {
+		g.ClearLinuxIntelRdt()
+		return
+	}
+
+	g.SetLinuxIntelRdtClosID(*closID)
+}
+
 // AdjustCgroupsPath adjusts the cgroup pseudofs path in the OCI Spec.
 func (g *Generator) AdjustCgroupsPath(path string) {
 	if path != "" {
@@ -306,6 +482,150 @@ func (g *Generator) AdjustCgroupsPath(path string) {
 	}
 }
 
+// AdjustSysctls adjusts the (namespaced) sysctls in the OCI Spec.
+func (g *Generator) AdjustSysctls(sysctls map[string]string) {
+	for k := range sysctls {
+		if key, marked := nri.IsMarkedForRemoval(k); marked {
+			g.RemoveLinuxSysctl(key)
+		}
+	}
+	for k, v := range sysctls {
+		if _, marked := nri.IsMarkedForRemoval(k); !marked {
+			g.AddLinuxSysctl(k, v)
+		}
+	}
+}
+
+// AdjustIDMappings adjusts the user namespace UID and GID mappings in the
+// OCI Spec. If the Spec has no user namespace yet, a new one is created.
+func (g *Generator) AdjustIDMappings(uids, gids []*nri.LinuxIDMapping) {
+	if len(uids) == 0 && len(gids) == 0 {
+		return
+	}
+
+	g.ClearLinuxUIDMappings()
+	for _, m := range uids {
+		g.AddLinuxUIDMapping(m.HostId, m.ContainerId, m.Size)
+	}
+	g.ClearLinuxGIDMappings()
+	for _, m := range gids {
+		g.AddLinuxGIDMapping(m.HostId, m.ContainerId, m.Size)
+	}
+
+	for _, ns := range g.Config.Linux.Namespaces {
+		if ns.Type == rspec.UserNamespace {
+			return
+		}
+	}
+	g.Config.Linux.Namespaces = append(g.Config.Linux.Namespaces,
+		rspec.LinuxNamespace{Type: rspec.UserNamespace})
+}
+
+// AdjustReadonlyRootfs adjusts whether the root filesystem is read-only in
+// the OCI Spec.
+func (g *Generator) AdjustReadonlyRootfs(readonly *bool) {
+	if readonly != nil {
+		g.SetRootReadonly(*readonly)
+	}
+}
+
+// AdjustMaskedPaths adjusts the masked paths in the OCI Spec.
+func (g *Generator) AdjustMaskedPaths(paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+	g.initConfigLinux()
+	g.Config.Linux.MaskedPaths = adjustPaths(g.Config.Linux.MaskedPaths, paths)
+}
+
+// AdjustReadonlyPaths adjusts the read-only paths in the OCI Spec.
+func (g *Generator) AdjustReadonlyPaths(paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+	g.initConfigLinux()
+	g.Config.Linux.ReadonlyPaths = adjustPaths(g.Config.Linux.ReadonlyPaths, paths)
+}
+
+// adjustPaths adds paths to or removes paths marked for removal from the
+// given paths, returning the adjusted paths.
+func adjustPaths(paths, adjust []string) []string {
+	for _, p := range adjust {
+		path, marked := nri.IsMarkedForRemoval(p)
+		kept := make([]string, 0, len(paths)+1)
+		for _, o := range paths {
+			if o != path {
+				kept = append(kept, o)
+			}
+		}
+		if !marked {
+			kept = append(kept, path)
+		}
+		paths = kept
+	}
+	return paths
+}
+
+// AdjustCapabilities adjusts the capabilities in the OCI Spec. Added
+// capabilities are put in the bounding, effective and permitted sets.
+// Dropped capabilities are removed from all sets.
+func (g *Generator) AdjustCapabilities(caps *nri.LinuxCapabilities) error {
+	for _, c := range caps.GetAdd() {
+		if err := g.AddProcessCapabilityBounding(c); err != nil {
+			return fmt.Errorf("failed to adjust capabilities in OCI Spec: %w", err)
+		}
+		if err := g.AddProcessCapabilityEffective(c); err != nil {
+			return fmt.Errorf("failed to adjust capabilities in OCI Spec: %w", err)
+		}
+		if err := g.AddProcessCapabilityPermitted(c); err != nil {
+			return fmt.Errorf("failed to adjust capabilities in OCI Spec: %w", err)
+		}
+	}
+	for _, c := range caps.GetDrop() {
+		if err := g.DropProcessCapability(c); err != nil {
+			return fmt.Errorf("failed to adjust capabilities in OCI Spec: %w", err)
+		}
+	}
+	return nil
+}
+
+// AdjustSeccompProfile adjusts the seccomp profile in the OCI Spec.
+func (g *Generator) AdjustSeccompProfile(profile *nri.SecurityProfile) error {
+	if profile == nil {
+		return nil
+	}
+	if g.resolveSeccomp == nil {
+		return errors.New("failed to adjust seccomp profile: no seccomp profile resolver")
+	}
+
+	seccomp, err := g.resolveSeccomp(profile)
+	if err != nil {
+		return fmt.Errorf("failed to adjust seccomp profile in OCI Spec: %w", err)
+	}
+
+	g.initConfigLinux()
+	g.Config.Linux.Seccomp = seccomp
+	return nil
+}
+
+// AdjustAppArmorProfile adjusts the AppArmor profile in the OCI Spec.
+func (g *Generator) AdjustAppArmorProfile(profile *nri.SecurityProfile) error {
+	if profile == nil {
+		return nil
+	}
+	if g.resolveAppArmor == nil {
+		return errors.New("failed to adjust AppArmor profile: no AppArmor profile resolver")
+	}
+
+	apparmor, err := g.resolveAppArmor(profile)
+	if err != nil {
+		return fmt.Errorf("failed to adjust AppArmor profile in OCI Spec: %w", err)
+	}
+
+	g.SetProcessApparmorProfile(apparmor)
+	return nil
+}
+
 // AdjustDevices adjusts the (Linux) devices in the OCI Spec.
 func (g *Generator) AdjustDevices(devices []*nri.LinuxDevice) {
 	for _, d := range devices {
@@ -320,6 +640,29 @@ func (g *Generator) AdjustDevices(devices []*nri.LinuxDevice) {
 	}
 }
 
+// AdjustCDIDevices injects the requested CDI devices into the OCI Spec.
+func (g *Generator) AdjustCDIDevices(devices []*nri.CDIDevice) error {
+	if len(devices) == 0 {
+		return nil
+	}
+	if g.injectCDIDevices == nil {
+		return errors.New("failed to inject CDI devices: no CDI device injector")
+	}
+
+	names := make([]string, 0, len(devices))
+	for _, d := range devices {
+		names = append(names, d.Name)
+	}
+
+	g.initConfig()
+	if err := g.injectCDIDevices(g.Config, names); err != nil {
+		return fmt.Errorf("failed to inject CDI devices %s: %w",
+			strings.Join(names, ","), err)
+	}
+
+	return nil
+}
+
 // AdjustMounts adjusts the mounts in the OCI Spec.
 func (g *Generator) AdjustMounts(mounts []*nri.Mount) error {
 	if len(mounts) == 0 {
@@ -337,15 +680,15 @@ func (g *Generator) AdjustMounts(mounts []*nri.Mount) error {
 
 		mnt := m.ToOCI(&propagation)
 		switch propagation {
-		case "rprivate":
-		case "rshared":
+		case "rprivate", "private":
+		case "rshared", "shared":
 			if err := ensurePropagation(mnt.Source, "rshared"); err != nil {
 				return fmt.Errorf("failed to adjust mounts in OCI Spec: %w", err)
 			}
 			if err := g.SetLinuxRootPropagation("rshared"); err != nil {
 				return fmt.Errorf("failed to adjust rootfs propagation in OCI Spec: %w", err)
 			}
-		case "rslave":
+		case "rslave", "slave":
 			if err := ensurePropagation(mnt.Source, "rshared", "rslave"); err != nil {
 				return fmt.Errorf("failed to adjust mounts in OCI Spec: %w", err)
 			}
@@ -213,6 +213,55 @@ var _ = Describe("Adjustment", func() {
 		})
 	})
 
+	When("has block I/O parameters", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{
+					Linux: &api.LinuxContainerAdjustment{
+						Resources: &api.LinuxResources{
+							Blockio: &api.LinuxBlockIO{
+								Weight: api.UInt32(uint32(200)),
+								ThrottleReadBpsDevice: []*api.LinuxThrottleDevice{
+									{Major: 8, Minor: 0, Rate: 1048576},
+								},
+							},
+						},
+					},
+				}
+			)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec).To(Equal(makeSpec(withBlockIOWeight(200), withBlockIOReadBps(8, 0, 1048576))))
+		})
+	})
+
+	When("has RDT CLOS ID", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{
+					Linux: &api.LinuxContainerAdjustment{
+						Resources: &api.LinuxResources{
+							RdtClosId: api.String("guaranteed"),
+						},
+					},
+				}
+			)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec).To(Equal(makeSpec(withRdtClosID("guaranteed"))))
+		})
+	})
+
 	When("has mounts", func() {
 		It("it sorts the Spec mount slice", func() {
 			var (
@@ -266,6 +315,533 @@ var _ = Describe("Adjustment", func() {
 			)))
 		})
 	})
+
+	When("has idmapped mounts", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec = makeSpec()
+				mnt  = &api.Mount{
+					Destination: "/data",
+					Type:        "bind",
+					Source:      "/host/data",
+					Options:     []string{"rbind", "rshared"},
+					UidMappings: []*api.LinuxIDMapping{
+						{ContainerId: 0, HostId: 100000, Size: 65536},
+					},
+					GidMappings: []*api.LinuxIDMapping{
+						{ContainerId: 0, HostId: 100000, Size: 65536},
+					},
+				}
+				adjust = &api.ContainerAdjustment{}
+			)
+
+			mnt.SetPropagation("rprivate")
+			adjust.AddMount(mnt)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec).To(Equal(makeSpec(
+				withMounts([]rspec.Mount{
+					{
+						Destination: "/data",
+						Type:        "bind",
+						Source:      "/host/data",
+						Options:     []string{"rbind", "rprivate"},
+						UIDMappings: []rspec.LinuxIDMapping{
+							{ContainerID: 0, HostID: 100000, Size: 65536},
+						},
+						GIDMappings: []rspec.LinuxIDMapping{
+							{ContainerID: 0, HostID: 100000, Size: 65536},
+						},
+					},
+				}),
+			)))
+		})
+	})
+
+	When("has mounts with non-recursive propagation", func() {
+		It("keeps private mounts as such", func() {
+			var (
+				spec = makeSpec()
+				mnt  = &api.Mount{
+					Destination: "/data",
+					Type:        "bind",
+					Source:      "/host/data",
+					Options:     []string{"rbind", "rshared"},
+				}
+				adjust = &api.ContainerAdjustment{}
+			)
+
+			mnt.SetPropagation("private")
+			adjust.AddMount(mnt)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec.Mounts).To(HaveLen(1))
+			Expect(spec.Mounts[0].Options).To(Equal([]string{"rbind", "private"}))
+			Expect(spec.Linux.RootfsPropagation).To(BeEmpty())
+		})
+
+		It("handles shared and slave mounts like recursive ones", func() {
+			for _, p := range []struct {
+				mode   string
+				rootfs []string
+			}{
+				{"shared", []string{"rshared"}},
+				{"slave", []string{"rshared", "rslave"}},
+			} {
+				var (
+					spec = makeSpec()
+					mnt  = &api.Mount{
+						Destination: "/host",
+						Type:        "bind",
+						Source:      "/",
+						Options:     []string{"rbind"},
+					}
+					adjust = &api.ContainerAdjustment{}
+				)
+
+				mnt.SetPropagation(p.mode)
+				adjust.AddMount(mnt)
+
+				rg := &rgen.Generator{Config: spec}
+				xg := xgen.SpecGenerator(rg)
+
+				Expect(xg).ToNot(BeNil())
+
+				// Whether this succeeds depends on the propagation of the
+				// host root, but it must be checked either way.
+				if err := xg.Adjust(adjust); err != nil {
+					Expect(err.Error()).To(ContainSubstring("mount propagation"))
+					continue
+				}
+				Expect(spec.Mounts[0].Options).To(Equal([]string{"rbind", p.mode}))
+				Expect(p.rootfs).To(ContainElement(spec.Linux.RootfsPropagation))
+			}
+		})
+	})
+
+	When("has CDI devices", func() {
+		It("injects them using the CDI device injector", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{
+					CDIDevices: []*api.CDIDevice{
+						{Name: "vendor.com/net=vf0"},
+						{Name: "vendor.com/rdma=dev1"},
+					},
+				}
+				injected []string
+				injector = func(s *rspec.Spec, devices []string) error {
+					injected = append(injected, devices...)
+					return nil
+				}
+			)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg, xgen.WithCDIDeviceInjector(injector))
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(injected).To(Equal([]string{"vendor.com/net=vf0", "vendor.com/rdma=dev1"}))
+		})
+
+		It("fails without a CDI device injector", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{
+					CDIDevices: []*api.CDIDevice{
+						{Name: "vendor.com/net=vf0"},
+					},
+				}
+			)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).ToNot(Succeed())
+		})
+	})
+
+	When("has sysctls", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec(withSysctl("net.ipv4.ip_forward", "1"))
+				adjust = &api.ContainerAdjustment{
+					Linux: &api.LinuxContainerAdjustment{
+						Sysctl: map[string]string{
+							"-net.ipv4.ip_forward": "",
+							"net.core.somaxconn":   "1024",
+						},
+					},
+				}
+			)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec).To(Equal(makeSpec(withSysctl("net.core.somaxconn", "1024"))))
+		})
+	})
+
+	When("has pod adjustments", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.PodSandboxAdjustment{
+					Annotations: map[string]string{
+						"example.com/ipam-pool": "pool-a",
+					},
+					Hostname:   "pod0",
+					Domainname: "example.com",
+					Linux: &api.LinuxPodSandboxAdjustment{
+						Sysctl: map[string]string{
+							"net.core.somaxconn": "1024",
+						},
+					},
+				}
+			)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.AdjustPodSandbox(adjust)).To(Succeed())
+			Expect(spec).To(Equal(makeSpec(
+				withAnnotation("example.com/ipam-pool", "pool-a"),
+				withSysctl("net.core.somaxconn", "1024"),
+				withHostname("pod0", "example.com"),
+			)))
+		})
+	})
+
+	When("has a network namespace to join", func() {
+		It("adjusts Spec correctly for a named namespace", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.PodSandboxAdjustment{}
+			)
+
+			adjust.JoinNetworkNamespace("mesh0")
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.AdjustPodSandbox(adjust)).To(Succeed())
+			Expect(spec).To(Equal(makeSpec(withNetworkNamespace("/var/run/netns/mesh0"))))
+		})
+
+		It("adjusts Spec correctly using the pod network namespace resolver", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.PodSandboxAdjustment{}
+			)
+
+			adjust.ShareNetworkNamespace("uid1")
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg,
+				xgen.WithPodNetworkNamespaceResolver(func(uid string) (string, error) {
+					return "/var/run/netns/cni-" + uid, nil
+				}),
+			)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.AdjustPodSandbox(adjust)).To(Succeed())
+			Expect(spec).To(Equal(makeSpec(withNetworkNamespace("/var/run/netns/cni-uid1"))))
+		})
+
+		It("fails without a pod network namespace resolver", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.PodSandboxAdjustment{}
+			)
+
+			adjust.ShareNetworkNamespace("uid1")
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.AdjustPodSandbox(adjust)).ToNot(Succeed())
+		})
+	})
+
+	When("has user namespace ID mappings", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{}
+				uids   = []*api.LinuxIDMapping{{ContainerId: 0, HostId: 100000, Size: 65536}}
+				gids   = []*api.LinuxIDMapping{{ContainerId: 0, HostId: 200000, Size: 65536}}
+			)
+
+			adjust.SetLinuxIDMappings(uids, gids)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec).To(Equal(makeSpec(withIDMappings(100000, 200000, 65536))))
+		})
+
+		It("keeps an existing user namespace", func() {
+			var (
+				spec   = makeSpec(withUserNamespace("/proc/1/ns/user"))
+				adjust = &api.PodSandboxAdjustment{}
+				uids   = []*api.LinuxIDMapping{{ContainerId: 0, HostId: 100000, Size: 65536}}
+				gids   = []*api.LinuxIDMapping{{ContainerId: 0, HostId: 200000, Size: 65536}}
+			)
+
+			adjust.SetLinuxIDMappings(uids, gids)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.AdjustPodSandbox(adjust)).To(Succeed())
+			Expect(spec.Linux.Namespaces).To(Equal(
+				makeSpec(withUserNamespace("/proc/1/ns/user")).Linux.Namespaces,
+			))
+			Expect(spec.Linux.UIDMappings).To(Equal([]rspec.LinuxIDMapping{
+				{ContainerID: 0, HostID: 100000, Size: 65536},
+			}))
+			Expect(spec.Linux.GIDMappings).To(Equal([]rspec.LinuxIDMapping{
+				{ContainerID: 0, HostID: 200000, Size: 65536},
+			}))
+		})
+	})
+
+	When("has read-only rootfs, masked and read-only paths", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{}
+			)
+
+			spec.Linux.MaskedPaths = []string{"/proc/kcore", "/proc/acpi"}
+			spec.Linux.ReadonlyPaths = []string{"/proc/sys"}
+
+			adjust.SetReadonlyRootfs(true)
+			adjust.AddLinuxMaskedPath("/proc/sched_debug")
+			adjust.RemoveLinuxMaskedPath("/proc/acpi")
+			adjust.AddLinuxReadonlyPath("/proc/sysrq-trigger")
+			adjust.AddLinuxReadonlyPath("/proc/sys")
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec.Root.Readonly).To(BeTrue())
+			Expect(spec.Linux.MaskedPaths).To(Equal([]string{"/proc/kcore", "/proc/sched_debug"}))
+			Expect(spec.Linux.ReadonlyPaths).To(Equal([]string{"/proc/sysrq-trigger", "/proc/sys"}))
+		})
+	})
+
+	When("has device cgroup rules", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{}
+				major  = int64(195)
+			)
+
+			adjust.AddLinuxDeviceCgroupRule(true, "c", &major, nil, "rw")
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec.Linux.Devices).To(BeEmpty())
+			Expect(spec.Linux.Resources.Devices).To(Equal([]rspec.LinuxDeviceCgroup{
+				{
+					Allow:  true,
+					Type:   "c",
+					Major:  &major,
+					Access: "rw",
+				},
+			}))
+		})
+	})
+
+	When("has capabilities", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{}
+			)
+
+			spec.Process.Capabilities = &rspec.LinuxCapabilities{
+				Bounding:  []string{"CAP_CHOWN", "CAP_SYS_ADMIN"},
+				Effective: []string{"CAP_CHOWN", "CAP_SYS_ADMIN"},
+				Permitted: []string{"CAP_CHOWN", "CAP_SYS_ADMIN"},
+			}
+
+			adjust.AddLinuxCapability("CAP_NET_ADMIN")
+			adjust.DropLinuxCapability("CAP_SYS_ADMIN")
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec.Process.Capabilities.Bounding).To(Equal([]string{"CAP_CHOWN", "CAP_NET_ADMIN"}))
+			Expect(spec.Process.Capabilities.Effective).To(Equal([]string{"CAP_CHOWN", "CAP_NET_ADMIN"}))
+			Expect(spec.Process.Capabilities.Permitted).To(Equal([]string{"CAP_CHOWN", "CAP_NET_ADMIN"}))
+		})
+	})
+
+	When("has rlimits", func() {
+		It("adjusts Spec correctly", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{}
+			)
+
+			spec.Process = &rspec.Process{
+				Rlimits: []rspec.POSIXRlimit{
+					{Type: "RLIMIT_NOFILE", Hard: 1024, Soft: 1024},
+				},
+			}
+
+			adjust.AddRlimit("RLIMIT_NOFILE", 65536, 4096)
+			adjust.AddRlimit("RLIMIT_NPROC", 512, 512)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec.Process.Rlimits).To(Equal([]rspec.POSIXRlimit{
+				{Type: "RLIMIT_NOFILE", Hard: 65536, Soft: 4096},
+				{Type: "RLIMIT_NPROC", Hard: 512, Soft: 512},
+			}))
+		})
+	})
+
+	When("has templated hooks", func() {
+		var (
+			adjust = func() *api.ContainerAdjustment {
+				a := &api.ContainerAdjustment{}
+				a.AddHooks(&api.Hooks{
+					CreateRuntime: []*api.Hook{
+						{
+							Path: "/usr/libexec/net-hook",
+							Args: []string{"net-hook", "--netns", "{{.NetNSPath}}", "--ips", "{{join .IPs \",\"}}"},
+							Env:  []string{"POD={{.PodNamespace}}/{{.PodName}}", "LITERAL=${HOME}"},
+						},
+					},
+				})
+				return a
+			}
+		)
+
+		It("adjusts Spec correctly using the template data", func() {
+			spec := makeSpec()
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg,
+				xgen.WithHookTemplateData(&api.HookTemplateData{
+					PodName:      "pod0",
+					PodNamespace: "default",
+					NetNSPath:    "/var/run/netns/cni-1",
+					IPs:          []string{"10.0.0.2", "fd00::2"},
+				}),
+			)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust())).To(Succeed())
+			Expect(spec.Hooks.CreateRuntime).To(Equal([]rspec.Hook{
+				{
+					Path: "/usr/libexec/net-hook",
+					Args: []string{"net-hook", "--netns", "/var/run/netns/cni-1", "--ips", "10.0.0.2,fd00::2"},
+					Env:  []string{"POD=default/pod0", "LITERAL=${HOME}"},
+				},
+			}))
+		})
+
+		It("fails without template data", func() {
+			spec := makeSpec()
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust())).ToNot(Succeed())
+		})
+	})
+
+	When("has security profiles", func() {
+		It("adjusts Spec correctly using the profile resolvers", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{
+					Linux: &api.LinuxContainerAdjustment{
+						SeccompProfile: &api.SecurityProfile{
+							ProfileType: api.SecurityProfile_UNCONFINED,
+						},
+						ApparmorProfile: &api.SecurityProfile{
+							ProfileType:  api.SecurityProfile_LOCALHOST,
+							LocalhostRef: "restricted",
+						},
+					},
+				}
+				seccomp = func(p *api.SecurityProfile) (*rspec.LinuxSeccomp, error) {
+					return nil, nil
+				}
+				apparmor = func(p *api.SecurityProfile) (string, error) {
+					return p.LocalhostRef, nil
+				}
+			)
+
+			spec.Linux.Seccomp = &rspec.LinuxSeccomp{
+				DefaultAction: rspec.ActErrno,
+			}
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg,
+				xgen.WithSeccompProfileResolver(seccomp),
+				xgen.WithAppArmorProfileResolver(apparmor),
+			)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).To(Succeed())
+			Expect(spec.Linux.Seccomp).To(BeNil())
+			Expect(spec.Process.ApparmorProfile).To(Equal("restricted"))
+		})
+
+		It("fails without a profile resolver", func() {
+			var (
+				spec   = makeSpec()
+				adjust = &api.ContainerAdjustment{
+					Linux: &api.LinuxContainerAdjustment{
+						SeccompProfile: &api.SecurityProfile{
+							ProfileType: api.SecurityProfile_RUNTIME_DEFAULT,
+						},
+					},
+				}
+			)
+
+			rg := &rgen.Generator{Config: spec}
+			xg := xgen.SpecGenerator(rg)
+
+			Expect(xg).ToNot(BeNil())
+			Expect(xg.Adjust(adjust)).ToNot(Succeed())
+		})
+	})
 })
 
 type specOption func(*rspec.Spec)
@@ -285,6 +861,73 @@ func withMemoryLimit(v int64) specOption {
 	}
 }
 
+func withHostname(hostname, domainname string) specOption {
+	return func(spec *rspec.Spec) {
+		spec.Hostname = hostname
+		spec.Domainname = domainname
+	}
+}
+
+func withNetworkNamespace(path string) specOption {
+	return func(spec *rspec.Spec) {
+		if spec.Linux == nil {
+			spec.Linux = &rspec.Linux{}
+		}
+		spec.Linux.Namespaces = append(spec.Linux.Namespaces, rspec.LinuxNamespace{
+			Type: rspec.NetworkNamespace,
+			Path: path,
+		})
+	}
+}
+
+func withUserNamespace(path string) specOption {
+	return func(spec *rspec.Spec) {
+		if spec.Linux == nil {
+			spec.Linux = &rspec.Linux{}
+		}
+		spec.Linux.Namespaces = append(spec.Linux.Namespaces, rspec.LinuxNamespace{
+			Type: rspec.UserNamespace,
+			Path: path,
+		})
+	}
+}
+
+func withIDMappings(uid, gid, size uint32) specOption {
+	return func(spec *rspec.Spec) {
+		if spec.Linux == nil {
+			spec.Linux = &rspec.Linux{}
+		}
+		spec.Linux.UIDMappings = []rspec.LinuxIDMapping{
+			{ContainerID: 0, HostID: uid, Size: size},
+		}
+		spec.Linux.GIDMappings = []rspec.LinuxIDMapping{
+			{ContainerID: 0, HostID: gid, Size: size},
+		}
+		withUserNamespace("")(spec)
+	}
+}
+
+func withAnnotation(key, value string) specOption {
+	return func(spec *rspec.Spec) {
+		if spec.Annotations == nil {
+			spec.Annotations = map[string]string{}
+		}
+		spec.Annotations[key] = value
+	}
+}
+
+func withSysctl(key, value string) specOption {
+	return func(spec *rspec.Spec) {
+		if spec.Linux == nil {
+			spec.Linux = &rspec.Linux{}
+		}
+		if spec.Linux.Sysctl == nil {
+			spec.Linux.Sysctl = map[string]string{}
+		}
+		spec.Linux.Sysctl[key] = value
+	}
+}
+
 func withMemorySwap(v int64) specOption {
 	return func(spec *rspec.Spec) {
 		if spec.Linux == nil {
@@ -375,6 +1018,50 @@ func withCPUSetMems(v string) specOption {
 	}
 }
 
+func withBlockIOWeight(v uint16) specOption {
+	return func(spec *rspec.Spec) {
+		if spec.Linux == nil {
+			spec.Linux = &rspec.Linux{}
+		}
+		if spec.Linux.Resources == nil {
+			spec.Linux.Resources = &rspec.LinuxResources{}
+		}
+		if spec.Linux.Resources.BlockIO == nil {
+			spec.Linux.Resources.BlockIO = &rspec.LinuxBlockIO{}
+		}
+		spec.Linux.Resources.BlockIO.Weight = &v
+	}
+}
+
+func withBlockIOReadBps(major, minor int64, rate uint64) specOption {
+	return func(spec *rspec.Spec) {
+		if spec.Linux == nil {
+			spec.Linux = &rspec.Linux{}
+		}
+		if spec.Linux.Resources == nil {
+			spec.Linux.Resources = &rspec.LinuxResources{}
+		}
+		if spec.Linux.Resources.BlockIO == nil {
+			spec.Linux.Resources.BlockIO = &rspec.LinuxBlockIO{}
+		}
+		d := rspec.LinuxThrottleDevice{Rate: rate}
+		d.Major, d.Minor = major, minor
+		spec.Linux.Resources.BlockIO.ThrottleReadBpsDevice = append(spec.Linux.Resources.BlockIO.ThrottleReadBpsDevice, d)
+	}
+}
+
+func withRdtClosID(v string) specOption {
+	return func(spec *rspec.Spec) {
+		if spec.Linux == nil {
+			spec.Linux = &rspec.Linux{}
+		}
+		if spec.Linux.IntelRdt == nil {
+			spec.Linux.IntelRdt = &rspec.LinuxIntelRdt{}
+		}
+		spec.Linux.IntelRdt.ClosID = v
+	}
+}
+
 func withMounts(mounts []rspec.Mount) specOption {
 	return func(spec *rspec.Spec) {
 		spec.Mounts = append(spec.Mounts, mounts...)
@@ -0,0 +1,113 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package state
+
+import (
+	"strings"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+const (
+	podPrefix       = "pod/"
+	containerPrefix = "container/"
+)
+
+// PodKey returns the key for the named state of a pod. Such keys are
+// removed by reconciliation once the pod is gone.
+func PodKey(id, name string) string {
+	return podPrefix + id + "/" + name
+}
+
+// ContainerKey returns the key for the named state of a container. Such
+// keys are removed by reconciliation once the container is gone.
+func ContainerKey(id, name string) string {
+	return containerPrefix + id + "/" + name
+}
+
+// PodKeys returns the existing keys for the state of the given pod.
+func (tx *Txn) PodKeys(id string) []string {
+	return tx.Keys(podPrefix + id + "/")
+}
+
+// ContainerKeys returns the existing keys for the state of the given container.
+func (tx *Txn) ContainerKeys(id string) []string {
+	return tx.Keys(containerPrefix + id + "/")
+}
+
+// Reconcile removes the state of pods and containers which are not among
+// the given ones, which are typically the ones passed to a Synchronize
+// handler. It returns the removed keys.
+func (tx *Txn) Reconcile(pods []*api.PodSandbox, containers []*api.Container) ([]string, error) {
+	var (
+		podIDs = make(map[string]struct{}, len(pods))
+		ctrIDs = make(map[string]struct{}, len(containers))
+	)
+	for _, pod := range pods {
+		podIDs[pod.GetId()] = struct{}{}
+	}
+	for _, ctr := range containers {
+		ctrIDs[ctr.GetId()] = struct{}{}
+	}
+
+	var removed []string
+	for _, key := range tx.Keys(podPrefix) {
+		if _, ok := podIDs[keyID(key, podPrefix)]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	for _, key := range tx.Keys(containerPrefix) {
+		if _, ok := ctrIDs[keyID(key, containerPrefix)]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	return removed, tx.deleteKeys(removed)
+}
+
+// ReconcileDelta removes the state of the given removed pods and
+// containers, which are typically the ones passed to a SynchronizeDelta
+// handler. It returns the removed keys.
+func (tx *Txn) ReconcileDelta(removedPods, removedContainers []string) ([]string, error) {
+	var removed []string
+	for _, id := range removedPods {
+		removed = append(removed, tx.PodKeys(id)...)
+	}
+	for _, id := range removedContainers {
+		removed = append(removed, tx.ContainerKeys(id)...)
+	}
+
+	return removed, tx.deleteKeys(removed)
+}
+
+func (tx *Txn) deleteKeys(keys []string) error {
+	for _, key := range keys {
+		if err := tx.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keyID returns the pod or container ID of a key with the given prefix.
+func keyID(key, prefix string) string {
+	id := strings.TrimPrefix(key, prefix)
+	if i := strings.IndexByte(id, '/'); i >= 0 {
+		id = id[:i]
+	}
+	return id
+}
@@ -0,0 +1,283 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+// Package state provides a small transactional, file-backed key/value
+// store for NRI plugins which need to keep state across restarts, like
+// resources accounted or allocated to pods and containers. Changes are
+// made in transactions, which are either persisted as a whole or not at
+// all. Every committed transaction bumps the generation of the store.
+//
+// Keys can be tied to pods and containers, with PodKey and ContainerKey.
+// Such keys are removed for pods and containers which no longer exist by
+// the reconciliation helpers, which plugins call from their Synchronize
+// and SynchronizeDelta handlers.
+//
+// A store is meant to be used by a single plugin process at a time.
+package state
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	// ErrReadOnly is returned for attempts to change a read-only transaction.
+	ErrReadOnly = errors.New("state: read-only transaction")
+	// ErrClosed is returned for transactions on a closed store.
+	ErrClosed = errors.New("state: store closed")
+)
+
+// Store is a transactional, file-backed key/value store.
+type Store struct {
+	sync.RWMutex
+	path       string
+	generation uint64
+	data       map[string][]byte
+	closed     bool
+}
+
+// file is the on-disk format of a store.
+type file struct {
+	Generation uint64            `json:"generation"`
+	Data       map[string][]byte `json:"data,omitempty"`
+}
+
+// Open opens the store at the given path, creating an empty one if the
+// file does not exist.
+func Open(path string) (*Store, error) {
+	s := &Store{
+		path: path,
+		data: make(map[string][]byte),
+	}
+
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to open state store %s: %w", path, err)
+		}
+		return s, nil
+	}
+
+	f := &file{}
+	if err = json.Unmarshal(buf, f); err != nil {
+		return nil, fmt.Errorf("failed to load state store %s: %w", path, err)
+	}
+	s.generation = f.Generation
+	if f.Data != nil {
+		s.data = f.Data
+	}
+
+	return s, nil
+}
+
+// Close the store. Transactions on a closed store fail with ErrClosed.
+func (s *Store) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	s.closed = true
+	return nil
+}
+
+// Generation returns the generation of the store, which is the number of
+// transactions committed to it.
+func (s *Store) Generation() uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	return s.generation
+}
+
+// View runs fn in a read-only transaction.
+func (s *Store) View(fn func(*Txn) error) error {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	return fn(&Txn{s: s, readOnly: true})
+}
+
+// Update runs fn in a read-write transaction. If fn returns nil, the
+// changes made in the transaction are persisted and the generation of the
+// store is bumped. Otherwise, or if persisting the changes fails, all the
+// changes are discarded.
+func (s *Store) Update(fn func(*Txn) error) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	tx := &Txn{
+		s:       s,
+		changes: make(map[string][]byte),
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if len(tx.changes) == 0 {
+		return nil
+	}
+
+	data := make(map[string][]byte, len(s.data)+len(tx.changes))
+	for k, v := range s.data {
+		data[k] = v
+	}
+	for k, v := range tx.changes {
+		if v == nil {
+			delete(data, k)
+		} else {
+			data[k] = v
+		}
+	}
+
+	if err := s.save(s.generation+1, data); err != nil {
+		return err
+	}
+
+	s.generation++
+	s.data = data
+
+	return nil
+}
+
+// save writes the given generation and data to the store file atomically.
+func (s *Store) save(generation uint64, data map[string][]byte) error {
+	buf, err := json.Marshal(&file{
+		Generation: generation,
+		Data:       data,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal state store: %w", err)
+	}
+
+	dir := filepath.Dir(s.path)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create state store directory %s: %w", dir, err)
+	}
+
+	f, err := os.CreateTemp(dir, filepath.Base(s.path)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("failed to save state store %s: %w", s.path, err)
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	if _, err = f.Write(buf); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save state store %s: %w", s.path, err)
+	}
+
+	if err = os.Rename(tmp, s.path); err != nil {
+		return fmt.Errorf("failed to save state store %s: %w", s.path, err)
+	}
+
+	if d, err := os.Open(dir); err == nil {
+		_ = d.Sync()
+		d.Close()
+	}
+
+	return nil
+}
+
+// Txn is a transaction on a store.
+type Txn struct {
+	s        *Store
+	readOnly bool
+	changes  map[string][]byte
+}
+
+// Get returns the value of the given key, and whether it exists.
+func (tx *Txn) Get(key string) ([]byte, bool) {
+	if v, ok := tx.changes[key]; ok {
+		return v, v != nil
+	}
+	v, ok := tx.s.data[key]
+	return v, ok
+}
+
+// Put sets the value of the given key.
+func (tx *Txn) Put(key string, value []byte) error {
+	if tx.readOnly {
+		return ErrReadOnly
+	}
+	if value == nil {
+		value = []byte{}
+	}
+	tx.changes[key] = value
+	return nil
+}
+
+// Delete deletes the given key.
+func (tx *Txn) Delete(key string) error {
+	if tx.readOnly {
+		return ErrReadOnly
+	}
+	tx.changes[key] = nil
+	return nil
+}
+
+// GetJSON decodes the JSON value of the given key into v, returning
+// whether the key exists.
+func (tx *Txn) GetJSON(key string, v interface{}) (bool, error) {
+	buf, ok := tx.Get(key)
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(buf, v); err != nil {
+		return true, fmt.Errorf("failed to decode state %q: %w", key, err)
+	}
+	return true, nil
+}
+
+// PutJSON sets the value of the given key to v encoded as JSON.
+func (tx *Txn) PutJSON(key string, v interface{}) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode state %q: %w", key, err)
+	}
+	return tx.Put(key, buf)
+}
+
+// Keys returns the existing keys with the given prefix, in sorted order.
+func (tx *Txn) Keys(prefix string) []string {
+	var keys []string
+	for k := range tx.s.data {
+		if _, changed := tx.changes[k]; !changed && strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	for k, v := range tx.changes {
+		if v != nil && strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
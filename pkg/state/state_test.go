@@ -0,0 +1,145 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package state_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/state"
+
+	require "github.com/stretchr/testify/require"
+)
+
+func TestUpdatePersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	s, err := state.Open(path)
+	require.NoError(t, err, "Open()")
+	require.Equal(t, uint64(0), s.Generation(), "Generation()")
+
+	err = s.Update(func(tx *state.Txn) error {
+		if err := tx.Put("a", []byte("1")); err != nil {
+			return err
+		}
+		return tx.PutJSON("b", map[string]int{"cpus": 2})
+	})
+	require.NoError(t, err, "Update()")
+	require.Equal(t, uint64(1), s.Generation(), "Generation()")
+	require.NoError(t, s.Close(), "Close()")
+
+	s, err = state.Open(path)
+	require.NoError(t, err, "Open() existing")
+	require.Equal(t, uint64(1), s.Generation(), "Generation() after reopening")
+
+	err = s.View(func(tx *state.Txn) error {
+		v, ok := tx.Get("a")
+		require.True(t, ok, "Get(a)")
+		require.Equal(t, []byte("1"), v, "Get(a)")
+
+		b := map[string]int{}
+		ok, err := tx.GetJSON("b", &b)
+		require.NoError(t, err, "GetJSON(b)")
+		require.True(t, ok, "GetJSON(b)")
+		require.Equal(t, map[string]int{"cpus": 2}, b, "GetJSON(b)")
+
+		require.ErrorIs(t, tx.Put("c", nil), state.ErrReadOnly, "Put() in View()")
+		return nil
+	})
+	require.NoError(t, err, "View()")
+}
+
+func TestFailedUpdateIsDiscarded(t *testing.T) {
+	s, err := state.Open(filepath.Join(t.TempDir(), "state.json"))
+	require.NoError(t, err, "Open()")
+
+	failed := errors.New("failed")
+	err = s.Update(func(tx *state.Txn) error {
+		require.NoError(t, tx.Put("a", []byte("1")), "Put()")
+		_, ok := tx.Get("a")
+		require.True(t, ok, "Get() own change")
+		return failed
+	})
+	require.ErrorIs(t, err, failed, "Update()")
+	require.Equal(t, uint64(0), s.Generation(), "Generation()")
+
+	err = s.View(func(tx *state.Txn) error {
+		_, ok := tx.Get("a")
+		require.False(t, ok, "Get() discarded change")
+		return nil
+	})
+	require.NoError(t, err, "View()")
+}
+
+func TestReconcile(t *testing.T) {
+	s, err := state.Open(filepath.Join(t.TempDir(), "state.json"))
+	require.NoError(t, err, "Open()")
+
+	err = s.Update(func(tx *state.Txn) error {
+		for _, key := range []string{
+			state.PodKey("pod0", "qos"),
+			state.PodKey("pod1", "qos"),
+			state.PodKey("pod2", "qos"),
+			state.ContainerKey("ctr0", "vf"),
+			state.ContainerKey("ctr1", "vf"),
+			state.ContainerKey("ctr2", "vf"),
+			"global",
+		} {
+			if err := tx.Put(key, []byte("x")); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err, "Update()")
+
+	err = s.Update(func(tx *state.Txn) error {
+		removed, err := tx.Reconcile(
+			[]*api.PodSandbox{{Id: "pod0"}, {Id: "pod1"}},
+			[]*api.Container{{Id: "ctr0"}, {Id: "ctr1"}},
+		)
+		require.Equal(t, []string{
+			state.PodKey("pod2", "qos"),
+			state.ContainerKey("ctr2", "vf"),
+		}, removed, "Reconcile()")
+		return err
+	})
+	require.NoError(t, err, "Update() reconcile")
+
+	err = s.Update(func(tx *state.Txn) error {
+		removed, err := tx.ReconcileDelta([]string{"pod1"}, []string{"ctr1"})
+		require.Equal(t, []string{
+			state.PodKey("pod1", "qos"),
+			state.ContainerKey("ctr1", "vf"),
+		}, removed, "ReconcileDelta()")
+		return err
+	})
+	require.NoError(t, err, "Update() delta reconcile")
+
+	err = s.View(func(tx *state.Txn) error {
+		require.Equal(t, []string{
+			"container/ctr0/vf",
+			"global",
+			"pod/pod0/qos",
+		}, tx.Keys(""), "Keys()")
+		return nil
+	})
+	require.NoError(t, err, "View()")
+	require.Equal(t, uint64(3), s.Generation(), "Generation()")
+}
@@ -0,0 +1,136 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package stub
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// RequestInfo describes a request from the runtime being handled by the
+// stub. It is attached to the context passed to interceptors.
+type RequestInfo struct {
+	// Method is the name of the request, for instance CreateContainer.
+	Method string
+	// Event is the event being delivered, or the event corresponding to
+	// the request. It is unset for Configure, Synchronize and Shutdown.
+	Event api.Event
+	// Runtime is the name of the runtime.
+	Runtime string
+	// RuntimeVersion is the version of the runtime.
+	RuntimeVersion string
+	// Seq is the sequence number of the request, counting from 1.
+	Seq uint64
+	// Deadline is the deadline of the request, or zero if it has none.
+	Deadline time.Time
+	// DryRun is set for simulated requests, which the plugin should not
+	// act on other than by returning its response.
+	DryRun bool
+}
+
+// Interceptor is called around the plugin handler of each request. It must
+// call handle to invoke the handler and usually return the resulting error.
+// Interceptors can get information about the request from the context using
+// GetRequestInfo.
+type Interceptor func(ctx context.Context, handle func() error) error
+
+type requestInfoKey struct{}
+
+type dryRunKey struct{}
+
+// GetRequestInfo returns the information about the request being handled
+// attached to the context, or nil if there is none.
+func GetRequestInfo(ctx context.Context) *RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(*RequestInfo)
+	return info
+}
+
+// WithInterceptor adds an interceptor to call around plugin handlers.
+// Interceptors are called in the order they were added.
+func WithInterceptor(i Interceptor) Option {
+	return func(s *stub) error {
+		s.interceptors = append(s.interceptors, i)
+		return nil
+	}
+}
+
+// WithDryRun declares that the plugin can handle dry-run requests without
+// side effects, which includes it in pod simulations. Interceptors can tell
+// dry runs apart from real requests using RequestInfo.DryRun.
+func WithDryRun() Option {
+	return func(s *stub) error {
+		s.dryRun = true
+		return nil
+	}
+}
+
+// checkDryRun checks if the plugin accepts a request, marking the context for
+// dry-run requests.
+func (stub *stub) checkDryRun(ctx context.Context, dryRun bool) (context.Context, error) {
+	if !dryRun {
+		return ctx, nil
+	}
+	if !stub.dryRun {
+		return ctx, &api.ErrUnsupported{Message: "dry runs not supported"}
+	}
+	return context.WithValue(ctx, dryRunKey{}, true), nil
+}
+
+// handle invokes a plugin handler for a request, attaching information
+// about the request to the context and calling any interceptors.
+func (stub *stub) handle(ctx context.Context, method string, event api.Event, handler func() error) error {
+	seq := atomic.AddUint64(&stub.seq, 1)
+
+	// Request information is only visible to interceptors, so avoid the
+	// allocations on the hot path if there are none.
+	if len(stub.interceptors) > 0 {
+		info := &RequestInfo{
+			Method:         method,
+			Event:          event,
+			Runtime:        stub.runtimeName,
+			RuntimeVersion: stub.runtimeVersion,
+			Seq:            seq,
+		}
+		info.DryRun, _ = ctx.Value(dryRunKey{}).(bool)
+
+		if deadline, ok := ctx.Deadline(); ok {
+			info.Deadline = deadline
+		}
+		ctx = context.WithValue(ctx, requestInfoKey{}, info)
+	}
+
+	if l, ok := stub.limits[event]; ok && event != api.Event_UNKNOWN {
+		if err := l.acquire(ctx); err != nil {
+			log.Warnf(ctx, "Rejecting %s request: %v", method, err)
+			return api.ToStatusError(err)
+		}
+		defer l.release()
+	}
+
+	call := handler
+	for i := len(stub.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := stub.interceptors[i], call
+		call = func() error {
+			return interceptor(ctx, next)
+		}
+	}
+
+	return api.ToStatusError(call())
+}
@@ -0,0 +1,217 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package stub
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+const (
+	// DefaultDedupSize is the default number of events remembered for
+	// deduplication.
+	DefaultDedupSize = 4096
+)
+
+// WithEventDeduplication enables deduplication of the given events. If no
+// events are given, all events which can be deduplicated are. Runtimes may
+// deliver some events again after a reconnect. With deduplication the stub
+// invokes the plugin handler at most once for each such event. Events are
+// considered the same if they have the same type, are for the same pod and
+// container, and carry the same extra data, like image, exec session, exit
+// status, pod resources, network status, network attachments or network
+// setup failure. An event is
+// only remembered once the plugin handler has handled it successfully, so an
+// event redelivered after a failure is passed to the plugin again. The stub
+// remembers the last DefaultDedupSize events, unless another store is given
+// using WithDedupStore.
+//
+// Only events are deduplicated. CreateContainer, UpdateContainer and
+// StopContainer requests are always passed to the plugin. So are the
+// ContainerOOM and ResourcePressure events, which may be repeated with the
+// same data for new occurrences. A deduplicated RunPodSandbox event returns
+// no pod adjustment, and a deduplicated SetupNetworkFailed event no changes
+// for the retry.
+func WithEventDeduplication(events ...api.Event) Option {
+	return func(s *stub) error {
+		mask := api.EventMask(0)
+		for _, e := range events {
+			switch e {
+			case api.Event_CREATE_CONTAINER, api.Event_UPDATE_CONTAINER, api.Event_STOP_CONTAINER:
+				return fmt.Errorf("cannot deduplicate %s requests", e)
+			}
+			if e <= api.Event_UNKNOWN || e >= api.Event_LAST {
+				return fmt.Errorf("cannot deduplicate unknown event %d", e)
+			}
+			if repeatedEvents.IsSet(e) {
+				return fmt.Errorf("cannot deduplicate repeatable %s events", e)
+			}
+			mask.Set(e)
+		}
+		if mask == 0 {
+			mask = api.ValidEvents &^ repeatedEvents
+		}
+		if s.dedup == nil {
+			s.dedup = &dedup{}
+		}
+		s.dedup.events = mask
+		if s.dedup.store == nil {
+			s.dedup.store = NewDedupStore(DefaultDedupSize)
+		}
+		return nil
+	}
+}
+
+// WithDedupStore sets the store used by WithEventDeduplication to remember
+// seen events. Stubs cannot be restarted once their connection is lost, so
+// a plugin which reconnects by creating a new stub can pass the same store
+// to it, to skip events redelivered by the runtime which the plugin has
+// already handled.
+//
+//	store := stub.NewDedupStore(stub.DefaultDedupSize)
+//	s, err := stub.New(p, stub.WithEventDeduplication(), stub.WithDedupStore(store))
+func WithDedupStore(store *DedupStore) Option {
+	return func(s *stub) error {
+		if store == nil {
+			return fmt.Errorf("invalid nil deduplication store")
+		}
+		if s.dedup == nil {
+			s.dedup = &dedup{}
+		}
+		s.dedup.store = store
+		return nil
+	}
+}
+
+// repeatedEvents may be delivered repeatedly with the same data for what
+// are new occurrences, so they are never deduplicated.
+var repeatedEvents = api.EventMask(1<<(api.Event_CONTAINER_OOM-1) | 1<<(api.Event_RESOURCE_PRESSURE-1))
+
+// DedupStore remembers the events handled by a plugin, for deduplication.
+// It is safe for concurrent use and can be shared by successive stubs of a
+// plugin.
+type DedupStore struct {
+	sync.Mutex
+	seen  map[string]struct{}
+	order []string
+	size  int
+}
+
+// NewDedupStore creates a store which remembers the last size events.
+func NewDedupStore(size int) *DedupStore {
+	if size <= 0 {
+		size = DefaultDedupSize
+	}
+	return &DedupStore{
+		seen: make(map[string]struct{}),
+		size: size,
+	}
+}
+
+// has checks if the store remembers the given key.
+func (d *DedupStore) has(key string) bool {
+	d.Lock()
+	defer d.Unlock()
+	_, ok := d.seen[key]
+	return ok
+}
+
+// add remembers the given key, forgetting the oldest one if necessary.
+func (d *DedupStore) add(key string) {
+	d.Lock()
+	defer d.Unlock()
+
+	if _, ok := d.seen[key]; ok {
+		return
+	}
+	if len(d.order) >= d.size {
+		delete(d.seen, d.order[0])
+		d.order = d.order[1:]
+	}
+	d.seen[key] = struct{}{}
+	d.order = append(d.order, key)
+}
+
+// dedup tracks recently handled events.
+type dedup struct {
+	events api.EventMask
+	store  *DedupStore
+}
+
+// check checks if the event has already been handled. It returns the key
+// to record once the event has been handled, or an empty key if the event
+// is not deduplicated.
+func (d *dedup) check(evt *api.StateChangeEvent) (string, bool) {
+	if d == nil || !d.events.IsSet(evt.Event) {
+		return "", false
+	}
+	key := dedupKey(evt)
+	return key, d.store.has(key)
+}
+
+// record remembers a handled event by its key.
+func (d *dedup) record(key string) {
+	if d == nil || key == "" {
+		return
+	}
+	d.store.add(key)
+}
+
+// dedupBuffers are recycled for marshaling the extra data of events.
+var dedupBuffers = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 0, 256)
+		return &buf
+	},
+}
+
+func dedupKey(evt *api.StateChangeEvent) string {
+	pod := evt.GetPod().GetUid()
+	if pod == "" {
+		pod = evt.GetPod().GetId()
+	}
+	key := evt.Event.String() + "/" + pod + "/" + evt.GetContainer().GetId()
+
+	extra := &api.StateChangeEvent{
+		Image:        evt.Image,
+		Exec:         evt.Exec,
+		Exit:         evt.Exit,
+		PodResources: evt.PodResources,
+		Pressure:     evt.Pressure,
+		Networks:     evt.Networks,
+		Attachments:  evt.Attachments,
+
+		NetworkFailure: evt.NetworkFailure,
+	}
+	bufp := dedupBuffers.Get().(*[]byte)
+	defer dedupBuffers.Put(bufp)
+
+	data, err := proto.MarshalOptions{Deterministic: true}.MarshalAppend((*bufp)[:0], extra)
+	if err != nil || len(data) == 0 {
+		return key
+	}
+	*bufp = data
+
+	sum := sha256.Sum256(data)
+	return key + "/" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,59 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package stub
+
+import (
+	"sync/atomic"
+)
+
+// WithLastGeneration asks the runtime to only synchronize the plugin with
+// the changes since the given generation of the runtime state, instead of
+// sending its full state. This is meant for plugins reconnecting after a
+// brief disconnect, which pass the Generation of the stub of their previous
+// connection. The runtime falls back to a full synchronization if it does
+// not know the changes since the given generation. The option has no effect
+// unless the plugin handles delta synchronization, by implementing
+// SynchronizeDeltaInterface or registering HandleSynchronizeDelta.
+//
+//	gen := s.Generation()
+//	s, err = stub.New(p, stub.WithLastGeneration(gen))
+func WithLastGeneration(generation uint64) Option {
+	return func(s *stub) error {
+		s.generation = generation
+		return nil
+	}
+}
+
+// Generation returns the generation of the last runtime state seen by the
+// plugin, which is the generation of the last Synchronize request, event or
+// request delivered to it.
+func (stub *stub) Generation() uint64 {
+	return atomic.LoadUint64(&stub.generation)
+}
+
+// seen records a generation of the runtime state seen by the plugin.
+func (stub *stub) seen(generation uint64) {
+	for {
+		last := atomic.LoadUint64(&stub.generation)
+		if generation <= last {
+			return
+		}
+		if atomic.CompareAndSwapUint64(&stub.generation, last, generation) {
+			return
+		}
+	}
+}
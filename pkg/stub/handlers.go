@@ -0,0 +1,330 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package stub
+
+import (
+	rspec "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// The Handle* options register functions as handlers for requests and
+// events. They allow small plugins to be written as a few closures, without
+// defining a type implementing the corresponding interfaces. Registering a
+// handler for an event also subscribes the plugin to it. A registered
+// handler takes precedence over any handler implemented by the plugin. For
+// instance:
+//
+//	s, err := stub.New(nil,
+//		stub.HandleStartContainer(func(pod *api.PodSandbox, ctr *api.Container) error {
+//			log.Printf("started container %s/%s", pod.Name, ctr.Name)
+//			return nil
+//		}),
+//	)
+
+// HandleConfigure registers a handler for Configure requests.
+func HandleConfigure(fn func(config, runtime, version string) (api.EventMask, error)) Option {
+	return func(s *stub) error {
+		s.handlers.Configure = fn
+		return nil
+	}
+}
+
+// HandleSynchronize registers a handler for Synchronize requests.
+func HandleSynchronize(fn func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error)) Option {
+	return func(s *stub) error {
+		s.handlers.Synchronize = fn
+		return nil
+	}
+}
+
+// HandleSynchronizeDelta registers a handler for delta Synchronize requests.
+func HandleSynchronizeDelta(fn func(pods []*api.PodSandbox, containers []*api.Container, removedPods, removedContainers []string) ([]*api.ContainerUpdate, error)) Option {
+	return func(s *stub) error {
+		s.handlers.SynchronizeDelta = fn
+		return nil
+	}
+}
+
+// HandleShutdown registers a handler for Shutdown requests.
+func HandleShutdown(fn func(*api.ShutdownRequest)) Option {
+	return func(s *stub) error {
+		s.handlers.Shutdown = fn
+		return nil
+	}
+}
+
+// HandleRunPodSandbox registers a handler for RunPodSandbox events.
+func HandleRunPodSandbox(fn func(*api.PodSandbox) error) Option {
+	return func(s *stub) error {
+		s.handlers.RunPodSandbox = fn
+		s.events.Set(api.Event_RUN_POD_SANDBOX)
+		return nil
+	}
+}
+
+// HandleAdjustPodSandbox registers a handler for pod adjustment during RunPodSandbox events.
+func HandleAdjustPodSandbox(fn func(*api.PodSandbox) (*api.PodSandboxAdjustment, error)) Option {
+	return func(s *stub) error {
+		s.handlers.AdjustPodSandbox = fn
+		s.events.Set(api.Event_RUN_POD_SANDBOX)
+		return nil
+	}
+}
+
+// HandleStopPodSandbox registers a handler for StopPodSandbox events.
+func HandleStopPodSandbox(fn func(*api.PodSandbox) error) Option {
+	return func(s *stub) error {
+		s.handlers.StopPodSandbox = fn
+		s.events.Set(api.Event_STOP_POD_SANDBOX)
+		return nil
+	}
+}
+
+// HandleRemovePodSandbox registers a handler for RemovePodSandbox events.
+func HandleRemovePodSandbox(fn func(*api.PodSandbox) error) Option {
+	return func(s *stub) error {
+		s.handlers.RemovePodSandbox = fn
+		s.events.Set(api.Event_REMOVE_POD_SANDBOX)
+		return nil
+	}
+}
+
+// HandleCreateContainer registers a handler for CreateContainer requests.
+func HandleCreateContainer(fn func(*api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)) Option {
+	return func(s *stub) error {
+		s.handlers.CreateContainer = fn
+		s.events.Set(api.Event_CREATE_CONTAINER)
+		return nil
+	}
+}
+
+// HandleCreateContainerSpec registers a handler for CreateContainer requests, with the OCI Spec of the container.
+func HandleCreateContainerSpec(fn func(*api.PodSandbox, *api.Container, *rspec.Spec) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)) Option {
+	return func(s *stub) error {
+		s.handlers.CreateContainerSpec = fn
+		s.events.Set(api.Event_CREATE_CONTAINER)
+		return nil
+	}
+}
+
+// HandlePostCreateContainer registers a handler for PostCreateContainer events.
+func HandlePostCreateContainer(fn func(*api.PodSandbox, *api.Container) error) Option {
+	return func(s *stub) error {
+		s.handlers.PostCreateContainer = fn
+		s.events.Set(api.Event_POST_CREATE_CONTAINER)
+		return nil
+	}
+}
+
+// HandleStartContainer registers a handler for StartContainer events.
+func HandleStartContainer(fn func(*api.PodSandbox, *api.Container) error) Option {
+	return func(s *stub) error {
+		s.handlers.StartContainer = fn
+		s.events.Set(api.Event_START_CONTAINER)
+		return nil
+	}
+}
+
+// HandlePostStartContainer registers a handler for PostStartContainer events.
+func HandlePostStartContainer(fn func(*api.PodSandbox, *api.Container) error) Option {
+	return func(s *stub) error {
+		s.handlers.PostStartContainer = fn
+		s.events.Set(api.Event_POST_START_CONTAINER)
+		return nil
+	}
+}
+
+// HandleUpdateContainer registers a handler for UpdateContainer requests.
+func HandleUpdateContainer(fn func(*api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error)) Option {
+	return func(s *stub) error {
+		s.handlers.UpdateContainer = fn
+		s.events.Set(api.Event_UPDATE_CONTAINER)
+		return nil
+	}
+}
+
+// HandlePostUpdateContainer registers a handler for PostUpdateContainer events.
+func HandlePostUpdateContainer(fn func(*api.PodSandbox, *api.Container) error) Option {
+	return func(s *stub) error {
+		s.handlers.PostUpdateContainer = fn
+		s.events.Set(api.Event_POST_UPDATE_CONTAINER)
+		return nil
+	}
+}
+
+// HandleStopContainer registers a handler for StopContainer requests.
+func HandleStopContainer(fn func(*api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error)) Option {
+	return func(s *stub) error {
+		s.handlers.StopContainer = fn
+		s.events.Set(api.Event_STOP_CONTAINER)
+		return nil
+	}
+}
+
+// HandleRemoveContainer registers a handler for RemoveContainer events.
+func HandleRemoveContainer(fn func(*api.PodSandbox, *api.Container) error) Option {
+	return func(s *stub) error {
+		s.handlers.RemoveContainer = fn
+		s.events.Set(api.Event_REMOVE_CONTAINER)
+		return nil
+	}
+}
+
+// HandleCheckpointPodSandbox registers a handler for CheckpointPodSandbox events.
+func HandleCheckpointPodSandbox(fn func(*api.PodSandbox) error) Option {
+	return func(s *stub) error {
+		s.handlers.CheckpointPodSandbox = fn
+		s.events.Set(api.Event_CHECKPOINT_POD_SANDBOX)
+		return nil
+	}
+}
+
+// HandleRestorePodSandbox registers a handler for RestorePodSandbox events.
+func HandleRestorePodSandbox(fn func(*api.PodSandbox) error) Option {
+	return func(s *stub) error {
+		s.handlers.RestorePodSandbox = fn
+		s.events.Set(api.Event_RESTORE_POD_SANDBOX)
+		return nil
+	}
+}
+
+// HandleCheckpointContainer registers a handler for CheckpointContainer events.
+func HandleCheckpointContainer(fn func(*api.PodSandbox, *api.Container) error) Option {
+	return func(s *stub) error {
+		s.handlers.CheckpointContainer = fn
+		s.events.Set(api.Event_CHECKPOINT_CONTAINER)
+		return nil
+	}
+}
+
+// HandleRestoreContainer registers a handler for RestoreContainer events.
+func HandleRestoreContainer(fn func(*api.PodSandbox, *api.Container) error) Option {
+	return func(s *stub) error {
+		s.handlers.RestoreContainer = fn
+		s.events.Set(api.Event_RESTORE_CONTAINER)
+		return nil
+	}
+}
+
+// HandleImagePulled registers a handler for ImagePulled events.
+func HandleImagePulled(fn func(*api.Image) error) Option {
+	return func(s *stub) error {
+		s.handlers.ImagePulled = fn
+		s.events.Set(api.Event_IMAGE_PULLED)
+		return nil
+	}
+}
+
+// HandleImageRemoved registers a handler for ImageRemoved events.
+func HandleImageRemoved(fn func(*api.Image) error) Option {
+	return func(s *stub) error {
+		s.handlers.ImageRemoved = fn
+		s.events.Set(api.Event_IMAGE_REMOVED)
+		return nil
+	}
+}
+
+// HandlePreExec registers a handler for PreExec events.
+func HandlePreExec(fn func(*api.PodSandbox, *api.Container, *api.ExecSession) error) Option {
+	return func(s *stub) error {
+		s.handlers.PreExec = fn
+		s.events.Set(api.Event_PRE_EXEC)
+		return nil
+	}
+}
+
+// HandlePostExec registers a handler for PostExec events.
+func HandlePostExec(fn func(*api.PodSandbox, *api.Container, *api.ExecSession) error) Option {
+	return func(s *stub) error {
+		s.handlers.PostExec = fn
+		s.events.Set(api.Event_POST_EXEC)
+		return nil
+	}
+}
+
+// HandleContainerOOM registers a handler for ContainerOOM events.
+func HandleContainerOOM(fn func(*api.PodSandbox, *api.Container) error) Option {
+	return func(s *stub) error {
+		s.handlers.ContainerOOM = fn
+		s.events.Set(api.Event_CONTAINER_OOM)
+		return nil
+	}
+}
+
+// HandleContainerExit registers a handler for ContainerExit events.
+func HandleContainerExit(fn func(*api.PodSandbox, *api.Container, *api.ContainerExit) error) Option {
+	return func(s *stub) error {
+		s.handlers.ContainerExit = fn
+		s.events.Set(api.Event_CONTAINER_EXIT)
+		return nil
+	}
+}
+
+// HandleUpdatePodSandbox registers a handler for UpdatePodSandbox events.
+func HandleUpdatePodSandbox(fn func(*api.PodSandbox, *api.LinuxResources) error) Option {
+	return func(s *stub) error {
+		s.handlers.UpdatePodSandbox = fn
+		s.events.Set(api.Event_UPDATE_POD_SANDBOX)
+		return nil
+	}
+}
+
+// HandlePostUpdatePodSandbox registers a handler for PostUpdatePodSandbox events.
+func HandlePostUpdatePodSandbox(fn func(*api.PodSandbox) error) Option {
+	return func(s *stub) error {
+		s.handlers.PostUpdatePodSandbox = fn
+		s.events.Set(api.Event_POST_UPDATE_POD_SANDBOX)
+		return nil
+	}
+}
+
+// HandleResourcePressure registers a handler for ResourcePressure events.
+func HandleResourcePressure(fn func(*api.PodSandbox, *api.Container, *api.Pressure) error) Option {
+	return func(s *stub) error {
+		s.handlers.ResourcePressure = fn
+		s.events.Set(api.Event_RESOURCE_PRESSURE)
+		return nil
+	}
+}
+
+// HandleNetworkStatus registers a handler for NetworkStatus events.
+func HandleNetworkStatus(fn func([]*api.NetworkStatus) error) Option {
+	return func(s *stub) error {
+		s.handlers.NetworkStatus = fn
+		s.events.Set(api.Event_NETWORK_STATUS)
+		return nil
+	}
+}
+
+// HandleNetworkGC registers a handler for NetworkGC events.
+func HandleNetworkGC(fn func([]*api.NetworkAttachment) error) Option {
+	return func(s *stub) error {
+		s.handlers.NetworkGC = fn
+		s.events.Set(api.Event_NETWORK_GC)
+		return nil
+	}
+}
+
+// HandleSetupNetworkFailed registers a handler for SetupNetworkFailed events.
+func HandleSetupNetworkFailed(fn func(*api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error)) Option {
+	return func(s *stub) error {
+		s.handlers.SetupNetworkFailed = fn
+		s.events.Set(api.Event_SETUP_NETWORK_FAILED)
+		return nil
+	}
+}
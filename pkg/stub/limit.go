@@ -0,0 +1,90 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package stub
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// ErrBusy is returned to the runtime for requests which are rejected because
+// the plugin is already handling as many of them as it is allowed to. It is
+// an api.ErrRetryable, so the runtime can check for it with api.IsRetryable.
+var ErrBusy error = &api.ErrRetryable{Message: "plugin busy"}
+
+// WithHandlerLimit limits the number of concurrent invocations of the plugin
+// handler for an event, and the number of further requests waiting for one
+// of those invocations to finish. Requests beyond these limits are rejected
+// with ErrBusy. Waiting requests are also rejected, as retryable, if they
+// time out. This protects plugins which call slow external services from
+// piling up work.
+func WithHandlerLimit(event api.Event, concurrency, queue int) Option {
+	return func(s *stub) error {
+		if event <= api.Event_UNKNOWN || event >= api.Event_LAST {
+			return fmt.Errorf("cannot limit handlers of unknown event %d", event)
+		}
+		if concurrency < 1 {
+			return fmt.Errorf("invalid %s handler concurrency %d", event, concurrency)
+		}
+		if queue < 0 {
+			return fmt.Errorf("invalid %s handler queue length %d", event, queue)
+		}
+		if s.limits == nil {
+			s.limits = make(map[api.Event]*limiter)
+		}
+		s.limits[event] = &limiter{
+			active: make(chan struct{}, concurrency),
+			queue:  int32(queue),
+		}
+		return nil
+	}
+}
+
+// limiter limits the number of active and waiting handler invocations.
+type limiter struct {
+	active  chan struct{}
+	queue   int32
+	waiting int32
+}
+
+func (l *limiter) acquire(ctx context.Context) error {
+	select {
+	case l.active <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if atomic.AddInt32(&l.waiting, 1) > l.queue {
+		atomic.AddInt32(&l.waiting, -1)
+		return ErrBusy
+	}
+	defer atomic.AddInt32(&l.waiting, -1)
+
+	select {
+	case l.active <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return &api.ErrRetryable{Message: "plugin busy, timed out waiting"}
+	}
+}
+
+func (l *limiter) release() {
+	<-l.active
+}
@@ -0,0 +1,112 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package stub
+
+import (
+	"fmt"
+)
+
+// PrivilegeProfile describes the privileges a plugin keeps once it has
+// connected to the runtime. Dropping privileges limits the damage a
+// compromised plugin can do, since plugins typically run as root with
+// access to host resources.
+type PrivilegeProfile struct {
+	// KeepCapabilities are the capabilities to keep, like CAP_NET_ADMIN.
+	// All other capabilities are dropped, also from the bounding set.
+	KeepCapabilities []string
+	// DenySyscalls are the system calls to deny with EPERM, using a seccomp
+	// filter. Setting them also sets no_new_privs.
+	DenySyscalls []string
+	// Chroot is the directory to change the root directory to, if any.
+	Chroot string
+}
+
+var (
+	// DefaultDeniedSyscalls are system calls plugins rarely need, which are
+	// commonly used to break out of containers or to tamper with the host.
+	DefaultDeniedSyscalls = []string{
+		"acct",
+		"add_key",
+		"bpf",
+		"clock_settime",
+		"delete_module",
+		"finit_module",
+		"init_module",
+		"kexec_load",
+		"keyctl",
+		"mount",
+		"open_by_handle_at",
+		"perf_event_open",
+		"personality",
+		"pivot_root",
+		"process_vm_readv",
+		"process_vm_writev",
+		"ptrace",
+		"reboot",
+		"request_key",
+		"setns",
+		"settimeofday",
+		"swapoff",
+		"swapon",
+		"umount2",
+		"unshare",
+		"userfaultfd",
+	}
+
+	// MinimalProfile drops all capabilities and denies the default system
+	// calls, for plugins which only talk to the runtime.
+	MinimalProfile = &PrivilegeProfile{
+		DenySyscalls: DefaultDeniedSyscalls,
+	}
+
+	// NetworkProfile keeps the capabilities needed to configure networking
+	// with netlink in other network namespaces, and allows entering them.
+	NetworkProfile = &PrivilegeProfile{
+		KeepCapabilities: []string{"CAP_NET_ADMIN", "CAP_NET_RAW", "CAP_SYS_ADMIN"},
+		DenySyscalls:     without(DefaultDeniedSyscalls, "setns"),
+	}
+)
+
+// WithPrivilegeDrop drops privileges according to the given profile once
+// the plugin has connected to the runtime, before it registers. Anything
+// which needs the dropped privileges, like opening host files outside of
+// the new root directory, must be done before starting the stub. Dropping
+// privileges is only supported on Linux, and since it must apply to all
+// threads of the process, only for plugins built without cgo.
+func WithPrivilegeDrop(profile *PrivilegeProfile) Option {
+	return func(s *stub) error {
+		if profile == nil {
+			return fmt.Errorf("no privilege profile given")
+		}
+		if err := checkPrivilegeProfile(profile); err != nil {
+			return fmt.Errorf("invalid privilege profile: %w", err)
+		}
+		s.privileges = profile
+		return nil
+	}
+}
+
+// without returns a copy of the list without the given item.
+func without(list []string, item string) []string {
+	var result []string
+	for _, i := range list {
+		if i != item {
+			result = append(result, i)
+		}
+	}
+	return result
+}
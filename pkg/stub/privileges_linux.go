@@ -0,0 +1,284 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package stub
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// seccomp(2) operation and flag, not provided by x/sys/unix
+	seccompSetModeFilter   = 1
+	seccompFilterFlagTsync = 1
+	// seccomp filter return values
+	seccompRetAllow = 0x7fff0000
+	seccompRetErrno = 0x00050000
+	// offsets of the architecture and system call number in seccomp_data
+	seccompDataNr   = 0
+	seccompDataArch = 4
+	// system call numbers with this bit set belong to the x32 ABI on amd64
+	x32SyscallBit = 0x40000000
+	// version of the capget(2)/capset(2) interface we use
+	capabilityVersion3 = 0x20080522
+)
+
+var (
+	// capabilities by name
+	capabilities = map[string]uintptr{
+		"CAP_CHOWN":              unix.CAP_CHOWN,
+		"CAP_DAC_OVERRIDE":       unix.CAP_DAC_OVERRIDE,
+		"CAP_DAC_READ_SEARCH":    unix.CAP_DAC_READ_SEARCH,
+		"CAP_FOWNER":             unix.CAP_FOWNER,
+		"CAP_FSETID":             unix.CAP_FSETID,
+		"CAP_KILL":               unix.CAP_KILL,
+		"CAP_SETGID":             unix.CAP_SETGID,
+		"CAP_SETUID":             unix.CAP_SETUID,
+		"CAP_SETPCAP":            unix.CAP_SETPCAP,
+		"CAP_LINUX_IMMUTABLE":    unix.CAP_LINUX_IMMUTABLE,
+		"CAP_NET_BIND_SERVICE":   unix.CAP_NET_BIND_SERVICE,
+		"CAP_NET_BROADCAST":      unix.CAP_NET_BROADCAST,
+		"CAP_NET_ADMIN":          unix.CAP_NET_ADMIN,
+		"CAP_NET_RAW":            unix.CAP_NET_RAW,
+		"CAP_IPC_LOCK":           unix.CAP_IPC_LOCK,
+		"CAP_IPC_OWNER":          unix.CAP_IPC_OWNER,
+		"CAP_SYS_MODULE":         unix.CAP_SYS_MODULE,
+		"CAP_SYS_RAWIO":          unix.CAP_SYS_RAWIO,
+		"CAP_SYS_CHROOT":         unix.CAP_SYS_CHROOT,
+		"CAP_SYS_PTRACE":         unix.CAP_SYS_PTRACE,
+		"CAP_SYS_PACCT":          unix.CAP_SYS_PACCT,
+		"CAP_SYS_ADMIN":          unix.CAP_SYS_ADMIN,
+		"CAP_SYS_BOOT":           unix.CAP_SYS_BOOT,
+		"CAP_SYS_NICE":           unix.CAP_SYS_NICE,
+		"CAP_SYS_RESOURCE":       unix.CAP_SYS_RESOURCE,
+		"CAP_SYS_TIME":           unix.CAP_SYS_TIME,
+		"CAP_SYS_TTY_CONFIG":     unix.CAP_SYS_TTY_CONFIG,
+		"CAP_MKNOD":              unix.CAP_MKNOD,
+		"CAP_LEASE":              unix.CAP_LEASE,
+		"CAP_AUDIT_WRITE":        unix.CAP_AUDIT_WRITE,
+		"CAP_AUDIT_CONTROL":      unix.CAP_AUDIT_CONTROL,
+		"CAP_SETFCAP":            unix.CAP_SETFCAP,
+		"CAP_MAC_OVERRIDE":       unix.CAP_MAC_OVERRIDE,
+		"CAP_MAC_ADMIN":          unix.CAP_MAC_ADMIN,
+		"CAP_SYSLOG":             unix.CAP_SYSLOG,
+		"CAP_WAKE_ALARM":         unix.CAP_WAKE_ALARM,
+		"CAP_BLOCK_SUSPEND":      unix.CAP_BLOCK_SUSPEND,
+		"CAP_AUDIT_READ":         unix.CAP_AUDIT_READ,
+		"CAP_PERFMON":            unix.CAP_PERFMON,
+		"CAP_BPF":                unix.CAP_BPF,
+		"CAP_CHECKPOINT_RESTORE": unix.CAP_CHECKPOINT_RESTORE,
+	}
+
+	// system calls which can be denied, by name
+	syscalls = map[string]uint32{
+		"acct":              unix.SYS_ACCT,
+		"add_key":           unix.SYS_ADD_KEY,
+		"bpf":               unix.SYS_BPF,
+		"chroot":            unix.SYS_CHROOT,
+		"clock_settime":     unix.SYS_CLOCK_SETTIME,
+		"delete_module":     unix.SYS_DELETE_MODULE,
+		"finit_module":      unix.SYS_FINIT_MODULE,
+		"init_module":       unix.SYS_INIT_MODULE,
+		"kexec_load":        unix.SYS_KEXEC_LOAD,
+		"keyctl":            unix.SYS_KEYCTL,
+		"mount":             unix.SYS_MOUNT,
+		"open_by_handle_at": unix.SYS_OPEN_BY_HANDLE_AT,
+		"perf_event_open":   unix.SYS_PERF_EVENT_OPEN,
+		"personality":       unix.SYS_PERSONALITY,
+		"pivot_root":        unix.SYS_PIVOT_ROOT,
+		"process_vm_readv":  unix.SYS_PROCESS_VM_READV,
+		"process_vm_writev": unix.SYS_PROCESS_VM_WRITEV,
+		"ptrace":            unix.SYS_PTRACE,
+		"quotactl":          unix.SYS_QUOTACTL,
+		"reboot":            unix.SYS_REBOOT,
+		"request_key":       unix.SYS_REQUEST_KEY,
+		"setns":             unix.SYS_SETNS,
+		"settimeofday":      unix.SYS_SETTIMEOFDAY,
+		"swapoff":           unix.SYS_SWAPOFF,
+		"swapon":            unix.SYS_SWAPON,
+		"syslog":            unix.SYS_SYSLOG,
+		"umount2":           unix.SYS_UMOUNT2,
+		"unshare":           unix.SYS_UNSHARE,
+		"userfaultfd":       unix.SYS_USERFAULTFD,
+		"vhangup":           unix.SYS_VHANGUP,
+	}
+
+	// seccomp audit architectures, by GOARCH
+	auditArchs = map[string]uint32{
+		"386":     unix.AUDIT_ARCH_I386,
+		"amd64":   unix.AUDIT_ARCH_X86_64,
+		"arm":     unix.AUDIT_ARCH_ARM,
+		"arm64":   unix.AUDIT_ARCH_AARCH64,
+		"ppc64le": unix.AUDIT_ARCH_PPC64LE,
+		"riscv64": unix.AUDIT_ARCH_RISCV64,
+		"s390x":   unix.AUDIT_ARCH_S390X,
+	}
+)
+
+// checkPrivilegeProfile checks that all capabilities and system calls of
+// the profile are known.
+func checkPrivilegeProfile(p *PrivilegeProfile) error {
+	for _, name := range p.KeepCapabilities {
+		if _, ok := capabilities[name]; !ok {
+			return fmt.Errorf("unknown capability %q", name)
+		}
+	}
+	if len(p.DenySyscalls) > 0 {
+		if _, ok := auditArchs[runtime.GOARCH]; !ok {
+			return fmt.Errorf("seccomp filters are not supported on %s", runtime.GOARCH)
+		}
+	}
+	if len(p.DenySyscalls) > len(syscalls) {
+		return fmt.Errorf("too many system calls to deny")
+	}
+	for _, name := range p.DenySyscalls {
+		if _, ok := syscalls[name]; !ok {
+			return fmt.Errorf("unknown or unsupported system call %q", name)
+		}
+	}
+	return nil
+}
+
+// dropPrivileges drops privileges for all threads of the process. Steps
+// needing privileges, like changing the root directory and dropping from
+// the capability bounding set, come before dropping capabilities.
+func dropPrivileges(p *PrivilegeProfile) error {
+	if p.Chroot != "" {
+		if err := unix.Chroot(p.Chroot); err != nil {
+			return fmt.Errorf("failed to change root directory to %s: %w", p.Chroot, err)
+		}
+		if err := unix.Chdir("/"); err != nil {
+			return fmt.Errorf("failed to change directory to new root: %w", err)
+		}
+	}
+
+	keep := uint64(0)
+	for _, name := range p.KeepCapabilities {
+		keep |= 1 << capabilities[name]
+	}
+
+	for c := uintptr(0); c <= unix.CAP_LAST_CAP; c++ {
+		if keep&(1<<c) != 0 {
+			continue
+		}
+		if err := allThreads(unix.SYS_PRCTL, unix.PR_CAPBSET_DROP, c, 0); err != nil {
+			// Capabilities unknown to the running kernel can't be dropped.
+			if errors.Is(err, unix.EINVAL) {
+				break
+			}
+			return fmt.Errorf("failed to drop capability %d from bounding set: %w", c, err)
+		}
+	}
+
+	if err := allThreads(unix.SYS_PRCTL, unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_CLEAR_ALL, 0); err != nil {
+		return fmt.Errorf("failed to clear ambient capabilities: %w", err)
+	}
+
+	if len(p.DenySyscalls) > 0 {
+		if err := allThreads(unix.SYS_PRCTL, unix.PR_SET_NO_NEW_PRIVS, 1, 0); err != nil {
+			return fmt.Errorf("failed to set no_new_privs: %w", err)
+		}
+		if err := installSeccompFilter(p.DenySyscalls); err != nil {
+			return err
+		}
+	}
+
+	hdr := struct {
+		version uint32
+		pid     int32
+	}{version: capabilityVersion3}
+	data := [2]struct {
+		effective   uint32
+		permitted   uint32
+		inheritable uint32
+	}{
+		{effective: uint32(keep), permitted: uint32(keep)},
+		{effective: uint32(keep >> 32), permitted: uint32(keep >> 32)},
+	}
+	err := allThreads(unix.SYS_CAPSET, uintptr(unsafe.Pointer(&hdr)), uintptr(unsafe.Pointer(&data[0])), 0)
+	runtime.KeepAlive(&hdr)
+	runtime.KeepAlive(&data)
+	if err != nil {
+		return fmt.Errorf("failed to set capabilities: %w", err)
+	}
+
+	return nil
+}
+
+// installSeccompFilter installs a seccomp filter denying the given system
+// calls with EPERM, synchronized to all threads of the process.
+func installSeccompFilter(deny []string) error {
+	var (
+		n    = len(deny)
+		prog []unix.SockFilter
+	)
+
+	stmt := func(code uint16, k uint32) {
+		prog = append(prog, unix.SockFilter{Code: code, K: k})
+	}
+	jump := func(code uint16, k uint32, jt, jf uint8) {
+		prog = append(prog, unix.SockFilter{Code: code, Jt: jt, Jf: jf, K: k})
+	}
+
+	// Deny everything from other architectures, then look at the system
+	// call number, denying the x32 ABI and each denied system call.
+	stmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataArch)
+	jump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, auditArchs[runtime.GOARCH], 1, 0)
+	stmt(unix.BPF_RET|unix.BPF_K, seccompRetErrno|uint32(unix.EPERM))
+	stmt(unix.BPF_LD|unix.BPF_W|unix.BPF_ABS, seccompDataNr)
+	jump(unix.BPF_JMP|unix.BPF_JGE|unix.BPF_K, x32SyscallBit, uint8(n+1), 0)
+	for i, name := range deny {
+		jump(unix.BPF_JMP|unix.BPF_JEQ|unix.BPF_K, syscalls[name], uint8(n-i), 0)
+	}
+	stmt(unix.BPF_RET|unix.BPF_K, seccompRetAllow)
+	stmt(unix.BPF_RET|unix.BPF_K, seccompRetErrno|uint32(unix.EPERM))
+
+	fprog := &unix.SockFprog{
+		Len:    uint16(len(prog)),
+		Filter: &prog[0],
+	}
+
+	tid, _, errno := unix.Syscall(unix.SYS_SECCOMP, seccompSetModeFilter, seccompFilterFlagTsync,
+		uintptr(unsafe.Pointer(fprog)))
+	runtime.KeepAlive(fprog)
+	runtime.KeepAlive(prog)
+	if errno != 0 {
+		return fmt.Errorf("failed to install seccomp filter: %w", errno)
+	}
+	if tid != 0 {
+		return fmt.Errorf("failed to synchronize seccomp filter to thread %d", tid)
+	}
+
+	return nil
+}
+
+// allThreads runs a system call on all threads of the process.
+func allThreads(trap, a1, a2, a3 uintptr) error {
+	_, _, errno := syscall.AllThreadsSyscall(trap, a1, a2, a3)
+	if errno == 0 {
+		return nil
+	}
+	if errno == syscall.ENOTSUP {
+		return errors.New("per-thread privileges can't be changed in plugins built with cgo")
+	}
+	return errno
+}
@@ -18,12 +18,14 @@ package stub
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
 	stdnet "net"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -32,6 +34,8 @@ import (
 	"github.com/containerd/nri/pkg/net"
 	"github.com/containerd/nri/pkg/net/multiplex"
 	"github.com/containerd/ttrpc"
+	rspec "github.com/opencontainers/runtime-spec/specs-go"
+	"google.golang.org/grpc"
 )
 
 // Plugin can implement a number of interfaces related to Pod and Container
@@ -40,6 +44,17 @@ import (
 // least one of these interfaces and this is verified during stub creation.
 // Trying to create a stub for a plugin violating this requirement will fail
 // with and error.
+//
+// Related interfaces are also grouped into role interfaces, for instance
+// PodLifecycleInterface or ContainerAdjusterInterface. Plugins can assert
+// at compile time that they implement a role, catching handlers with the
+// wrong signature which the stub would otherwise silently ignore:
+//
+//	var _ stub.ContainerAdjusterInterface = (*plugin)(nil)
+//
+// Any handler receiving a pod can attach data to it for plugins later in the
+// invocation chain using the PodSandbox SetPluginData and RemovePluginData
+// functions. The stub relays any such changes back to the runtime.
 type Plugin interface{}
 
 // ConfigureInterface handles Configure API request.
@@ -57,6 +72,16 @@ type SynchronizeInterface interface {
 	Synchronize([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error)
 }
 
+// SynchronizeDeltaInterface handles delta Synchronize API requests.
+type SynchronizeDeltaInterface interface {
+	// SynchronizeDelta updates the state of the plugin with the changes in
+	// the runtime since the generation given by WithLastGeneration. Pods and
+	// containers are the ones which changed since then, followed by the IDs
+	// of pods and containers which were removed. The plugin can request
+	// updates to containers in response.
+	SynchronizeDelta(pods []*api.PodSandbox, containers []*api.Container, removedPods, removedContainers []string) ([]*api.ContainerUpdate, error)
+}
+
 // ShutdownInterface handles a Shutdown API request.
 type ShutdownInterface interface {
 	// Shutdown notifies the plugin about the runtime shutting down.
@@ -69,6 +94,12 @@ type RunPodInterface interface {
 	RunPodSandbox(*api.PodSandbox) error
 }
 
+// AdjustPodInterface handles pod adjustment during RunPodSandbox API events.
+type AdjustPodInterface interface {
+	// AdjustPodSandbox requests adjustments to a pod being created.
+	AdjustPodSandbox(*api.PodSandbox) (*api.PodSandboxAdjustment, error)
+}
+
 // StopPodInterface handles StopPodSandbox API events.
 type StopPodInterface interface {
 	// StopPodSandbox relays a StopPodSandbox event to the plugin.
@@ -85,10 +116,24 @@ type RemovePodInterface interface {
 type CreateContainerInterface interface {
 	// CreateContainer relays a CreateContainer request to the plugin.
 	// The plugin can request adjustments to the container being created
-	// and updates to other unstopped containers in response.
+	// and updates to other unstopped containers in response. The plugin
+	// can also return ErrDeferred and deliver its response later using
+	// DeliverCreateContainerResponse.
 	CreateContainer(*api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)
 }
 
+// CreateContainerSpecInterface handles CreateContainer API requests together
+// with the OCI Spec generated for the container by the runtime. Plugins which
+// implement it are sent the Spec in CreateContainer requests and it is used
+// instead of CreateContainerInterface.
+type CreateContainerSpecInterface interface {
+	// CreateContainerSpec relays a CreateContainer request to the plugin.
+	// The OCI Spec is read-only, the plugin can request adjustments to the
+	// container being created and updates to other unstopped containers in
+	// response.
+	CreateContainerSpec(*api.PodSandbox, *api.Container, *rspec.Spec) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)
+}
+
 // StartContainerInterface handles StartContainer API requests.
 type StartContainerInterface interface {
 	// StartContainer relays a StartContainer event to the plugin.
@@ -135,6 +180,158 @@ type PostUpdateContainerInterface interface {
 	PostUpdateContainer(*api.PodSandbox, *api.Container) error
 }
 
+// CheckpointPodInterface handles CheckpointPodSandbox API events.
+type CheckpointPodInterface interface {
+	// CheckpointPodSandbox relays a CheckpointPodSandbox event to the plugin.
+	CheckpointPodSandbox(*api.PodSandbox) error
+}
+
+// RestorePodInterface handles RestorePodSandbox API events.
+type RestorePodInterface interface {
+	// RestorePodSandbox relays a RestorePodSandbox event to the plugin.
+	RestorePodSandbox(*api.PodSandbox) error
+}
+
+// CheckpointContainerInterface handles CheckpointContainer API events.
+type CheckpointContainerInterface interface {
+	// CheckpointContainer relays a CheckpointContainer event to the plugin.
+	CheckpointContainer(*api.PodSandbox, *api.Container) error
+}
+
+// RestoreContainerInterface handles RestoreContainer API events.
+type RestoreContainerInterface interface {
+	// RestoreContainer relays a RestoreContainer event to the plugin.
+	RestoreContainer(*api.PodSandbox, *api.Container) error
+}
+
+// ImagePulledInterface handles ImagePulled API events.
+type ImagePulledInterface interface {
+	// ImagePulled relays an ImagePulled event to the plugin.
+	ImagePulled(*api.Image) error
+}
+
+// ImageRemovedInterface handles ImageRemoved API events.
+type ImageRemovedInterface interface {
+	// ImageRemoved relays an ImageRemoved event to the plugin.
+	ImageRemoved(*api.Image) error
+}
+
+// PreExecInterface handles PreExec API events.
+type PreExecInterface interface {
+	// PreExec relays a PreExec event to the plugin.
+	PreExec(*api.PodSandbox, *api.Container, *api.ExecSession) error
+}
+
+// PostExecInterface handles PostExec API events.
+type PostExecInterface interface {
+	// PostExec relays a PostExec event to the plugin.
+	PostExec(*api.PodSandbox, *api.Container, *api.ExecSession) error
+}
+
+// ContainerOOMInterface handles ContainerOOM API events.
+type ContainerOOMInterface interface {
+	// ContainerOOM relays a ContainerOOM event to the plugin.
+	ContainerOOM(*api.PodSandbox, *api.Container) error
+}
+
+// ContainerExitInterface handles ContainerExit API events.
+type ContainerExitInterface interface {
+	// ContainerExit relays a ContainerExit event to the plugin.
+	ContainerExit(*api.PodSandbox, *api.Container, *api.ContainerExit) error
+}
+
+// UpdatePodInterface handles UpdatePodSandbox API events.
+type UpdatePodInterface interface {
+	// UpdatePodSandbox relays an UpdatePodSandbox event to the plugin.
+	// The pod carries the current, the second argument the new resources.
+	UpdatePodSandbox(*api.PodSandbox, *api.LinuxResources) error
+}
+
+// ResourcePressureInterface handles ResourcePressure API events.
+type ResourcePressureInterface interface {
+	// ResourcePressure relays a ResourcePressure event to the plugin. The
+	// pod and container are nil for node-wide pressure. Plugins can react
+	// by requesting unsolicited container updates, which they must do after
+	// returning from the handler.
+	ResourcePressure(*api.PodSandbox, *api.Container, *api.Pressure) error
+}
+
+// NetworkStatusInterface handles NetworkStatus API events.
+type NetworkStatusInterface interface {
+	// NetworkStatus relays the readiness of CNI networks to the plugin.
+	// Plugins can use it to avoid admitting pods onto networks which are
+	// not ready.
+	NetworkStatus([]*api.NetworkStatus) error
+}
+
+// NetworkGCInterface handles NetworkGC API events.
+type NetworkGCInterface interface {
+	// NetworkGC relays CNI garbage collection to the plugin, with the
+	// network attachments still in use. Plugins should release any state
+	// they hold for other attachments.
+	NetworkGC([]*api.NetworkAttachment) error
+}
+
+// SetupNetworkFailedInterface handles SetupNetworkFailed API events.
+type SetupNetworkFailedInterface interface {
+	// SetupNetworkFailed relays a failed attempt to set up the network of
+	// a pod to the plugin. The plugin can return changes for the next
+	// attempt, for instance to fall back to a different network, or an
+	// error to fail the pod right away.
+	SetupNetworkFailed(*api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error)
+}
+
+// PostUpdatePodInterface handles PostUpdatePodSandbox API events.
+type PostUpdatePodInterface interface {
+	// PostUpdatePodSandbox relays a PostUpdatePodSandbox event to the plugin.
+	PostUpdatePodSandbox(*api.PodSandbox) error
+}
+
+// PodLifecycleInterface handles all pod lifecycle events.
+type PodLifecycleInterface interface {
+	RunPodInterface
+	StopPodInterface
+	RemovePodInterface
+}
+
+// ContainerAdjusterInterface handles all requests which can adjust or
+// update containers.
+type ContainerAdjusterInterface interface {
+	CreateContainerInterface
+	UpdateContainerInterface
+	StopContainerInterface
+}
+
+// ContainerLifecycleInterface handles all container lifecycle events
+// which cannot adjust or update containers.
+type ContainerLifecycleInterface interface {
+	PostCreateContainerInterface
+	StartContainerInterface
+	PostStartContainerInterface
+	PostUpdateContainerInterface
+	RemoveContainerInterface
+}
+
+// CheckpointInterface handles all checkpoint and restore events.
+type CheckpointInterface interface {
+	CheckpointPodInterface
+	RestorePodInterface
+	CheckpointContainerInterface
+	RestoreContainerInterface
+}
+
+// ImageInterface handles all image events.
+type ImageInterface interface {
+	ImagePulledInterface
+	ImageRemovedInterface
+}
+
+// ExecInterface handles all exec session events.
+type ExecInterface interface {
+	PreExecInterface
+	PostExecInterface
+}
+
 // Stub is the interface the stub provides for the plugin implementation.
 type Stub interface {
 	// Run the plugin. Starts the plugin then waits for an error or the plugin to stop
@@ -148,6 +345,29 @@ type Stub interface {
 
 	// UpdateContainer requests unsolicited updates to containers.
 	UpdateContainers([]*api.ContainerUpdate) ([]*api.ContainerUpdate, error)
+	// ListPlugins lists active plugins in invocation order.
+	ListPlugins() ([]*api.PluginInfo, error)
+	// GetStats returns the current resource usage of a container or pod.
+	GetStats(id string) (*api.Stats, error)
+	// RestartContainer requests restarting, or if stop is set, stopping a
+	// container for the given reason, if the runtime allows it.
+	RestartContainer(id, reason string, stop bool) error
+	// SetNetworkReadiness reports whether the node network is ready, as far
+	// as the plugin can tell, with a reason and message if it is not. The
+	// runtime reports the node network not ready while any plugin does.
+	SetNetworkReadiness(ready bool, reason, message string) error
+	// DeliverCreateContainerResponse delivers the response to a deferred
+	// CreateContainer request, or an error to fail container creation with.
+	DeliverCreateContainerResponse(id string, adjust *api.ContainerAdjustment, update []*api.ContainerUpdate, err error) error
+	// ConfigType returns the format of the configuration passed to the
+	// plugin, like api.ConfigTypeTOML, or an empty string if it is unknown.
+	ConfigType() string
+	// RuntimeBuildInfo returns the version and build information of the
+	// runtime, if the runtime provided it.
+	RuntimeBuildInfo() *api.BuildInfo
+	// Generation returns the generation of the last runtime state seen by
+	// the plugin, to pass to WithLastGeneration when reconnecting.
+	Generation() uint64
 }
 
 const (
@@ -165,6 +385,10 @@ var (
 	// ErrNoService indicates that the stub has no runtime service/connection,
 	// for instance by UpdateContainers on a stub which has not been started.
 	ErrNoService = errors.New("stub: no service/connection")
+
+	// ErrDeferred can be returned by CreateContainer handlers to tell the
+	// runtime that the response will be delivered later.
+	ErrDeferred = errors.New("stub: response deferred")
 )
 
 // EventMask holds a mask of events for plugin subscription.
@@ -192,6 +416,15 @@ func WithPluginName(name string) Option {
 	}
 }
 
+// WithPluginVersion sets the version to use in plugin registration. If it
+// is not set, the version of the main module of the plugin binary is used.
+func WithPluginVersion(version string) Option {
+	return func(s *stub) error {
+		s.version = version
+		return nil
+	}
+}
+
 // WithPluginIdx sets the index to use in plugin registration.
 func WithPluginIdx(idx string) Option {
 	return func(s *stub) error {
@@ -227,6 +460,79 @@ func WithDialer(d func(string) (stdnet.Conn, error)) Option {
 	}
 }
 
+// WithTransport sets the RPC transport to use for talking to the runtime.
+// The runtime detects which transport a plugin uses when it connects.
+func WithTransport(t api.Transport) Option {
+	return func(s *stub) error {
+		switch t {
+		case api.TransportTTRPC, api.TransportGRPC:
+			s.transport = t
+		default:
+			return fmt.Errorf("unknown transport %q", t)
+		}
+		return nil
+	}
+}
+
+// WithCompression enables compression of messages of at least the given
+// size to the runtime, provided that the runtime supports compression.
+func WithCompression(size int) Option {
+	return func(s *stub) error {
+		if size < 0 {
+			return fmt.Errorf("invalid compression size %d", size)
+		}
+		s.compress = size
+		return nil
+	}
+}
+
+// WithFields declares the optional pod and container fields the plugin
+// needs. The runtime omits the other optional fields from the requests and
+// events it sends to the plugin, which can shrink them substantially. If no
+// fields are given, all optional fields are omitted. Without this option
+// the plugin receives all fields.
+func WithFields(fields ...api.Field) Option {
+	return func(s *stub) error {
+		for _, f := range fields {
+			if f <= api.Field_FIELD_UNKNOWN || f >= api.Field_FIELD_LAST {
+				return fmt.Errorf("invalid optional field %d", f)
+			}
+		}
+		s.fields = api.NewFieldMask(fields...)
+		return nil
+	}
+}
+
+// WithScopes declares the adjustment scopes the plugin intends to use. The
+// runtime rejects responses from the plugin which adjust or update anything
+// outside of these scopes. Runtimes which enforce scopes only accept plugins
+// which declare them, and only if all declared scopes have been granted.
+func WithScopes(scopes ...api.Scope) Option {
+	return func(s *stub) error {
+		for _, sc := range scopes {
+			if sc <= api.Scope_SCOPE_UNKNOWN || sc >= api.Scope_SCOPE_LAST {
+				return fmt.Errorf("invalid adjustment scope %d", sc)
+			}
+		}
+		s.scopes = api.NewScopeMask(scopes...)
+		return nil
+	}
+}
+
+// WithSigningKey sets an Ed25519 key to sign responses with. Runtimes can
+// verify signatures to attribute the adjustments and updates they apply to
+// plugins and to detect tampering. Runtimes which have a public key for the
+// plugin reject its responses unless they are properly signed.
+func WithSigningKey(key ed25519.PrivateKey) Option {
+	return func(s *stub) error {
+		if len(key) != ed25519.PrivateKeySize {
+			return fmt.Errorf("invalid Ed25519 private key size %d", len(key))
+		}
+		s.signingKey = key
+		return nil
+	}
+}
+
 // stub implements Stub.
 type stub struct {
 	sync.Mutex
@@ -235,7 +541,14 @@ type stub struct {
 	events     api.EventMask
 	name       string
 	idx        string
+	version    string
 	socketPath string
+	transport  api.Transport
+	compress   int
+	dryRun     bool
+	fields     *api.FieldMask
+	scopes     *api.ScopeMask
+	signingKey ed25519.PrivateKey
 	dialer     func(string) (stdnet.Conn, error)
 	conn       stdnet.Conn
 	onClose    func()
@@ -243,23 +556,39 @@ type stub struct {
 	rpcl       stdnet.Listener
 	rpcs       *ttrpc.Server
 	rpcc       *ttrpc.Client
+	grpcs      *grpc.Server
+	grpcc      *grpc.ClientConn
 	runtime    api.RuntimeService
 	closeOnce  sync.Once
 	started    bool
 	doneC      chan struct{}
 	srvErrC    chan error
 	cfgErrC    chan error
+
+	interceptors   []Interceptor
+	dedup          *dedup
+	limits         map[api.Event]*limiter
+	privileges     *PrivilegeProfile
+	seq            uint64
+	generation     uint64
+	runtimeName    string
+	runtimeVersion string
+	runtimeBuild   *api.BuildInfo
+	configType     string
 }
 
 // Handlers for NRI plugin event and request.
 type handlers struct {
 	Configure           func(string, string, string) (api.EventMask, error)
 	Synchronize         func([]*api.PodSandbox, []*api.Container) ([]*api.ContainerUpdate, error)
+	SynchronizeDelta    func([]*api.PodSandbox, []*api.Container, []string, []string) ([]*api.ContainerUpdate, error)
 	Shutdown            func(*api.ShutdownRequest)
 	RunPodSandbox       func(*api.PodSandbox) error
+	AdjustPodSandbox    func(*api.PodSandbox) (*api.PodSandboxAdjustment, error)
 	StopPodSandbox      func(*api.PodSandbox) error
 	RemovePodSandbox    func(*api.PodSandbox) error
 	CreateContainer     func(*api.PodSandbox, *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)
+	CreateContainerSpec func(*api.PodSandbox, *api.Container, *rspec.Spec) (*api.ContainerAdjustment, []*api.ContainerUpdate, error)
 	StartContainer      func(*api.PodSandbox, *api.Container) error
 	UpdateContainer     func(*api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error)
 	StopContainer       func(*api.PodSandbox, *api.Container) ([]*api.ContainerUpdate, error)
@@ -267,15 +596,41 @@ type handlers struct {
 	PostCreateContainer func(*api.PodSandbox, *api.Container) error
 	PostStartContainer  func(*api.PodSandbox, *api.Container) error
 	PostUpdateContainer func(*api.PodSandbox, *api.Container) error
+
+	CheckpointPodSandbox func(*api.PodSandbox) error
+	RestorePodSandbox    func(*api.PodSandbox) error
+	CheckpointContainer  func(*api.PodSandbox, *api.Container) error
+	RestoreContainer     func(*api.PodSandbox, *api.Container) error
+
+	ImagePulled  func(*api.Image) error
+	ImageRemoved func(*api.Image) error
+
+	PreExec  func(*api.PodSandbox, *api.Container, *api.ExecSession) error
+	PostExec func(*api.PodSandbox, *api.Container, *api.ExecSession) error
+
+	ContainerOOM  func(*api.PodSandbox, *api.Container) error
+	ContainerExit func(*api.PodSandbox, *api.Container, *api.ContainerExit) error
+
+	UpdatePodSandbox     func(*api.PodSandbox, *api.LinuxResources) error
+	PostUpdatePodSandbox func(*api.PodSandbox) error
+
+	ResourcePressure func(*api.PodSandbox, *api.Container, *api.Pressure) error
+
+	NetworkStatus func([]*api.NetworkStatus) error
+	NetworkGC     func([]*api.NetworkAttachment) error
+
+	SetupNetworkFailed func(*api.PodSandbox, *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error)
 }
 
-// New creates a stub with the given plugin and options.
+// New creates a stub with the given plugin and options. The plugin can be
+// nil if all handlers are registered using Handle* options.
 func New(p interface{}, opts ...Option) (Stub, error) {
 	stub := &stub{
 		plugin:     p,
 		name:       os.Getenv(api.PluginNameEnvVar),
 		idx:        os.Getenv(api.PluginIdxEnvVar),
 		socketPath: api.DefaultSocketPath,
+		transport:  api.TransportTTRPC,
 		dialer:     func(p string) (stdnet.Conn, error) { return stdnet.Dial("unix", p) },
 		doneC:      make(chan struct{}),
 	}
@@ -315,6 +670,15 @@ func (stub *stub) Start(ctx context.Context) (retErr error) {
 		return err
 	}
 
+	if stub.privileges != nil {
+		if err = dropPrivileges(stub.privileges); err != nil {
+			stub.conn.Close()
+			stub.conn = nil
+			return fmt.Errorf("failed to drop privileges: %w", err)
+		}
+		log.Infof(ctx, "Dropped privileges of plugin %s", stub.Name())
+	}
+
 	rpcm := multiplex.Multiplex(stub.conn)
 	defer func() {
 		if retErr != nil {
@@ -334,59 +698,88 @@ func (stub *stub) Start(ctx context.Context) (retErr error) {
 		}
 	}()
 
+	conn, err := rpcm.Open(multiplex.RuntimeServiceConn)
+	if err != nil {
+		return fmt.Errorf("failed to multiplex %s client connection: %w", stub.transport, err)
+	}
+
+	stub.rpcm = rpcm
+	stub.rpcl = rpcl
+	stub.srvErrC = make(chan error, 1)
+	stub.cfgErrC = make(chan error, 1)
+
+	if stub.transport == api.TransportGRPC {
+		err = stub.startGRPC(ctx, conn)
+	} else {
+		err = stub.startTTRPC(ctx, conn)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = stub.register(ctx); err != nil {
+		stub.close()
+		return err
+	}
+
+	if err = <-stub.cfgErrC; err != nil {
+		return err
+	}
+
+	log.Infof(ctx, "Started plugin %s...", stub.Name())
+
+	return nil
+}
+
+// Set up ttRPC client and server for talking to the runtime.
+func (stub *stub) startTTRPC(ctx context.Context, conn stdnet.Conn) error {
 	rpcs, err := ttrpc.NewServer()
 	if err != nil {
 		return fmt.Errorf("failed to create ttrpc server: %w", err)
 	}
-	defer func() {
-		if retErr != nil {
-			rpcs.Close()
-			stub.rpcs = nil
-		}
-	}()
 
 	api.RegisterPluginService(rpcs, stub)
 
-	conn, err := rpcm.Open(multiplex.RuntimeServiceConn)
-	if err != nil {
-		return fmt.Errorf("failed to multiplex ttrpc client connection: %w", err)
-	}
 	rpcc := ttrpc.NewClient(conn,
 		ttrpc.WithOnClose(func() {
 			stub.connClosed()
 		}),
 	)
-	defer func() {
-		if retErr != nil {
-			rpcc.Close()
-			stub.rpcc = nil
-		}
-	}()
 
-	stub.srvErrC = make(chan error, 1)
-	stub.cfgErrC = make(chan error, 1)
 	go func() {
-		stub.srvErrC <- rpcs.Serve(ctx, rpcl)
+		stub.srvErrC <- rpcs.Serve(ctx, stub.rpcl)
 		close(stub.doneC)
 	}()
 
-	stub.rpcm = rpcm
-	stub.rpcl = rpcl
 	stub.rpcs = rpcs
 	stub.rpcc = rpcc
-
 	stub.runtime = api.NewRuntimeClient(rpcc)
 
-	if err = stub.register(ctx); err != nil {
-		stub.close()
-		return err
-	}
+	return nil
+}
 
-	if err = <-stub.cfgErrC; err != nil {
-		return err
+// Set up gRPC client and server for talking to the runtime.
+func (stub *stub) startGRPC(ctx context.Context, conn stdnet.Conn) error {
+	grpcc, err := net.NewGRPCClientConn(conn, stub.connClosed)
+	if err != nil {
+		return fmt.Errorf("failed to create gRPC client: %w", err)
 	}
 
-	log.Infof(ctx, "Started plugin %s...", stub.Name())
+	grpcs := grpc.NewServer()
+	api.RegisterPluginGRPCService(grpcs, stub)
+
+	go func() {
+		err := grpcs.Serve(stub.rpcl)
+		if err == nil || err == grpc.ErrServerStopped {
+			err = ttrpc.ErrServerClosed
+		}
+		stub.srvErrC <- err
+		close(stub.doneC)
+	}()
+
+	stub.grpcs = grpcs
+	stub.grpcc = grpcc
+	stub.runtime = api.NewRuntimeGRPCClient(grpcc)
 
 	return nil
 }
@@ -411,6 +804,12 @@ func (stub *stub) close() {
 		if stub.rpcc != nil {
 			stub.rpcc.Close()
 		}
+		if stub.grpcc != nil {
+			stub.grpcc.Close()
+		}
+		if stub.grpcs != nil {
+			stub.grpcs.Stop()
+		}
 		if stub.rpcm != nil {
 			stub.rpcm.Close()
 		}
@@ -495,6 +894,10 @@ func (stub *stub) register(ctx context.Context) error {
 	req := &api.RegisterPluginRequest{
 		PluginName: stub.name,
 		PluginIdx:  stub.idx,
+		Compression: []string{
+			api.CompressionZstd,
+		},
+		BuildInfo: api.GetBuildInfo(stub.version),
 	}
 	if _, err := stub.runtime.RegisterPlugin(ctx, req); err != nil {
 		return fmt.Errorf("failed to register with NRI/Runtime: %w", err)
@@ -535,6 +938,101 @@ func (stub *stub) UpdateContainers(update []*api.ContainerUpdate) ([]*api.Contai
 	return nil, err
 }
 
+// ListPlugins lists active plugins in invocation order.
+func (stub *stub) ListPlugins() ([]*api.PluginInfo, error) {
+	if stub.runtime == nil {
+		return nil, ErrNoService
+	}
+
+	ctx := context.Background()
+	rpl, err := stub.runtime.ListPlugins(ctx, &api.ListPluginsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return rpl.Plugins, nil
+}
+
+// GetStats returns the current resource usage of a container or pod.
+func (stub *stub) GetStats(id string) (*api.Stats, error) {
+	if stub.runtime == nil {
+		return nil, ErrNoService
+	}
+
+	ctx := context.Background()
+	rpl, err := stub.runtime.GetStats(ctx, &api.GetStatsRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	return rpl.Stats, nil
+}
+
+// RestartContainer requests restarting or stopping a container.
+func (stub *stub) RestartContainer(id, reason string, stop bool) error {
+	if stub.runtime == nil {
+		return ErrNoService
+	}
+
+	ctx := context.Background()
+	req := &api.RestartContainerRequest{
+		ContainerId: id,
+		Reason:      reason,
+		Stop:        stop,
+	}
+	_, err := stub.runtime.RestartContainer(ctx, req)
+	return err
+}
+
+// DeliverCreateContainerResponse delivers a deferred CreateContainer response.
+func (stub *stub) DeliverCreateContainerResponse(id string, adjust *api.ContainerAdjustment, update []*api.ContainerUpdate, err error) error {
+	if stub.runtime == nil {
+		return ErrNoService
+	}
+
+	ctx := context.Background()
+	req := &api.DeliverCreateContainerResponseRequest{
+		ContainerId: id,
+	}
+	if err != nil {
+		req.ErrorDetails, req.Error = api.ToErrorDetails(err)
+	} else {
+		req.Response = &api.CreateContainerResponse{
+			Adjust: adjust,
+			Update: update,
+		}
+		if err := stub.sign(id, req.Response, nil); err != nil {
+			return err
+		}
+	}
+	_, err = stub.runtime.DeliverCreateContainerResponse(ctx, req)
+	return err
+}
+
+// ConfigType returns the format of the plugin configuration, if known.
+func (stub *stub) ConfigType() string {
+	return stub.configType
+}
+
+// RuntimeBuildInfo returns the version and build information of the runtime.
+func (stub *stub) RuntimeBuildInfo() *api.BuildInfo {
+	return stub.runtimeBuild
+}
+
+// SetNetworkReadiness reports whether the node network is ready.
+func (stub *stub) SetNetworkReadiness(ready bool, reason, message string) error {
+	if stub.runtime == nil {
+		return ErrNoService
+	}
+
+	ctx := context.Background()
+	req := &api.SetNetworkReadinessRequest{
+		Ready:   ready,
+		Reason:  reason,
+		Message: message,
+	}
+	_, err := stub.runtime.SetNetworkReadiness(ctx, req)
+	return err
+}
+
 // Configure the plugin.
 func (stub *stub) Configure(ctx context.Context, req *api.ConfigureRequest) (rpl *api.ConfigureResponse, retErr error) {
 	var (
@@ -542,17 +1040,30 @@ func (stub *stub) Configure(ctx context.Context, req *api.ConfigureRequest) (rpl
 		err    error
 	)
 
-	log.Infof(ctx, "Configuring plugin %s for runtime %s/%s...", stub.Name(),
-		req.RuntimeName, req.RuntimeVersion)
+	log.Infof(ctx, "Configuring plugin %s for runtime %s/%s, build %s...", stub.Name(),
+		req.RuntimeName, req.RuntimeVersion, req.RuntimeBuildInfo.Summary())
 
 	defer func() {
 		stub.cfgErrC <- retErr
 	}()
 
+	if stub.compress > 0 && api.HasCompression(req.Compression, api.CompressionZstd) {
+		stub.rpcm.SetCompression(stub.compress)
+	}
+
+	stub.runtimeName = req.RuntimeName
+	stub.runtimeVersion = req.RuntimeVersion
+	stub.runtimeBuild = req.RuntimeBuildInfo
+	stub.configType = req.ConfigType
+
 	if handler := stub.handlers.Configure; handler == nil {
 		events = stub.events
 	} else {
-		events, err = handler(req.Config, req.RuntimeName, req.RuntimeVersion)
+		err = stub.handle(ctx, "Configure", 0, func() error {
+			var err error
+			events, err = handler(req.Config, req.RuntimeName, req.RuntimeVersion)
+			return err
+		})
 		if err != nil {
 			log.Errorf(ctx, "Plugin configuration failed: %v", err)
 			return nil, err
@@ -564,28 +1075,64 @@ func (stub *stub) Configure(ctx context.Context, req *api.ConfigureRequest) (rpl
 
 		// Only allow plugins to subscribe to events they can handle.
 		if extra := events & ^stub.events; extra != 0 {
-			log.Errorf(ctx, "Plugin subscribed for unhandled events %s (0x%x)",
-				extra.PrettyString(), extra)
-			return nil, fmt.Errorf("internal error: unhandled events %s (0x%x)",
-				extra.PrettyString(), extra)
+			err = unhandledEventsError(stub.plugin, extra)
+			log.Errorf(ctx, "Plugin configuration failed: %v", err)
+			return nil, err
 		}
 
 		log.Infof(ctx, "Subscribing plugin %s (%s) for events %s", stub.Name(),
 			filepath.Base(os.Args[0]), events.PrettyString())
 	}
 
-	return &api.ConfigureResponse{
-		Events: int32(events),
-	}, nil
+	rpl = &api.ConfigureResponse{
+		Events:         int32(events),
+		WantOciSpec:    stub.handlers.CreateContainerSpec != nil,
+		SupportsDryRun: stub.dryRun,
+		Fields:         stub.fields,
+		Scopes:         stub.scopes,
+	}
+	if stub.handlers.SynchronizeDelta != nil {
+		rpl.LastGeneration = stub.Generation()
+	}
+
+	return rpl, nil
 }
 
 // Synchronize the state of the plugin with the runtime.
 func (stub *stub) Synchronize(ctx context.Context, req *api.SynchronizeRequest) (*api.SynchronizeResponse, error) {
+	stub.seen(req.Generation)
+
+	if req.Delta {
+		return stub.synchronizeDelta(ctx, req)
+	}
+
 	handler := stub.handlers.Synchronize
 	if handler == nil {
 		return &api.SynchronizeResponse{}, nil
 	}
-	update, err := handler(req.Pods, req.Containers)
+	var update []*api.ContainerUpdate
+	err := stub.handle(ctx, "Synchronize", 0, func() error {
+		var err error
+		update, err = handler(req.Pods, req.Containers)
+		return err
+	})
+	return &api.SynchronizeResponse{
+		Update: update,
+	}, err
+}
+
+// Delta synchronize the state of the plugin with the runtime.
+func (stub *stub) synchronizeDelta(ctx context.Context, req *api.SynchronizeRequest) (*api.SynchronizeResponse, error) {
+	handler := stub.handlers.SynchronizeDelta
+	if handler == nil {
+		return nil, fmt.Errorf("plugin %s cannot handle delta synchronization", stub.Name())
+	}
+	var update []*api.ContainerUpdate
+	err := stub.handle(ctx, "Synchronize", 0, func() error {
+		var err error
+		update, err = handler(req.Pods, req.Containers, req.RemovedPods, req.RemovedContainers)
+		return err
+	})
 	return &api.SynchronizeResponse{
 		Update: update,
 	}, err
@@ -595,87 +1142,276 @@ func (stub *stub) Synchronize(ctx context.Context, req *api.SynchronizeRequest)
 func (stub *stub) Shutdown(ctx context.Context, req *api.ShutdownRequest) (*api.ShutdownResponse, error) {
 	handler := stub.handlers.Shutdown
 	if handler != nil {
-		handler(req)
+		_ = stub.handle(ctx, "Shutdown", 0, func() error {
+			handler(req)
+			return nil
+		})
 	}
 	return &api.ShutdownResponse{}, nil
 }
 
 // CreateContainer request handler.
 func (stub *stub) CreateContainer(ctx context.Context, req *api.CreateContainerRequest) (*api.CreateContainerResponse, error) {
+	stub.seen(req.Generation)
+	ctx, err := stub.checkDryRun(ctx, req.DryRun)
+	if err != nil {
+		return nil, api.ToStatusError(err)
+	}
+	if stub.handlers.CreateContainerSpec != nil {
+		return stub.createContainerSpec(ctx, req)
+	}
 	handler := stub.handlers.CreateContainer
 	if handler == nil {
 		return nil, nil
 	}
-	adjust, update, err := handler(req.Pod, req.Container)
-	return &api.CreateContainerResponse{
-		Adjust: adjust,
-		Update: update,
-	}, err
+	var (
+		data   = api.DupStringMap(req.Pod.GetPluginData())
+		adjust *api.ContainerAdjustment
+		update []*api.ContainerUpdate
+	)
+	err = stub.handle(ctx, "CreateContainer", api.Event_CREATE_CONTAINER, func() error {
+		var err error
+		adjust, update, err = handler(req.Pod, req.Container)
+		return err
+	})
+	if errors.Is(err, ErrDeferred) {
+		rpl := &api.CreateContainerResponse{Deferred: true}
+		return rpl, stub.sign(req.GetContainer().GetId(), rpl, nil)
+	}
+	rpl := &api.CreateContainerResponse{
+		Adjust:     adjust,
+		Update:     update,
+		PluginData: api.DiffPluginData(data, req.Pod.GetPluginData()),
+	}
+	return rpl, stub.sign(req.GetContainer().GetId(), rpl, err)
+}
+
+// CreateContainer request handler for plugins which want the OCI Spec.
+func (stub *stub) createContainerSpec(ctx context.Context, req *api.CreateContainerRequest) (*api.CreateContainerResponse, error) {
+	spec, err := req.OCISpec()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		data   = api.DupStringMap(req.Pod.GetPluginData())
+		adjust *api.ContainerAdjustment
+		update []*api.ContainerUpdate
+	)
+	err = stub.handle(ctx, "CreateContainer", api.Event_CREATE_CONTAINER, func() error {
+		var err error
+		adjust, update, err = stub.handlers.CreateContainerSpec(req.Pod, req.Container, spec)
+		return err
+	})
+	if errors.Is(err, ErrDeferred) {
+		rpl := &api.CreateContainerResponse{Deferred: true}
+		return rpl, stub.sign(req.GetContainer().GetId(), rpl, nil)
+	}
+	rpl := &api.CreateContainerResponse{
+		Adjust:     adjust,
+		Update:     update,
+		PluginData: api.DiffPluginData(data, req.Pod.GetPluginData()),
+	}
+	return rpl, stub.sign(req.GetContainer().GetId(), rpl, err)
 }
 
 // UpdateContainer request handler.
 func (stub *stub) UpdateContainer(ctx context.Context, req *api.UpdateContainerRequest) (*api.UpdateContainerResponse, error) {
+	stub.seen(req.Generation)
 	handler := stub.handlers.UpdateContainer
 	if handler == nil {
 		return nil, nil
 	}
-	update, err := handler(req.Pod, req.Container)
-	return &api.UpdateContainerResponse{
-		Update: update,
-	}, err
+	var (
+		data   = api.DupStringMap(req.Pod.GetPluginData())
+		update []*api.ContainerUpdate
+	)
+	err := stub.handle(ctx, "UpdateContainer", api.Event_UPDATE_CONTAINER, func() error {
+		var err error
+		update, err = handler(req.Pod, req.Container)
+		return err
+	})
+	rpl := &api.UpdateContainerResponse{
+		Update:     update,
+		PluginData: api.DiffPluginData(data, req.Pod.GetPluginData()),
+	}
+	return rpl, stub.sign(req.GetContainer().GetId(), rpl, err)
 }
 
 // StopContainer request handler.
 func (stub *stub) StopContainer(ctx context.Context, req *api.StopContainerRequest) (*api.StopContainerResponse, error) {
+	stub.seen(req.Generation)
 	handler := stub.handlers.StopContainer
 	if handler == nil {
 		return nil, nil
 	}
-	update, err := handler(req.Pod, req.Container)
-	return &api.StopContainerResponse{
-		Update: update,
-	}, err
+	var (
+		data   = api.DupStringMap(req.Pod.GetPluginData())
+		update []*api.ContainerUpdate
+	)
+	err := stub.handle(ctx, "StopContainer", api.Event_STOP_CONTAINER, func() error {
+		var err error
+		update, err = handler(req.Pod, req.Container)
+		return err
+	})
+	rpl := &api.StopContainerResponse{
+		Update:     update,
+		PluginData: api.DiffPluginData(data, req.Pod.GetPluginData()),
+	}
+	return rpl, stub.sign(req.GetContainer().GetId(), rpl, err)
 }
 
 // StateChange event handler.
-func (stub *stub) StateChange(ctx context.Context, evt *api.StateChangeEvent) (*api.Empty, error) {
-	var err error
-	switch evt.Event {
-	case api.Event_RUN_POD_SANDBOX:
-		if handler := stub.handlers.RunPodSandbox; handler != nil {
-			err = handler(evt.Pod)
-		}
-	case api.Event_STOP_POD_SANDBOX:
-		if handler := stub.handlers.StopPodSandbox; handler != nil {
-			err = handler(evt.Pod)
-		}
-	case api.Event_REMOVE_POD_SANDBOX:
-		if handler := stub.handlers.RemovePodSandbox; handler != nil {
-			err = handler(evt.Pod)
-		}
-	case api.Event_POST_CREATE_CONTAINER:
-		if handler := stub.handlers.PostCreateContainer; handler != nil {
-			err = handler(evt.Pod, evt.Container)
-		}
-	case api.Event_START_CONTAINER:
-		if handler := stub.handlers.StartContainer; handler != nil {
-			err = handler(evt.Pod, evt.Container)
-		}
-	case api.Event_POST_START_CONTAINER:
-		if handler := stub.handlers.PostStartContainer; handler != nil {
-			err = handler(evt.Pod, evt.Container)
-		}
-	case api.Event_POST_UPDATE_CONTAINER:
-		if handler := stub.handlers.PostUpdateContainer; handler != nil {
-			err = handler(evt.Pod, evt.Container)
+func (stub *stub) StateChange(ctx context.Context, evt *api.StateChangeEvent) (*api.StateChangeResponse, error) {
+	var (
+		adjust *api.PodSandboxAdjustment
+		retry  *api.NetworkSetupRetry
+		err    error
+	)
+
+	stub.seen(evt.Generation)
+
+	ctx, err = stub.checkDryRun(ctx, evt.DryRun)
+	if err != nil {
+		return nil, api.ToStatusError(err)
+	}
+
+	var dedupKey string
+	if !evt.DryRun {
+		var dup bool
+		if dedupKey, dup = stub.dedup.check(evt); dup {
+			log.Infof(ctx, "Skipping duplicate %s event", evt.Event)
+			rpl := &api.StateChangeResponse{}
+			return rpl, stub.sign(evt.GetPod().GetId(), rpl, nil)
 		}
-	case api.Event_REMOVE_CONTAINER:
-		if handler := stub.handlers.RemoveContainer; handler != nil {
-			err = handler(evt.Pod, evt.Container)
+	}
+
+	data := api.DupStringMap(evt.Pod.GetPluginData())
+	err = stub.handle(ctx, "StateChange", evt.Event, func() error {
+		switch evt.Event {
+		case api.Event_RUN_POD_SANDBOX:
+			if handler := stub.handlers.RunPodSandbox; handler != nil {
+				err = handler(evt.Pod)
+			}
+			if handler := stub.handlers.AdjustPodSandbox; handler != nil && err == nil {
+				adjust, err = handler(evt.Pod)
+			}
+		case api.Event_STOP_POD_SANDBOX:
+			if handler := stub.handlers.StopPodSandbox; handler != nil {
+				err = handler(evt.Pod)
+			}
+		case api.Event_REMOVE_POD_SANDBOX:
+			if handler := stub.handlers.RemovePodSandbox; handler != nil {
+				err = handler(evt.Pod)
+			}
+		case api.Event_POST_CREATE_CONTAINER:
+			if handler := stub.handlers.PostCreateContainer; handler != nil {
+				err = handler(evt.Pod, evt.Container)
+			}
+		case api.Event_START_CONTAINER:
+			if handler := stub.handlers.StartContainer; handler != nil {
+				err = handler(evt.Pod, evt.Container)
+			}
+		case api.Event_POST_START_CONTAINER:
+			if handler := stub.handlers.PostStartContainer; handler != nil {
+				err = handler(evt.Pod, evt.Container)
+			}
+		case api.Event_POST_UPDATE_CONTAINER:
+			if handler := stub.handlers.PostUpdateContainer; handler != nil {
+				err = handler(evt.Pod, evt.Container)
+			}
+		case api.Event_REMOVE_CONTAINER:
+			if handler := stub.handlers.RemoveContainer; handler != nil {
+				err = handler(evt.Pod, evt.Container)
+			}
+		case api.Event_CHECKPOINT_POD_SANDBOX:
+			if handler := stub.handlers.CheckpointPodSandbox; handler != nil {
+				err = handler(evt.Pod)
+			}
+		case api.Event_RESTORE_POD_SANDBOX:
+			if handler := stub.handlers.RestorePodSandbox; handler != nil {
+				err = handler(evt.Pod)
+			}
+		case api.Event_CHECKPOINT_CONTAINER:
+			if handler := stub.handlers.CheckpointContainer; handler != nil {
+				err = handler(evt.Pod, evt.Container)
+			}
+		case api.Event_RESTORE_CONTAINER:
+			if handler := stub.handlers.RestoreContainer; handler != nil {
+				err = handler(evt.Pod, evt.Container)
+			}
+		case api.Event_IMAGE_PULLED:
+			if handler := stub.handlers.ImagePulled; handler != nil {
+				err = handler(evt.Image)
+			}
+		case api.Event_IMAGE_REMOVED:
+			if handler := stub.handlers.ImageRemoved; handler != nil {
+				err = handler(evt.Image)
+			}
+		case api.Event_PRE_EXEC:
+			if handler := stub.handlers.PreExec; handler != nil {
+				err = handler(evt.Pod, evt.Container, evt.Exec)
+			}
+		case api.Event_POST_EXEC:
+			if handler := stub.handlers.PostExec; handler != nil {
+				err = handler(evt.Pod, evt.Container, evt.Exec)
+			}
+		case api.Event_CONTAINER_OOM:
+			if handler := stub.handlers.ContainerOOM; handler != nil {
+				err = handler(evt.Pod, evt.Container)
+			}
+		case api.Event_CONTAINER_EXIT:
+			if handler := stub.handlers.ContainerExit; handler != nil {
+				err = handler(evt.Pod, evt.Container, evt.Exit)
+			}
+		case api.Event_UPDATE_POD_SANDBOX:
+			if handler := stub.handlers.UpdatePodSandbox; handler != nil {
+				err = handler(evt.Pod, evt.PodResources)
+			}
+		case api.Event_POST_UPDATE_POD_SANDBOX:
+			if handler := stub.handlers.PostUpdatePodSandbox; handler != nil {
+				err = handler(evt.Pod)
+			}
+		case api.Event_RESOURCE_PRESSURE:
+			if handler := stub.handlers.ResourcePressure; handler != nil {
+				err = handler(evt.Pod, evt.Container, evt.Pressure)
+			}
+		case api.Event_NETWORK_STATUS:
+			if handler := stub.handlers.NetworkStatus; handler != nil {
+				err = handler(evt.Networks)
+			}
+		case api.Event_NETWORK_GC:
+			if handler := stub.handlers.NetworkGC; handler != nil {
+				err = handler(evt.Attachments)
+			}
+		case api.Event_SETUP_NETWORK_FAILED:
+			if handler := stub.handlers.SetupNetworkFailed; handler != nil {
+				retry, err = handler(evt.Pod, evt.NetworkFailure)
+			}
 		}
+		return err
+	})
+	if err == nil {
+		stub.dedup.record(dedupKey)
 	}
 
-	return &api.StateChangeResponse{}, err
+	rpl := &api.StateChangeResponse{
+		PluginData: api.DiffPluginData(data, evt.Pod.GetPluginData()),
+		Adjust:     adjust,
+		Retry:      retry,
+	}
+	return rpl, stub.sign(evt.GetPod().GetId(), rpl, err)
+}
+
+// sign signs a response, if the plugin has a signing key and the request
+// was successfully handled.
+func (stub *stub) sign(subject string, rpl api.SignedResponse, err error) error {
+	if err != nil || stub.signingKey == nil {
+		return err
+	}
+	if err := api.SignResponse(stub.signingKey, subject, rpl); err != nil {
+		return fmt.Errorf("failed to sign response: %w", err)
+	}
+	return nil
 }
 
 // getIdentity gets plugin index and name from the binary if those are unset.
@@ -700,62 +1436,187 @@ func (stub *stub) getIdentity() error {
 	return nil
 }
 
+// eventHandlers lists the interfaces plugins implement to handle events.
+var eventHandlers = map[api.Event]string{
+	api.Event_RUN_POD_SANDBOX:         "RunPodInterface or AdjustPodInterface",
+	api.Event_STOP_POD_SANDBOX:        "StopPodInterface",
+	api.Event_REMOVE_POD_SANDBOX:      "RemovePodInterface",
+	api.Event_CREATE_CONTAINER:        "CreateContainerInterface or CreateContainerSpecInterface",
+	api.Event_POST_CREATE_CONTAINER:   "PostCreateContainerInterface",
+	api.Event_START_CONTAINER:         "StartContainerInterface",
+	api.Event_POST_START_CONTAINER:    "PostStartContainerInterface",
+	api.Event_UPDATE_CONTAINER:        "UpdateContainerInterface",
+	api.Event_POST_UPDATE_CONTAINER:   "PostUpdateContainerInterface",
+	api.Event_STOP_CONTAINER:          "StopContainerInterface",
+	api.Event_REMOVE_CONTAINER:        "RemoveContainerInterface",
+	api.Event_CHECKPOINT_POD_SANDBOX:  "CheckpointPodInterface",
+	api.Event_RESTORE_POD_SANDBOX:     "RestorePodInterface",
+	api.Event_CHECKPOINT_CONTAINER:    "CheckpointContainerInterface",
+	api.Event_RESTORE_CONTAINER:       "RestoreContainerInterface",
+	api.Event_IMAGE_PULLED:            "ImagePulledInterface",
+	api.Event_IMAGE_REMOVED:           "ImageRemovedInterface",
+	api.Event_PRE_EXEC:                "PreExecInterface",
+	api.Event_POST_EXEC:               "PostExecInterface",
+	api.Event_CONTAINER_OOM:           "ContainerOOMInterface",
+	api.Event_CONTAINER_EXIT:          "ContainerExitInterface",
+	api.Event_UPDATE_POD_SANDBOX:      "UpdatePodInterface",
+	api.Event_POST_UPDATE_POD_SANDBOX: "PostUpdatePodInterface",
+	api.Event_RESOURCE_PRESSURE:       "ResourcePressureInterface",
+	api.Event_NETWORK_STATUS:          "NetworkStatusInterface",
+	api.Event_NETWORK_GC:              "NetworkGCInterface",
+	api.Event_SETUP_NETWORK_FAILED:    "SetupNetworkFailedInterface",
+}
+
+// unhandledEventsError returns an error telling which interfaces a plugin
+// should implement to handle the events it tried to subscribe to.
+func unhandledEventsError(plugin interface{}, events api.EventMask) error {
+	missing := []string{}
+	for e := api.Event_RUN_POD_SANDBOX; e < api.Event_LAST; e++ {
+		if !events.IsSet(e) {
+			continue
+		}
+		handler, ok := eventHandlers[e]
+		if !ok {
+			handler = "unknown interface"
+		}
+		missing = append(missing, fmt.Sprintf("%s (needs %s)", e, handler))
+	}
+
+	return fmt.Errorf("plugin %T subscribed to events it does not handle: %s",
+		plugin, strings.Join(missing, ", "))
+}
+
 // Set up event handlers and the subscription mask for the plugin.
 func (stub *stub) setupHandlers() error {
-	if plugin, ok := stub.plugin.(ConfigureInterface); ok {
+	if plugin, ok := stub.plugin.(ConfigureInterface); ok && stub.handlers.Configure == nil {
 		stub.handlers.Configure = plugin.Configure
 	}
-	if plugin, ok := stub.plugin.(SynchronizeInterface); ok {
+	if plugin, ok := stub.plugin.(SynchronizeInterface); ok && stub.handlers.Synchronize == nil {
 		stub.handlers.Synchronize = plugin.Synchronize
 	}
-	if plugin, ok := stub.plugin.(ShutdownInterface); ok {
+	if plugin, ok := stub.plugin.(SynchronizeDeltaInterface); ok && stub.handlers.SynchronizeDelta == nil {
+		stub.handlers.SynchronizeDelta = plugin.SynchronizeDelta
+	}
+	if plugin, ok := stub.plugin.(ShutdownInterface); ok && stub.handlers.Shutdown == nil {
 		stub.handlers.Shutdown = plugin.Shutdown
 	}
 
-	if plugin, ok := stub.plugin.(RunPodInterface); ok {
+	if plugin, ok := stub.plugin.(RunPodInterface); ok && stub.handlers.RunPodSandbox == nil {
 		stub.handlers.RunPodSandbox = plugin.RunPodSandbox
 		stub.events.Set(api.Event_RUN_POD_SANDBOX)
 	}
-	if plugin, ok := stub.plugin.(StopPodInterface); ok {
+	if plugin, ok := stub.plugin.(AdjustPodInterface); ok && stub.handlers.AdjustPodSandbox == nil {
+		stub.handlers.AdjustPodSandbox = plugin.AdjustPodSandbox
+		stub.events.Set(api.Event_RUN_POD_SANDBOX)
+	}
+	if plugin, ok := stub.plugin.(StopPodInterface); ok && stub.handlers.StopPodSandbox == nil {
 		stub.handlers.StopPodSandbox = plugin.StopPodSandbox
 		stub.events.Set(api.Event_STOP_POD_SANDBOX)
 	}
-	if plugin, ok := stub.plugin.(RemovePodInterface); ok {
+	if plugin, ok := stub.plugin.(RemovePodInterface); ok && stub.handlers.RemovePodSandbox == nil {
 		stub.handlers.RemovePodSandbox = plugin.RemovePodSandbox
 		stub.events.Set(api.Event_REMOVE_POD_SANDBOX)
 	}
-	if plugin, ok := stub.plugin.(CreateContainerInterface); ok {
+	if plugin, ok := stub.plugin.(CreateContainerInterface); ok && stub.handlers.CreateContainer == nil {
 		stub.handlers.CreateContainer = plugin.CreateContainer
 		stub.events.Set(api.Event_CREATE_CONTAINER)
 	}
-	if plugin, ok := stub.plugin.(StartContainerInterface); ok {
+	if plugin, ok := stub.plugin.(CreateContainerSpecInterface); ok && stub.handlers.CreateContainerSpec == nil {
+		stub.handlers.CreateContainerSpec = plugin.CreateContainerSpec
+		stub.events.Set(api.Event_CREATE_CONTAINER)
+	}
+	if plugin, ok := stub.plugin.(StartContainerInterface); ok && stub.handlers.StartContainer == nil {
 		stub.handlers.StartContainer = plugin.StartContainer
 		stub.events.Set(api.Event_START_CONTAINER)
 	}
-	if plugin, ok := stub.plugin.(UpdateContainerInterface); ok {
+	if plugin, ok := stub.plugin.(UpdateContainerInterface); ok && stub.handlers.UpdateContainer == nil {
 		stub.handlers.UpdateContainer = plugin.UpdateContainer
 		stub.events.Set(api.Event_UPDATE_CONTAINER)
 	}
-	if plugin, ok := stub.plugin.(StopContainerInterface); ok {
+	if plugin, ok := stub.plugin.(StopContainerInterface); ok && stub.handlers.StopContainer == nil {
 		stub.handlers.StopContainer = plugin.StopContainer
 		stub.events.Set(api.Event_STOP_CONTAINER)
 	}
-	if plugin, ok := stub.plugin.(RemoveContainerInterface); ok {
+	if plugin, ok := stub.plugin.(RemoveContainerInterface); ok && stub.handlers.RemoveContainer == nil {
 		stub.handlers.RemoveContainer = plugin.RemoveContainer
 		stub.events.Set(api.Event_REMOVE_CONTAINER)
 	}
-	if plugin, ok := stub.plugin.(PostCreateContainerInterface); ok {
+	if plugin, ok := stub.plugin.(PostCreateContainerInterface); ok && stub.handlers.PostCreateContainer == nil {
 		stub.handlers.PostCreateContainer = plugin.PostCreateContainer
 		stub.events.Set(api.Event_POST_CREATE_CONTAINER)
 	}
-	if plugin, ok := stub.plugin.(PostStartContainerInterface); ok {
+	if plugin, ok := stub.plugin.(PostStartContainerInterface); ok && stub.handlers.PostStartContainer == nil {
 		stub.handlers.PostStartContainer = plugin.PostStartContainer
 		stub.events.Set(api.Event_POST_START_CONTAINER)
 	}
-	if plugin, ok := stub.plugin.(PostUpdateContainerInterface); ok {
+	if plugin, ok := stub.plugin.(PostUpdateContainerInterface); ok && stub.handlers.PostUpdateContainer == nil {
 		stub.handlers.PostUpdateContainer = plugin.PostUpdateContainer
 		stub.events.Set(api.Event_POST_UPDATE_CONTAINER)
 	}
+	if plugin, ok := stub.plugin.(CheckpointPodInterface); ok && stub.handlers.CheckpointPodSandbox == nil {
+		stub.handlers.CheckpointPodSandbox = plugin.CheckpointPodSandbox
+		stub.events.Set(api.Event_CHECKPOINT_POD_SANDBOX)
+	}
+	if plugin, ok := stub.plugin.(RestorePodInterface); ok && stub.handlers.RestorePodSandbox == nil {
+		stub.handlers.RestorePodSandbox = plugin.RestorePodSandbox
+		stub.events.Set(api.Event_RESTORE_POD_SANDBOX)
+	}
+	if plugin, ok := stub.plugin.(CheckpointContainerInterface); ok && stub.handlers.CheckpointContainer == nil {
+		stub.handlers.CheckpointContainer = plugin.CheckpointContainer
+		stub.events.Set(api.Event_CHECKPOINT_CONTAINER)
+	}
+	if plugin, ok := stub.plugin.(RestoreContainerInterface); ok && stub.handlers.RestoreContainer == nil {
+		stub.handlers.RestoreContainer = plugin.RestoreContainer
+		stub.events.Set(api.Event_RESTORE_CONTAINER)
+	}
+	if plugin, ok := stub.plugin.(ImagePulledInterface); ok && stub.handlers.ImagePulled == nil {
+		stub.handlers.ImagePulled = plugin.ImagePulled
+		stub.events.Set(api.Event_IMAGE_PULLED)
+	}
+	if plugin, ok := stub.plugin.(ImageRemovedInterface); ok && stub.handlers.ImageRemoved == nil {
+		stub.handlers.ImageRemoved = plugin.ImageRemoved
+		stub.events.Set(api.Event_IMAGE_REMOVED)
+	}
+	if plugin, ok := stub.plugin.(PreExecInterface); ok && stub.handlers.PreExec == nil {
+		stub.handlers.PreExec = plugin.PreExec
+		stub.events.Set(api.Event_PRE_EXEC)
+	}
+	if plugin, ok := stub.plugin.(PostExecInterface); ok && stub.handlers.PostExec == nil {
+		stub.handlers.PostExec = plugin.PostExec
+		stub.events.Set(api.Event_POST_EXEC)
+	}
+	if plugin, ok := stub.plugin.(ContainerOOMInterface); ok && stub.handlers.ContainerOOM == nil {
+		stub.handlers.ContainerOOM = plugin.ContainerOOM
+		stub.events.Set(api.Event_CONTAINER_OOM)
+	}
+	if plugin, ok := stub.plugin.(ContainerExitInterface); ok && stub.handlers.ContainerExit == nil {
+		stub.handlers.ContainerExit = plugin.ContainerExit
+		stub.events.Set(api.Event_CONTAINER_EXIT)
+	}
+	if plugin, ok := stub.plugin.(UpdatePodInterface); ok && stub.handlers.UpdatePodSandbox == nil {
+		stub.handlers.UpdatePodSandbox = plugin.UpdatePodSandbox
+		stub.events.Set(api.Event_UPDATE_POD_SANDBOX)
+	}
+	if plugin, ok := stub.plugin.(PostUpdatePodInterface); ok && stub.handlers.PostUpdatePodSandbox == nil {
+		stub.handlers.PostUpdatePodSandbox = plugin.PostUpdatePodSandbox
+		stub.events.Set(api.Event_POST_UPDATE_POD_SANDBOX)
+	}
+	if plugin, ok := stub.plugin.(ResourcePressureInterface); ok && stub.handlers.ResourcePressure == nil {
+		stub.handlers.ResourcePressure = plugin.ResourcePressure
+		stub.events.Set(api.Event_RESOURCE_PRESSURE)
+	}
+	if plugin, ok := stub.plugin.(NetworkStatusInterface); ok && stub.handlers.NetworkStatus == nil {
+		stub.handlers.NetworkStatus = plugin.NetworkStatus
+		stub.events.Set(api.Event_NETWORK_STATUS)
+	}
+	if plugin, ok := stub.plugin.(NetworkGCInterface); ok && stub.handlers.NetworkGC == nil {
+		stub.handlers.NetworkGC = plugin.NetworkGC
+		stub.events.Set(api.Event_NETWORK_GC)
+	}
+	if plugin, ok := stub.plugin.(SetupNetworkFailedInterface); ok && stub.handlers.SetupNetworkFailed == nil {
+		stub.handlers.SetupNetworkFailed = plugin.SetupNetworkFailed
+		stub.events.Set(api.Event_SETUP_NETWORK_FAILED)
+	}
 
 	if stub.events == 0 {
 		return fmt.Errorf("internal error: plugin %T does not implement any NRI request handlers",
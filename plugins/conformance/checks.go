@@ -0,0 +1,186 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"github.com/containerd/nri/pkg/api"
+)
+
+// check is an adjustment the plugin makes to containers being created, and
+// a way to verify that the runtime applied it from container data the
+// runtime passes to the plugin in later events.
+type check struct {
+	name   string
+	adjust func(*api.ContainerAdjustment)
+	verify func(*api.Container) bool
+}
+
+// podCheck is a check for adjustments to pods being created.
+type podCheck struct {
+	name   string
+	adjust func(*api.PodSandboxAdjustment)
+	verify func(*api.PodSandbox) bool
+}
+
+const (
+	checkKey         = "conformance.nri.io/check"
+	checkValue       = "nri-conformance"
+	checkEnv         = "NRI_CONFORMANCE"
+	checkMountPath   = "/nri-conformance"
+	checkHookPath    = "/bin/true"
+	checkRlimit      = "RLIMIT_NOFILE"
+	checkRlimitValue = 65536
+	checkMemoryLimit = 1 << 30
+	checkCPUShares   = 1000
+	checkCPUSetMems  = "0"
+)
+
+var checks = []*check{
+	{
+		name:   "Annotations",
+		adjust: func(a *api.ContainerAdjustment) { a.AddAnnotation(checkKey, checkValue) },
+		verify: func(c *api.Container) bool { return c.GetAnnotations()[checkKey] == checkValue },
+	},
+	{
+		name:   "Labels",
+		adjust: func(a *api.ContainerAdjustment) { a.AddLabel(checkKey, checkValue) },
+		verify: func(c *api.Container) bool { return c.GetLabels()[checkKey] == checkValue },
+	},
+	{
+		name:   "Env",
+		adjust: func(a *api.ContainerAdjustment) { a.AddEnv(checkEnv, checkValue) },
+		verify: func(c *api.Container) bool {
+			for _, e := range c.GetEnv() {
+				if e == checkEnv+"="+checkValue {
+					return true
+				}
+			}
+			return false
+		},
+	},
+	{
+		name: "Mounts",
+		adjust: func(a *api.ContainerAdjustment) {
+			a.AddMount(&api.Mount{
+				Destination: checkMountPath,
+				Type:        "tmpfs",
+				Source:      "tmpfs",
+				Options:     []string{"nosuid", "nodev", "noexec"},
+			})
+		},
+		verify: func(c *api.Container) bool {
+			for _, m := range c.GetMounts() {
+				if m.Destination == checkMountPath {
+					return true
+				}
+			}
+			return false
+		},
+	},
+	{
+		name: "Hooks",
+		adjust: func(a *api.ContainerAdjustment) {
+			a.AddHooks(&api.Hooks{
+				Poststop: []*api.Hook{{Path: checkHookPath}},
+			})
+		},
+		verify: func(c *api.Container) bool {
+			for _, h := range c.GetHooks().GetPoststop() {
+				if h.Path == checkHookPath {
+					return true
+				}
+			}
+			return false
+		},
+	},
+	{
+		name:   "Rlimits",
+		adjust: func(a *api.ContainerAdjustment) { a.AddRlimit(checkRlimit, checkRlimitValue, checkRlimitValue) },
+		verify: func(c *api.Container) bool {
+			for _, rl := range c.GetRlimits() {
+				if rl.Type == checkRlimit && rl.Hard == checkRlimitValue && rl.Soft == checkRlimitValue {
+					return true
+				}
+			}
+			return false
+		},
+	},
+	{
+		name:   "MemoryLimit",
+		adjust: func(a *api.ContainerAdjustment) { a.SetLinuxMemoryLimit(checkMemoryLimit) },
+		verify: func(c *api.Container) bool {
+			return c.GetLinux().GetResources().GetMemory().GetLimit().GetValue() == checkMemoryLimit
+		},
+	},
+	{
+		name:   "CPUShares",
+		adjust: func(a *api.ContainerAdjustment) { a.SetLinuxCPUShares(checkCPUShares) },
+		verify: func(c *api.Container) bool {
+			return c.GetLinux().GetResources().GetCpu().GetShares().GetValue() == checkCPUShares
+		},
+	},
+	{
+		name:   "CPUSetMems",
+		adjust: func(a *api.ContainerAdjustment) { a.SetLinuxCPUSetMems(checkCPUSetMems) },
+		verify: func(c *api.Container) bool {
+			return c.GetLinux().GetResources().GetCpu().GetMems() == checkCPUSetMems
+		},
+	},
+}
+
+// adjustContainer returns the adjustments of all checks.
+func adjustContainer() *api.ContainerAdjustment {
+	adjust := &api.ContainerAdjustment{}
+	for _, c := range checks {
+		c.adjust(adjust)
+	}
+	return adjust
+}
+
+var podChecks = []*podCheck{
+	{
+		name:   "PodAnnotations",
+		adjust: func(a *api.PodSandboxAdjustment) { a.AddAnnotation(checkKey, checkValue) },
+		verify: func(p *api.PodSandbox) bool { return p.GetAnnotations()[checkKey] == checkValue },
+	},
+	{
+		name:   "PodLabels",
+		adjust: func(a *api.PodSandboxAdjustment) { a.AddLabel(checkKey, checkValue) },
+		verify: func(p *api.PodSandbox) bool { return p.GetLabels()[checkKey] == checkValue },
+	},
+}
+
+// adjustPod returns the adjustments of all pod checks.
+func adjustPod() *api.PodSandboxAdjustment {
+	adjust := &api.PodSandboxAdjustment{}
+	for _, c := range podChecks {
+		c.adjust(adjust)
+	}
+	return adjust
+}
+
+// checkNames returns the names of all pod and container checks.
+func checkNames() []string {
+	var names []string
+	for _, c := range podChecks {
+		names = append(names, c.name)
+	}
+	for _, c := range checks {
+		names = append(names, c.name)
+	}
+	return names
+}
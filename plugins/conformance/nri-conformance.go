@@ -0,0 +1,325 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+type config struct {
+	ReportFile string `json:"reportFile"`
+	Namespace  string `json:"namespace"`
+}
+
+type plugin struct {
+	sync.Mutex
+	stub     stub.Stub
+	report   *Report
+	verified map[string]bool
+}
+
+var (
+	cfg config
+	log *logrus.Logger
+	_   = stub.ConfigureInterface(&plugin{})
+	_   = stub.AdjustPodInterface(&plugin{})
+	_   = stub.PodLifecycleInterface(&plugin{})
+	_   = stub.ContainerAdjusterInterface(&plugin{})
+	_   = stub.ContainerLifecycleInterface(&plugin{})
+	_   = stub.CheckpointInterface(&plugin{})
+	_   = stub.ImageInterface(&plugin{})
+	_   = stub.ExecInterface(&plugin{})
+	_   = stub.ContainerOOMInterface(&plugin{})
+	_   = stub.ContainerExitInterface(&plugin{})
+	_   = stub.UpdatePodInterface(&plugin{})
+	_   = stub.PostUpdatePodInterface(&plugin{})
+	_   = stub.ResourcePressureInterface(&plugin{})
+	_   = stub.NetworkStatusInterface(&plugin{})
+	_   = stub.NetworkGCInterface(&plugin{})
+	_   = stub.SetupNetworkFailedInterface(&plugin{})
+)
+
+func (p *plugin) Configure(config, runtime, version string) (stub.EventMask, error) {
+	log.Infof("got configuration data: %q from runtime %s %s", config, runtime, version)
+
+	if config != "" {
+		if err := yaml.Unmarshal([]byte(config), &cfg); err != nil {
+			return 0, fmt.Errorf("failed to parse provided configuration: %w", err)
+		}
+		if cfg.ReportFile == "" {
+			return 0, fmt.Errorf("invalid configuration, no report file given")
+		}
+	}
+
+	p.Lock()
+	defer p.Unlock()
+
+	p.report = newReport()
+	p.report.Runtime = runtime
+	p.report.RuntimeVersion = version
+	p.verified = map[string]bool{}
+
+	// Subscribe to all the events the plugin handles.
+	return 0, p.save()
+}
+
+func (p *plugin) RunPodSandbox(pod *api.PodSandbox) error {
+	return p.record(api.Event_RUN_POD_SANDBOX, pod, nil)
+}
+
+func (p *plugin) AdjustPodSandbox(pod *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+	if !p.isTestPod(pod) {
+		return nil, nil
+	}
+	return adjustPod(), nil
+}
+
+func (p *plugin) StopPodSandbox(pod *api.PodSandbox) error {
+	return p.record(api.Event_STOP_POD_SANDBOX, pod, nil)
+}
+
+func (p *plugin) RemovePodSandbox(pod *api.PodSandbox) error {
+	return p.record(api.Event_REMOVE_POD_SANDBOX, pod, nil)
+}
+
+func (p *plugin) UpdatePodSandbox(pod *api.PodSandbox, _ *api.LinuxResources) error {
+	return p.record(api.Event_UPDATE_POD_SANDBOX, pod, nil)
+}
+
+func (p *plugin) PostUpdatePodSandbox(pod *api.PodSandbox) error {
+	return p.record(api.Event_POST_UPDATE_POD_SANDBOX, pod, nil)
+}
+
+func (p *plugin) CheckpointPodSandbox(pod *api.PodSandbox) error {
+	return p.record(api.Event_CHECKPOINT_POD_SANDBOX, pod, nil)
+}
+
+func (p *plugin) RestorePodSandbox(pod *api.PodSandbox) error {
+	return p.record(api.Event_RESTORE_POD_SANDBOX, pod, nil)
+}
+
+func (p *plugin) CreateContainer(pod *api.PodSandbox, container *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+	if err := p.record(api.Event_CREATE_CONTAINER, pod, nil); err != nil || !p.isTestPod(pod) {
+		return nil, nil, err
+	}
+	return adjustContainer(), nil, nil
+}
+
+func (p *plugin) PostCreateContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record(api.Event_POST_CREATE_CONTAINER, pod, container)
+}
+
+func (p *plugin) StartContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record(api.Event_START_CONTAINER, pod, container)
+}
+
+func (p *plugin) PostStartContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record(api.Event_POST_START_CONTAINER, pod, container)
+}
+
+func (p *plugin) UpdateContainer(pod *api.PodSandbox, container *api.Container) ([]*api.ContainerUpdate, error) {
+	return nil, p.record(api.Event_UPDATE_CONTAINER, pod, container)
+}
+
+func (p *plugin) PostUpdateContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record(api.Event_POST_UPDATE_CONTAINER, pod, container)
+}
+
+func (p *plugin) StopContainer(pod *api.PodSandbox, container *api.Container) ([]*api.ContainerUpdate, error) {
+	return nil, p.record(api.Event_STOP_CONTAINER, pod, container)
+}
+
+func (p *plugin) RemoveContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record(api.Event_REMOVE_CONTAINER, pod, container)
+}
+
+func (p *plugin) CheckpointContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record(api.Event_CHECKPOINT_CONTAINER, pod, container)
+}
+
+func (p *plugin) RestoreContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record(api.Event_RESTORE_CONTAINER, pod, container)
+}
+
+func (p *plugin) ImagePulled(_ *api.Image) error {
+	return p.record(api.Event_IMAGE_PULLED, nil, nil)
+}
+
+func (p *plugin) ImageRemoved(_ *api.Image) error {
+	return p.record(api.Event_IMAGE_REMOVED, nil, nil)
+}
+
+func (p *plugin) PreExec(pod *api.PodSandbox, container *api.Container, _ *api.ExecSession) error {
+	return p.record(api.Event_PRE_EXEC, pod, container)
+}
+
+func (p *plugin) PostExec(pod *api.PodSandbox, container *api.Container, _ *api.ExecSession) error {
+	return p.record(api.Event_POST_EXEC, pod, container)
+}
+
+func (p *plugin) ContainerOOM(pod *api.PodSandbox, container *api.Container) error {
+	return p.record(api.Event_CONTAINER_OOM, pod, container)
+}
+
+func (p *plugin) ContainerExit(pod *api.PodSandbox, container *api.Container, _ *api.ContainerExit) error {
+	return p.record(api.Event_CONTAINER_EXIT, pod, container)
+}
+
+func (p *plugin) ResourcePressure(pod *api.PodSandbox, container *api.Container, _ *api.Pressure) error {
+	return p.record(api.Event_RESOURCE_PRESSURE, pod, container)
+}
+
+func (p *plugin) NetworkStatus([]*api.NetworkStatus) error {
+	return p.record(api.Event_NETWORK_STATUS, nil, nil)
+}
+
+func (p *plugin) NetworkGC([]*api.NetworkAttachment) error {
+	return p.record(api.Event_NETWORK_GC, nil, nil)
+}
+
+func (p *plugin) SetupNetworkFailed(pod *api.PodSandbox, _ *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+	return nil, p.record(api.Event_SETUP_NETWORK_FAILED, pod, nil)
+}
+
+func (p *plugin) onClose() {
+	log.Infof("connection to the runtime lost, exiting...")
+	os.Exit(0)
+}
+
+// isTestPod returns true if the pod is in the conformance test namespace.
+func (p *plugin) isTestPod(pod *api.PodSandbox) bool {
+	return pod.GetNamespace() == cfg.Namespace
+}
+
+// record an event, verify any adjustments of the test pod and container it
+// is for, then save the report.
+func (p *plugin) record(event api.Event, pod *api.PodSandbox, container *api.Container) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.report == nil {
+		p.report = newReport()
+		p.verified = map[string]bool{}
+	}
+
+	mask := api.EventMask(0)
+	p.report.Events[mask.Set(event).PrettyString()]++
+
+	if len(pod.GetNetwork().GetIps()) > 0 {
+		p.report.PodNetworkStatus = true
+	}
+
+	if p.isTestPod(pod) {
+		// Pod adjustments are visible in any event after RunPodSandbox.
+		if event != api.Event_RUN_POD_SANDBOX && !p.verified[pod.GetId()] {
+			p.verified[pod.GetId()] = true
+			for _, c := range podChecks {
+				p.verify(c.name, c.verify(pod), pod.GetName())
+			}
+		}
+		// Container adjustments are visible in any event after creation.
+		if container != nil && !p.verified[container.GetId()] {
+			p.verified[container.GetId()] = true
+			for _, c := range checks {
+				p.verify(c.name, c.verify(container), container.GetName())
+			}
+		}
+	}
+
+	if err := p.save(); err != nil {
+		log.Errorf("%v", err)
+	}
+
+	return nil
+}
+
+// verify records the result of an adjustment check.
+func (p *plugin) verify(name string, ok bool, target string) {
+	res := p.report.Adjustments[name]
+	if ok {
+		res.Passed++
+	} else {
+		log.Warnf("%s adjustment not applied to %s", name, target)
+		res.Failed++
+	}
+}
+
+// save the report.
+func (p *plugin) save() error {
+	return p.report.save(cfg.ReportFile)
+}
+
+func main() {
+	var (
+		pluginName string
+		pluginIdx  string
+		check      string
+		opts       []stub.Option
+		err        error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.StringVar(&cfg.ReportFile, "report-file", "nri-conformance.json", "file to write the report to")
+	flag.StringVar(&cfg.Namespace, "namespace", "nri-conformance", "namespace of the conformance test pods")
+	flag.StringVar(&check, "check", "", "show the given report instead of running the plugin")
+	flag.Parse()
+
+	if check != "" {
+		report, err := loadReport(check)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		if !report.show(os.Stdout) {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	p := &plugin{}
+	if p.stub, err = stub.New(p, append(opts, stub.WithOnClose(p.onClose))...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
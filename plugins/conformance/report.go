@@ -0,0 +1,149 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// Report is the compatibility report of a runtime.
+type Report struct {
+	// Runtime is the name of the runtime.
+	Runtime string `json:"runtime"`
+	// RuntimeVersion is the version of the runtime.
+	RuntimeVersion string `json:"runtimeVersion"`
+	// Events are the number of times each event was received.
+	Events map[string]int `json:"events"`
+	// Adjustments are the results of the adjustment checks.
+	Adjustments map[string]*Result `json:"adjustments"`
+	// PodNetworkStatus is set if pods carried their network status, with
+	// the IP addresses of the pod, in any event.
+	PodNetworkStatus bool `json:"podNetworkStatus"`
+}
+
+// Result is the result of an adjustment check.
+type Result struct {
+	// Passed is the number of containers the adjustment was applied to.
+	Passed int `json:"passed"`
+	// Failed is the number of containers the adjustment was not applied to.
+	Failed int `json:"failed"`
+}
+
+func newReport() *Report {
+	r := &Report{
+		Events:      map[string]int{},
+		Adjustments: map[string]*Result{},
+	}
+	for _, name := range checkNames() {
+		r.Adjustments[name] = &Result{}
+	}
+	return r
+}
+
+// loadReport loads a report from a file.
+func loadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+	r := &Report{}
+	if err = json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("failed to parse report %s: %w", path, err)
+	}
+	return r, nil
+}
+
+// save the report, replacing the report file atomically.
+func (r *Report) save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+// show the report, returning false if any adjustment check failed.
+func (r *Report) show(w io.Writer) bool {
+	var (
+		tw     = tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		ok     = true
+		events int
+		passed int
+	)
+
+	fmt.Fprintf(w, "NRI compatibility report for %s %s\n\n", r.Runtime, r.RuntimeVersion)
+
+	fmt.Fprintln(tw, "EVENT\tCOUNT\tSTATUS")
+	for e := api.Event_UNKNOWN + 1; e < api.Event_LAST; e++ {
+		mask := api.EventMask(0)
+		name := mask.Set(e).PrettyString()
+		status := "not observed"
+		if r.Events[name] > 0 {
+			status = "ok"
+			events++
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", name, r.Events[name], status)
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(tw, "ADJUSTMENT\tPASSED\tFAILED\tSTATUS")
+	for _, name := range checkNames() {
+		res := r.Adjustments[name]
+		if res == nil {
+			res = &Result{}
+		}
+		status := "not tested"
+		switch {
+		case res.Failed > 0:
+			status = "FAILED"
+			ok = false
+		case res.Passed > 0:
+			status = "ok"
+			passed++
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", name, res.Passed, res.Failed, status)
+	}
+	tw.Flush()
+
+	network := "not observed"
+	if r.PodNetworkStatus {
+		network = "ok"
+	}
+
+	fmt.Fprintf(w, "\npod network status: %s\n", network)
+	fmt.Fprintf(w, "events observed: %d/%d, adjustments applied: %d/%d\n",
+		events, int(api.Event_LAST-1), passed, len(checkNames()))
+
+	return ok
+}
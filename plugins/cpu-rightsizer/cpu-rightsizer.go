@@ -0,0 +1,252 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+const (
+	// Prefix of the key used for opting containers in to right-sizing.
+	enableKey = "cpu-rightsizer.nri.io"
+)
+
+var (
+	log *logrus.Logger
+)
+
+// our CPU right-sizer plugin
+type plugin struct {
+	sync.Mutex
+	stub       stub.Stub
+	policy     *policy
+	interval   time.Duration
+	maxUpdates int
+	containers map[string]*container
+	once       sync.Once
+}
+
+// Synchronize starts tracking existing containers, then starts polling.
+func (p *plugin) Synchronize(pods []*api.PodSandbox, containers []*api.Container) ([]*api.ContainerUpdate, error) {
+	podMap := make(map[string]*api.PodSandbox)
+	for _, pod := range pods {
+		podMap[pod.Id] = pod
+	}
+
+	for _, ctr := range containers {
+		if ctr.State != api.ContainerState_CONTAINER_RUNNING {
+			continue
+		}
+		if pod, ok := podMap[ctr.PodSandboxId]; ok {
+			p.track(pod, ctr)
+		}
+	}
+
+	p.once.Do(func() { go p.poll() })
+
+	return nil, nil
+}
+
+// StartContainer starts tracking a container, if it opted in.
+func (p *plugin) StartContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	p.track(pod, ctr)
+	return nil
+}
+
+// RemoveContainer stops tracking a container.
+func (p *plugin) RemoveContainer(_ *api.PodSandbox, ctr *api.Container) error {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.containers, ctr.Id)
+	return nil
+}
+
+// track starts tracking a container, if it opted in to right-sizing.
+func (p *plugin) track(pod *api.PodSandbox, ctr *api.Container) {
+	if !enabled(pod, ctr) {
+		return
+	}
+
+	c := p.policy.newContainer(pod, ctr)
+	if c.ceiling <= 0 {
+		log.Warnf("%s: not right-sizing, no CPU quota and no -max-cpus", c.name)
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.containers[ctr.Id] = c
+
+	log.Infof("%s: right-sizing CPU quota up to %.2f CPUs", c.name, c.ceiling)
+}
+
+// enabled checks if a container opted in to right-sizing.
+func enabled(pod *api.PodSandbox, ctr *api.Container) bool {
+	for _, key := range []string{
+		enableKey + "/container." + ctr.Name,
+		enableKey + "/pod",
+		enableKey,
+	} {
+		if value, ok := pod.Annotations[key]; ok {
+			return value == "true"
+		}
+	}
+	return false
+}
+
+// poll periodically samples the usage of tracked containers and updates
+// their CPU quota, at most maxUpdates containers at a time.
+func (p *plugin) poll() {
+	for range time.Tick(p.interval) {
+		p.rightSize()
+	}
+}
+
+// rightSize samples tracked containers once and updates the ones with a
+// new quota.
+func (p *plugin) rightSize() {
+	p.Lock()
+	ids := make([]string, 0, len(p.containers))
+	for id := range p.containers {
+		ids = append(ids, id)
+	}
+	p.Unlock()
+	sort.Strings(ids)
+
+	var (
+		now     = time.Now()
+		updates []*api.ContainerUpdate
+		targets = make(map[string]float64)
+	)
+
+	for _, id := range ids {
+		stats, err := p.stub.GetStats(id)
+		if err != nil {
+			log.Warnf("failed to get stats of container %s: %v", id, err)
+			continue
+		}
+
+		p.Lock()
+		c, ok := p.containers[id]
+		if !ok {
+			p.Unlock()
+			continue
+		}
+		target, update := p.policy.sample(c, stats, now)
+		p.Unlock()
+
+		if !update || len(updates) >= p.maxUpdates {
+			continue
+		}
+
+		u := &api.ContainerUpdate{}
+		u.SetContainerId(id)
+		u.SetLinuxCPUQuota(c.quota(target))
+		u.SetLinuxCPUPeriod(c.period)
+		u.SetIgnoreFailure()
+		updates = append(updates, u)
+		targets[id] = target
+	}
+
+	if len(updates) == 0 {
+		return
+	}
+
+	failed, err := p.stub.UpdateContainers(updates)
+	if err != nil {
+		log.Errorf("failed to update containers: %v", err)
+		return
+	}
+	for _, u := range failed {
+		log.Warnf("failed to update container %s", u.ContainerId)
+		delete(targets, u.ContainerId)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	for id, target := range targets {
+		c, ok := p.containers[id]
+		if !ok {
+			continue
+		}
+		log.Infof("%s: CPU quota %.2f -> %.2f CPUs (average usage %.2f)",
+			c.name, c.current, target, c.average)
+		c.current = target
+		c.updated = now
+	}
+}
+
+func main() {
+	var (
+		pluginName string
+		pluginIdx  string
+		opts       []stub.Option
+		err        error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	p := &plugin{
+		policy:     &policy{},
+		containers: make(map[string]*container),
+	}
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.DurationVar(&p.interval, "interval", 30*time.Second, "interval to sample container usage at")
+	flag.IntVar(&p.maxUpdates, "max-updates", 10, "maximum number of containers to update per interval")
+	flag.DurationVar(&p.policy.Cooldown, "cooldown", 5*time.Minute, "minimum time between updates to a container")
+	flag.Float64Var(&p.policy.MinCPUs, "min-cpus", 0.1, "smallest CPU quota to set, in CPUs")
+	flag.Float64Var(&p.policy.MaxCPUs, "max-cpus", 0, "largest CPU quota to set for containers without a quota, in CPUs")
+	flag.Float64Var(&p.policy.Headroom, "headroom", 1.25, "factor to set CPU quota above average usage")
+	flag.Float64Var(&p.policy.Growth, "growth", 1.5, "factor to grow CPU quota by when throttled")
+	flag.Parse()
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	if p.interval <= 0 || p.maxUpdates <= 0 {
+		log.Fatalf("invalid -interval or -max-updates")
+	}
+
+	if p.stub, err = stub.New(p, opts...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
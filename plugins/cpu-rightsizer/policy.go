@@ -0,0 +1,125 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+const (
+	// CFS period used for containers without one.
+	defaultPeriod = 100000
+	// Weight of the latest sample in the moving average of CPU usage.
+	smoothing = 0.3
+	// Relative change of the quota below which it is not updated.
+	hysteresis = 0.1
+)
+
+// policy right-sizes CPU quotas.
+type policy struct {
+	// MinCPUs is the smallest quota set, in CPUs.
+	MinCPUs float64
+	// MaxCPUs is the largest quota set for containers without a quota.
+	MaxCPUs float64
+	// Headroom is the factor the quota is set above average usage.
+	Headroom float64
+	// Growth is the factor the quota is grown by when throttled.
+	Growth float64
+	// Cooldown is the minimum time between updates to a container.
+	Cooldown time.Duration
+}
+
+// a container being right-sized
+type container struct {
+	id      string
+	name    string
+	period  int64
+	ceiling float64 // largest quota we set, in CPUs
+	current float64 // current quota, in CPUs
+	average float64 // moving average of CPU usage, in CPUs
+	last    *api.CPUStats
+	lastAt  int64
+	updated time.Time
+}
+
+// newContainer sets up right-sizing for a container, starting from its
+// current CPU quota, which also becomes the largest quota we set for it.
+func (p *policy) newContainer(pod *api.PodSandbox, ctr *api.Container) *container {
+	c := &container{
+		id:      ctr.Id,
+		name:    pod.Namespace + "/" + pod.Name + "/" + ctr.Name,
+		period:  defaultPeriod,
+		ceiling: p.MaxCPUs,
+	}
+
+	if cpu := ctr.GetLinux().GetResources().GetCpu(); cpu != nil {
+		if period := cpu.GetPeriod().Get(); period != nil && *period > 0 {
+			c.period = int64(*period)
+		}
+		if quota := cpu.GetQuota().Get(); quota != nil && *quota > 0 {
+			c.ceiling = float64(*quota) / float64(c.period)
+		}
+	}
+	c.current = c.ceiling
+
+	return c
+}
+
+// sample updates the usage of the container from its stats. It returns
+// the new quota of the container, in CPUs, if it should be updated.
+func (p *policy) sample(c *container, stats *api.Stats, now time.Time) (float64, bool) {
+	cpu := stats.GetCpu()
+	if cpu == nil {
+		return 0, false
+	}
+
+	last, lastAt := c.last, c.lastAt
+	c.last, c.lastAt = cpu, stats.Timestamp
+	if last == nil || stats.Timestamp <= lastAt || cpu.UsageNanoseconds < last.UsageNanoseconds {
+		return 0, false
+	}
+
+	usage := float64(cpu.UsageNanoseconds-last.UsageNanoseconds) / float64(stats.Timestamp-lastAt)
+	if c.average == 0 {
+		c.average = usage
+	} else {
+		c.average = smoothing*usage + (1-smoothing)*c.average
+	}
+
+	target := c.average * p.Headroom
+	if cpu.ThrottledPeriods > last.ThrottledPeriods {
+		target = math.Max(target, c.current*p.Growth)
+	}
+	target = math.Min(math.Max(target, p.MinCPUs), c.ceiling)
+
+	if math.Abs(target-c.current) < hysteresis*c.current {
+		return 0, false
+	}
+	if now.Sub(c.updated) < p.Cooldown {
+		return 0, false
+	}
+
+	return target, true
+}
+
+// quota returns the CFS quota of the container for the given CPUs.
+func (c *container) quota(cpus float64) int64 {
+	return int64(math.Ceil(cpus * float64(c.period)))
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/r3labs/diff/v3"
 	"github.com/sirupsen/logrus"
 	"github.com/sters/yaml-diff/yamldiff"
+	"google.golang.org/protobuf/proto"
 	"sigs.k8s.io/yaml"
 
 	"github.com/containerd/nri/pkg/api"
@@ -40,6 +41,7 @@ type config struct {
 	LogFile      string `json:"logFile"`
 	VerboseLevel int    `json:"verboseLevel"`
 	Yaml         bool   `json:"yaml"`
+	JSONPatch    bool   `json:"jsonPatch"`
 }
 
 type pluginIndex struct {
@@ -140,6 +142,8 @@ func (p *plugin) differ(apifunc string, pod *api.PodSandbox, container *api.Cont
 
 				if cfg.Yaml {
 					p.printYamlDiff(apifunc, "pod", initialValue.pod, podChanged)
+				} else if cfg.JSONPatch {
+					p.printPatch(apifunc, "pod", &initialValue.pod, &podChanged)
 				} else {
 					changelog, err := diff.Diff(initialValue.pod, podChanged)
 					if err != nil {
@@ -158,6 +162,8 @@ func (p *plugin) differ(apifunc string, pod *api.PodSandbox, container *api.Cont
 
 				if cfg.Yaml {
 					p.printYamlDiff(apifunc, "container", initialValue.container, containerChanged)
+				} else if cfg.JSONPatch {
+					p.printPatch(apifunc, "container", &initialValue.container, &containerChanged)
 				} else {
 					changelog, err := diff.Diff(initialValue.container, containerChanged)
 					if err != nil {
@@ -185,7 +191,7 @@ func (p *plugin) Synchronize(pods []*api.PodSandbox, containers []*api.Container
 	return nil, nil
 }
 
-func (p *plugin) Shutdown() {
+func (p *plugin) Shutdown(_ *api.ShutdownRequest) {
 	p.dump("Shutdown")
 }
 
@@ -249,6 +255,77 @@ func (p *plugin) RemoveContainer(pod *api.PodSandbox, container *api.Container)
 	return nil
 }
 
+func (p *plugin) CheckpointPodSandbox(pod *api.PodSandbox) error {
+	p.differ("CheckpointPodSandbox", pod, nil)
+	return nil
+}
+
+func (p *plugin) RestorePodSandbox(pod *api.PodSandbox) error {
+	p.differ("RestorePodSandbox", pod, nil)
+	return nil
+}
+
+func (p *plugin) CheckpointContainer(pod *api.PodSandbox, container *api.Container) error {
+	p.differ("CheckpointContainer", pod, container)
+	return nil
+}
+
+func (p *plugin) RestoreContainer(pod *api.PodSandbox, container *api.Container) error {
+	p.differ("RestoreContainer", pod, container)
+	return nil
+}
+
+func (p *plugin) ImagePulled(image *api.Image) error {
+	if cfg.VerboseLevel > 0 {
+		p.dump("ImagePulled", "image", image)
+	}
+	return nil
+}
+
+func (p *plugin) ImageRemoved(image *api.Image) error {
+	if cfg.VerboseLevel > 0 {
+		p.dump("ImageRemoved", "image", image)
+	}
+	return nil
+}
+
+func (p *plugin) PreExec(pod *api.PodSandbox, container *api.Container, exec *api.ExecSession) error {
+	p.differ("PreExec", pod, container)
+	return nil
+}
+
+func (p *plugin) PostExec(pod *api.PodSandbox, container *api.Container, exec *api.ExecSession) error {
+	p.differ("PostExec", pod, container)
+	return nil
+}
+
+func (p *plugin) ContainerOOM(pod *api.PodSandbox, container *api.Container) error {
+	p.differ("ContainerOOM", pod, container)
+	return nil
+}
+
+func (p *plugin) ContainerExit(pod *api.PodSandbox, container *api.Container, exit *api.ContainerExit) error {
+	p.differ("ContainerExit", pod, container)
+	return nil
+}
+
+func (p *plugin) UpdatePodSandbox(pod *api.PodSandbox, resources *api.LinuxResources) error {
+	p.differ("UpdatePodSandbox", pod, nil)
+	return nil
+}
+
+func (p *plugin) PostUpdatePodSandbox(pod *api.PodSandbox) error {
+	p.differ("PostUpdatePodSandbox", pod, nil)
+	return nil
+}
+
+func (p *plugin) ResourcePressure(pod *api.PodSandbox, container *api.Container, pressure *api.Pressure) error {
+	if cfg.VerboseLevel > 0 {
+		p.dump("ResourcePressure", "pod", pod, "container", container, "pressure", pressure)
+	}
+	return nil
+}
+
 func (p *plugin) onClose() {
 	log.Infof("stopped")
 	os.Exit(0)
@@ -332,6 +409,27 @@ func (p *plugin) printYamlDiff(apifunc string, obj string, origValue interface{}
 	}
 }
 
+func (p *plugin) printPatch(apifunc string, obj string, origValue proto.Message, changedValue proto.Message) {
+	changes, err := api.Diff(origValue, changedValue)
+	if err != nil {
+		log.Errorf("%v", err)
+		return
+	}
+
+	if len(changes) == 0 {
+		log.Infof("[%d] %s: %s: %s", p.idx, apifunc, obj, "<no changes>")
+		return
+	}
+
+	patch, err := changes.JSONPatch()
+	if err != nil {
+		log.Errorf("%v", err)
+		return
+	}
+
+	log.Infof("[%d] %s: %s: %s", p.idx, apifunc, obj, string(patch))
+}
+
 func startPlugin(wg *sync.WaitGroup, pluginName string, pluginIdx int) {
 	var (
 		opts []stub.Option
@@ -387,6 +485,7 @@ func main() {
 			"indices 45, 50 and 80. Note that this plugin will install itself to index 0 and 99\n"+
 			"if this parameter is not given.")
 	flag.BoolVar(&cfg.Yaml, "yaml", false, "Print the diff in yaml")
+	flag.BoolVar(&cfg.JSONPatch, "json-patch", false, "Print the diff as a JSON Patch")
 	flag.Parse()
 
 	if cfg.LogFile != "" {
@@ -0,0 +1,151 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/netip"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// Default directory for generated resolv.conf files.
+	defaultResolvConfDir = "/run/nri-dns-policy"
+	// Options used in node-local fallback mode, to fail over quickly if
+	// the node-local DNS cache does not respond.
+	fallbackOptions = "timeout:1 attempts:2"
+	// Maximum number of nameservers the resolver uses.
+	maxNameservers = 3
+)
+
+// plugin configuration
+type config struct {
+	// ResolvConfDir is the directory to generate resolv.conf files in.
+	ResolvConfDir string `json:"resolvConfDir"`
+	// NodeLocalDNS is the address of the node-local DNS cache, used by
+	// policies in node-local fallback mode.
+	NodeLocalDNS string `json:"nodeLocalDNS"`
+	// Policies in order of precedence.
+	Policies []*policy `json:"policies"`
+}
+
+// DNS policy for pods in some namespaces
+type policy struct {
+	// Name of the policy, used in logs and metrics.
+	Name string `json:"name"`
+	// Namespaces the policy applies to, as shell file name patterns.
+	Namespaces []string `json:"namespaces"`
+	// Nameservers to use.
+	Nameservers []string `json:"nameservers"`
+	// Search domains to use.
+	Searches []string `json:"searches"`
+	// Resolver options to use, like ndots:2.
+	Options []string `json:"options"`
+	// NodeLocalFallback puts the node-local DNS cache first, falling back
+	// to the policy nameservers if it does not respond.
+	NodeLocalFallback bool `json:"nodeLocalFallback"`
+}
+
+// parseConfig parses and checks the given YAML configuration.
+func parseConfig(data []byte) (*config, error) {
+	cfg := &config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+	if cfg.ResolvConfDir == "" {
+		cfg.ResolvConfDir = defaultResolvConfDir
+	}
+	if cfg.NodeLocalDNS != "" {
+		if _, err := netip.ParseAddr(cfg.NodeLocalDNS); err != nil {
+			return nil, fmt.Errorf("invalid node-local DNS address: %w", err)
+		}
+	}
+
+	for i, p := range cfg.Policies {
+		if p.Name == "" {
+			p.Name = fmt.Sprintf("policy%d", i)
+		}
+		if len(p.Namespaces) == 0 {
+			return nil, fmt.Errorf("policy %s: no namespaces", p.Name)
+		}
+		for _, pattern := range p.Namespaces {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("policy %s: invalid namespace pattern %q: %w",
+					p.Name, pattern, err)
+			}
+		}
+		for _, ns := range p.Nameservers {
+			if _, err := netip.ParseAddr(ns); err != nil {
+				return nil, fmt.Errorf("policy %s: invalid nameserver: %w", p.Name, err)
+			}
+		}
+		if len(p.Nameservers) == 0 && !p.NodeLocalFallback {
+			return nil, fmt.Errorf("policy %s: no nameservers", p.Name)
+		}
+		if p.NodeLocalFallback && cfg.NodeLocalDNS == "" {
+			return nil, fmt.Errorf("policy %s: node-local fallback without nodeLocalDNS", p.Name)
+		}
+	}
+
+	return cfg, nil
+}
+
+// policyFor returns the policy for pods in the given namespace, if any.
+func (cfg *config) policyFor(namespace string) *policy {
+	for _, p := range cfg.Policies {
+		for _, pattern := range p.Namespaces {
+			if ok, _ := path.Match(pattern, namespace); ok {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// resolvConf generates the resolv.conf contents for the policy.
+func (cfg *config) resolvConf(p *policy) []byte {
+	var (
+		buf         strings.Builder
+		nameservers []string
+		options     = p.Options
+	)
+
+	if p.NodeLocalFallback {
+		nameservers = append(nameservers, cfg.NodeLocalDNS)
+		options = append([]string{fallbackOptions}, options...)
+	}
+	nameservers = append(nameservers, p.Nameservers...)
+	if len(nameservers) > maxNameservers {
+		nameservers = nameservers[:maxNameservers]
+	}
+
+	fmt.Fprintf(&buf, "# generated by NRI DNS policy %s\n", p.Name)
+	for _, ns := range nameservers {
+		fmt.Fprintf(&buf, "nameserver %s\n", ns)
+	}
+	if len(p.Searches) > 0 {
+		fmt.Fprintf(&buf, "search %s\n", strings.Join(p.Searches, " "))
+	}
+	if len(options) > 0 {
+		fmt.Fprintf(&buf, "options %s\n", strings.Join(options, " "))
+	}
+
+	return []byte(buf.String())
+}
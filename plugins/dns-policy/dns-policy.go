@@ -0,0 +1,228 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+const (
+	// Path of the resolver configuration in containers.
+	resolvConfPath = "/etc/resolv.conf"
+)
+
+var (
+	log *logrus.Logger
+)
+
+// our DNS policy plugin
+type plugin struct {
+	sync.Mutex
+	stub    stub.Stub
+	cfg     *config
+	pods    map[string]struct{}
+	metrics *metrics
+}
+
+// Configure the plugin, if the runtime passes a configuration.
+func (p *plugin) Configure(config, runtime, version string) (stub.EventMask, error) {
+	if config == "" {
+		return 0, nil
+	}
+
+	cfg, err := parseConfig([]byte(config))
+	if err != nil {
+		return 0, err
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.cfg = cfg
+
+	log.Infof("configured with %d DNS policies by %s %s", len(cfg.Policies), runtime, version)
+
+	return 0, nil
+}
+
+// Synchronize removes the generated configuration of pods which are gone.
+func (p *plugin) Synchronize(pods []*api.PodSandbox, _ []*api.Container) ([]*api.ContainerUpdate, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	known := make(map[string]struct{}, len(pods))
+	for _, pod := range pods {
+		known[pod.Id] = struct{}{}
+	}
+
+	entries, err := os.ReadDir(p.cfg.ResolvConfDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read %s: %w", p.cfg.ResolvConfDir, err)
+	}
+	for _, e := range entries {
+		if _, ok := known[e.Name()]; ok {
+			p.pods[e.Name()] = struct{}{}
+			continue
+		}
+		log.Infof("removing DNS configuration of stale pod %s", e.Name())
+		if err := os.RemoveAll(filepath.Join(p.cfg.ResolvConfDir, e.Name())); err != nil {
+			log.Errorf("failed to remove DNS configuration of pod %s: %v", e.Name(), err)
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateContainer rewrites the DNS configuration of containers in pods
+// some policy applies to.
+func (p *plugin) CreateContainer(pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	policy := p.cfg.policyFor(pod.Namespace)
+	if policy == nil {
+		return nil, nil, nil
+	}
+
+	file, err := p.writeResolvConf(pod, policy)
+	if err != nil {
+		p.metrics.rewriteFailed()
+		return nil, nil, err
+	}
+
+	log.Infof("%s/%s/%s: using DNS policy %s", pod.Namespace, pod.Name, ctr.Name, policy.Name)
+
+	adjust := &api.ContainerAdjustment{}
+	adjust.AddMount(&api.Mount{
+		Destination: resolvConfPath,
+		Source:      file,
+		Type:        "bind",
+		Options:     []string{"rbind", "rprivate", "ro"},
+	})
+
+	return adjust, nil, nil
+}
+
+// RemovePodSandbox removes the generated configuration of a pod.
+func (p *plugin) RemovePodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if _, ok := p.pods[pod.Id]; !ok {
+		return nil
+	}
+	delete(p.pods, pod.Id)
+
+	return os.RemoveAll(filepath.Join(p.cfg.ResolvConfDir, pod.Id))
+}
+
+// writeResolvConf generates the resolv.conf of a pod, once per pod.
+func (p *plugin) writeResolvConf(pod *api.PodSandbox, policy *policy) (string, error) {
+	var (
+		dir  = filepath.Join(p.cfg.ResolvConfDir, pod.Id)
+		file = filepath.Join(dir, "resolv.conf")
+	)
+
+	if _, ok := p.pods[pod.Id]; ok {
+		return file, nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create DNS configuration of pod %s: %w", pod.Id, err)
+	}
+	if err := os.WriteFile(file, p.cfg.resolvConf(policy), 0644); err != nil {
+		return "", fmt.Errorf("failed to write DNS configuration of pod %s: %w", pod.Id, err)
+	}
+
+	p.pods[pod.Id] = struct{}{}
+	p.metrics.podRewritten(policy)
+
+	return file, nil
+}
+
+func main() {
+	var (
+		pluginName  string
+		pluginIdx   string
+		configFile  string
+		metricsAddr string
+		opts        []stub.Option
+		err         error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.StringVar(&configFile, "config", "", "configuration file with DNS policies")
+	flag.StringVar(&metricsAddr, "metrics-addr", "", "address to serve metrics on, like :9110")
+	flag.Parse()
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	p := &plugin{
+		cfg:     &config{ResolvConfDir: defaultResolvConfDir},
+		pods:    make(map[string]struct{}),
+		metrics: newMetrics(),
+	}
+
+	if configFile != "" {
+		data, err := os.ReadFile(configFile)
+		if err != nil {
+			log.Fatalf("failed to read configuration: %v", err)
+		}
+		if p.cfg, err = parseConfig(data); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+
+	if metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", p.metrics)
+		go func() {
+			log.Errorf("metrics server exited: %v", http.ListenAndServe(metricsAddr, mux))
+		}()
+	}
+
+	if p.stub, err = stub.New(p, opts...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// metrics of the plugin, served in the Prometheus text format
+type metrics struct {
+	sync.Mutex
+	rewritten map[string]uint64
+	fallback  uint64
+	errors    uint64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		rewritten: make(map[string]uint64),
+	}
+}
+
+// podRewritten counts a pod the DNS configuration was rewritten for.
+func (m *metrics) podRewritten(p *policy) {
+	m.Lock()
+	defer m.Unlock()
+	m.rewritten[p.Name]++
+	if p.NodeLocalFallback {
+		m.fallback++
+	}
+}
+
+// rewriteFailed counts a failure to rewrite the DNS configuration of a pod.
+func (m *metrics) rewriteFailed() {
+	m.Lock()
+	defer m.Unlock()
+	m.errors++
+}
+
+// ServeHTTP serves the metrics.
+func (m *metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	m.Lock()
+	defer m.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	fmt.Fprintln(w, "# HELP nri_dns_policy_rewritten_pods_total Pods with DNS configuration rewritten, by policy.")
+	fmt.Fprintln(w, "# TYPE nri_dns_policy_rewritten_pods_total counter")
+	names := make([]string, 0, len(m.rewritten))
+	for name := range m.rewritten {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "nri_dns_policy_rewritten_pods_total{policy=%q} %d\n", name, m.rewritten[name])
+	}
+
+	fmt.Fprintln(w, "# HELP nri_dns_policy_node_local_fallback_pods_total Pods rewritten in node-local fallback mode.")
+	fmt.Fprintln(w, "# TYPE nri_dns_policy_node_local_fallback_pods_total counter")
+	fmt.Fprintf(w, "nri_dns_policy_node_local_fallback_pods_total %d\n", m.fallback)
+
+	fmt.Fprintln(w, "# HELP nri_dns_policy_rewrite_errors_total Failures to rewrite DNS configuration.")
+	fmt.Fprintln(w, "# TYPE nri_dns_policy_rewrite_errors_total counter")
+	fmt.Fprintf(w, "nri_dns_policy_rewrite_errors_total %d\n", m.errors)
+}
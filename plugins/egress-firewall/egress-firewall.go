@@ -0,0 +1,179 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+var (
+	log *logrus.Logger
+)
+
+// our egress firewall plugin
+type plugin struct {
+	sync.Mutex
+	stub   stub.Stub
+	cfg    *config
+	dryRun bool
+}
+
+// Configure the plugin, if the runtime passes a configuration.
+func (p *plugin) Configure(config, runtime, version string) (stub.EventMask, error) {
+	if config == "" {
+		return 0, nil
+	}
+
+	cfg, err := parseConfig([]byte(config))
+	if err != nil {
+		return 0, err
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.cfg = cfg
+
+	log.Infof("configured with %d tenants by %s %s", len(cfg.Tenants), runtime, version)
+
+	return 0, nil
+}
+
+// Synchronize installs egress rules for existing pods.
+func (p *plugin) Synchronize(pods []*api.PodSandbox, _ []*api.Container) ([]*api.ContainerUpdate, error) {
+	for _, pod := range pods {
+		if err := p.RunPodSandbox(pod); err != nil {
+			log.Warnf("%s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+	}
+	return nil, nil
+}
+
+// RunPodSandbox installs the egress rules of the tenant of the pod in the
+// network namespace of the pod. The rules go away with the namespace, so
+// no cleanup is necessary.
+func (p *plugin) RunPodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+
+	t := p.cfg.tenantFor(pod.Namespace)
+	if t == nil {
+		return nil
+	}
+
+	netns := networkNamespace(pod)
+	if netns == "" {
+		return fmt.Errorf("no network namespace for pod of tenant %s", t.Name)
+	}
+
+	if err := p.nft(netns, t.ruleset()); err != nil {
+		return fmt.Errorf("failed to install egress rules of tenant %s: %w", t.Name, err)
+	}
+
+	log.Infof("%s/%s: installed egress rules of tenant %s", pod.Namespace, pod.Name, t.Name)
+
+	return nil
+}
+
+// networkNamespace returns the path of the network namespace of the pod.
+func networkNamespace(pod *api.PodSandbox) string {
+	for _, ns := range pod.GetLinux().GetNamespaces() {
+		if ns.Type == "network" {
+			return ns.Path
+		}
+	}
+	return ""
+}
+
+// nft loads a ruleset in the given network namespace.
+func (p *plugin) nft(netns, ruleset string) error {
+	if p.dryRun {
+		log.Infof("nft ruleset for %s:\n%s", netns, ruleset)
+		return nil
+	}
+
+	cmd := exec.Command("nsenter", "--net="+netns, "nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(ruleset)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("nft failed: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func main() {
+	var (
+		pluginName string
+		pluginIdx  string
+		configFile string
+		opts       []stub.Option
+		err        error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	p := &plugin{
+		cfg: &config{},
+	}
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.StringVar(&configFile, "config", "", "configuration file with tenant egress policies")
+	flag.BoolVar(&p.dryRun, "dry-run", false, "only log nftables rulesets, don't load them")
+	flag.Parse()
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	if configFile != "" {
+		data, err := os.ReadFile(configFile)
+		if err != nil {
+			log.Fatalf("failed to read configuration: %v", err)
+		}
+		if p.cfg, err = parseConfig(data); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+
+	if p.stub, err = stub.New(p, opts...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
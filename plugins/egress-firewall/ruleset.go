@@ -0,0 +1,166 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/netip"
+	"path"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// Name of the nftables table holding the egress rules of a pod.
+	tableName = "nri-egress"
+)
+
+// plugin configuration
+type config struct {
+	// Tenants in order of precedence.
+	Tenants []*tenant `json:"tenants"`
+}
+
+// egress policy of a tenant
+type tenant struct {
+	// Name of the tenant, used in logs.
+	Name string `json:"name"`
+	// Namespaces of the tenant, as shell file name patterns.
+	Namespaces []string `json:"namespaces"`
+	// Allowed egress traffic. Everything else is dropped.
+	Allow []*rule `json:"allow"`
+}
+
+// allowed egress traffic
+type rule struct {
+	// CIDR traffic is allowed to.
+	CIDR string `json:"cidr"`
+	// Protocol, tcp or udp. If unset, both are allowed.
+	Protocol string `json:"protocol"`
+	// Destination ports. If unset, all ports are allowed.
+	Ports []int `json:"ports"`
+}
+
+// parseConfig parses and checks the given YAML configuration.
+func parseConfig(data []byte) (*config, error) {
+	cfg := &config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	for i, t := range cfg.Tenants {
+		if t.Name == "" {
+			t.Name = fmt.Sprintf("tenant%d", i)
+		}
+		if len(t.Namespaces) == 0 {
+			return nil, fmt.Errorf("tenant %s: no namespaces", t.Name)
+		}
+		for _, pattern := range t.Namespaces {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("tenant %s: invalid namespace pattern %q: %w",
+					t.Name, pattern, err)
+			}
+		}
+		for _, r := range t.Allow {
+			if _, err := netip.ParsePrefix(r.CIDR); err != nil {
+				return nil, fmt.Errorf("tenant %s: invalid CIDR: %w", t.Name, err)
+			}
+			switch r.Protocol {
+			case "", "tcp", "udp":
+			default:
+				return nil, fmt.Errorf("tenant %s: invalid protocol %q", t.Name, r.Protocol)
+			}
+			for _, port := range r.Ports {
+				if port < 1 || port > 65535 {
+					return nil, fmt.Errorf("tenant %s: invalid port %d", t.Name, port)
+				}
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// tenantFor returns the tenant of pods in the given namespace, if any.
+func (cfg *config) tenantFor(namespace string) *tenant {
+	for _, t := range cfg.Tenants {
+		for _, pattern := range t.Namespaces {
+			if ok, _ := path.Match(pattern, namespace); ok {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+// ruleset returns the nftables ruleset enforcing the egress policy of the
+// tenant. Loading it replaces any earlier ruleset of the plugin.
+func (t *tenant) ruleset() string {
+	var b strings.Builder
+
+	// Create the table first, so that deleting it never fails.
+	fmt.Fprintf(&b, "table inet %s\n", tableName)
+	fmt.Fprintf(&b, "delete table inet %s\n", tableName)
+	fmt.Fprintf(&b, "table inet %s {\n", tableName)
+	fmt.Fprintf(&b, "\tchain output {\n")
+	fmt.Fprintf(&b, "\t\ttype filter hook output priority 0; policy drop;\n")
+	fmt.Fprintf(&b, "\t\tct state established,related accept\n")
+	fmt.Fprintf(&b, "\t\toif \"lo\" accept\n")
+	for _, r := range t.Allow {
+		for _, line := range r.statements() {
+			fmt.Fprintf(&b, "\t\t%s accept\n", line)
+		}
+	}
+	fmt.Fprintf(&b, "\t}\n")
+	fmt.Fprintf(&b, "}\n")
+
+	return b.String()
+}
+
+// statements returns the nftables match statements for the rule.
+func (r *rule) statements() []string {
+	prefix := netip.MustParsePrefix(r.CIDR).Masked()
+	family := "ip"
+	if prefix.Addr().Is6() {
+		family = "ip6"
+	}
+	match := family + " daddr " + prefix.String()
+
+	if len(r.Ports) == 0 {
+		if r.Protocol == "" {
+			return []string{match}
+		}
+		return []string{match + " meta l4proto " + r.Protocol}
+	}
+
+	ports := make([]string, 0, len(r.Ports))
+	for _, p := range r.Ports {
+		ports = append(ports, strconv.Itoa(p))
+	}
+	dport := " dport { " + strings.Join(ports, ", ") + " }"
+
+	var lines []string
+	for _, proto := range []string{"tcp", "udp"} {
+		if r.Protocol == "" || r.Protocol == proto {
+			lines = append(lines, match+" "+proto+dport)
+		}
+	}
+
+	return lines
+}
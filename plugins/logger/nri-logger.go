@@ -84,7 +84,7 @@ func (p *plugin) Synchronize(pods []*api.PodSandbox, containers []*api.Container
 	return nil, nil
 }
 
-func (p *plugin) Shutdown() {
+func (p *plugin) Shutdown(_ *api.ShutdownRequest) {
 	dump("Shutdown")
 }
 
@@ -161,6 +161,86 @@ func (p *plugin) RemoveContainer(pod *api.PodSandbox, container *api.Container)
 	return nil
 }
 
+func (p *plugin) CheckpointPodSandbox(pod *api.PodSandbox) error {
+	dump("CheckpointPodSandbox", "pod", pod)
+	return nil
+}
+
+func (p *plugin) RestorePodSandbox(pod *api.PodSandbox) error {
+	dump("RestorePodSandbox", "pod", pod)
+	return nil
+}
+
+func (p *plugin) CheckpointContainer(pod *api.PodSandbox, container *api.Container) error {
+	dump("CheckpointContainer", "pod", pod, "container", container)
+	return nil
+}
+
+func (p *plugin) RestoreContainer(pod *api.PodSandbox, container *api.Container) error {
+	dump("RestoreContainer", "pod", pod, "container", container)
+	return nil
+}
+
+func (p *plugin) ImagePulled(image *api.Image) error {
+	dump("ImagePulled", "image", image)
+	return nil
+}
+
+func (p *plugin) ImageRemoved(image *api.Image) error {
+	dump("ImageRemoved", "image", image)
+	return nil
+}
+
+func (p *plugin) PreExec(pod *api.PodSandbox, container *api.Container, exec *api.ExecSession) error {
+	dump("PreExec", "pod", pod, "container", container, "exec", exec)
+	return nil
+}
+
+func (p *plugin) PostExec(pod *api.PodSandbox, container *api.Container, exec *api.ExecSession) error {
+	dump("PostExec", "pod", pod, "container", container, "exec", exec)
+	return nil
+}
+
+func (p *plugin) ContainerOOM(pod *api.PodSandbox, container *api.Container) error {
+	dump("ContainerOOM", "pod", pod, "container", container)
+	return nil
+}
+
+func (p *plugin) ContainerExit(pod *api.PodSandbox, container *api.Container, exit *api.ContainerExit) error {
+	dump("ContainerExit", "pod", pod, "container", container, "exit", exit)
+	return nil
+}
+
+func (p *plugin) UpdatePodSandbox(pod *api.PodSandbox, resources *api.LinuxResources) error {
+	dump("UpdatePodSandbox", "pod", pod, "resources", resources)
+	return nil
+}
+
+func (p *plugin) PostUpdatePodSandbox(pod *api.PodSandbox) error {
+	dump("PostUpdatePodSandbox", "pod", pod)
+	return nil
+}
+
+func (p *plugin) ResourcePressure(pod *api.PodSandbox, container *api.Container, pressure *api.Pressure) error {
+	dump("ResourcePressure", "pod", pod, "container", container, "pressure", pressure)
+	return nil
+}
+
+func (p *plugin) NetworkStatus(networks []*api.NetworkStatus) error {
+	dump("NetworkStatus", "networks", networks)
+	return nil
+}
+
+func (p *plugin) NetworkGC(attachments []*api.NetworkAttachment) error {
+	dump("NetworkGC", "attachments", attachments)
+	return nil
+}
+
+func (p *plugin) SetupNetworkFailed(pod *api.PodSandbox, failure *api.NetworkSetupFailure) (*api.NetworkSetupRetry, error) {
+	dump("SetupNetworkFailed", "pod", pod, "failure", failure)
+	return nil, nil
+}
+
 func (p *plugin) onClose() {
 	os.Exit(0)
 }
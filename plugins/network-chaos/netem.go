@@ -0,0 +1,132 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+var (
+	// netem parameters we accept, and the number of values they take
+	netemParams = map[string]int{
+		"delay":     1,
+		"jitter":    1,
+		"loss":      1,
+		"duplicate": 1,
+		"corrupt":   1,
+		"reorder":   1,
+		"rate":      1,
+		"limit":     1,
+	}
+	// netem parameter values, like 100ms, 1%, 10mbit
+	netemValue = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?[a-z%]*$`)
+)
+
+// parseNetem parses and checks netem parameters, like "delay 100ms loss 1%",
+// returning them as tc arguments. A jitter is passed as the second value
+// of delay, as tc expects it.
+func parseNetem(spec string) ([]string, error) {
+	var (
+		fields = strings.Fields(spec)
+		args   []string
+		jitter string
+	)
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no netem parameters")
+	}
+
+	for i := 0; i < len(fields); i++ {
+		name := fields[i]
+		n, ok := netemParams[name]
+		if !ok {
+			return nil, fmt.Errorf("unsupported netem parameter %q", name)
+		}
+		if i+n >= len(fields) {
+			return nil, fmt.Errorf("missing value for netem parameter %q", name)
+		}
+		value := fields[i+1]
+		if !netemValue.MatchString(value) {
+			return nil, fmt.Errorf("invalid value %q for netem parameter %q", value, name)
+		}
+		i += n
+
+		if name == "jitter" {
+			jitter = value
+			continue
+		}
+		args = append(args, name, value)
+	}
+
+	if jitter != "" {
+		found := false
+		for i, arg := range args {
+			if arg == "delay" {
+				args = append(args[:i+2], append([]string{jitter}, args[i+2:]...)...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("netem jitter without delay")
+		}
+	}
+
+	return args, nil
+}
+
+// netem sets up and removes netem qdiscs on interfaces in a network namespace.
+type netem struct {
+	dryRun bool
+}
+
+// inject replaces the root qdisc of the interface with netem.
+func (n *netem) inject(netns, dev string, args []string) error {
+	return n.tc(netns, append([]string{"qdisc", "replace", "dev", dev, "root", "netem"}, args...)...)
+}
+
+// remove removes netem from the interface, restoring the default qdisc.
+// The namespace or interface may already be gone with the pod network,
+// which is not an error.
+func (n *netem) remove(netns, dev string) error {
+	err := n.tc(netns, "qdisc", "del", "dev", dev, "root")
+	if err != nil && (strings.Contains(err.Error(), "Cannot find device") ||
+		strings.Contains(err.Error(), "No such file or directory")) {
+		return nil
+	}
+	return err
+}
+
+// tc runs a tc command in a network namespace.
+func (n *netem) tc(netns string, args ...string) error {
+	log.Infof("[%s] tc %s", netns, strings.Join(args, " "))
+	if n.dryRun {
+		return nil
+	}
+
+	cmd := append([]string{"--net=" + netns, "tc"}, args...)
+	out, err := exec.Command("nsenter", cmd...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("tc %s failed: %w: %s", strings.Join(args, " "), err,
+			strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
@@ -0,0 +1,234 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+const (
+	// Annotation with the netem parameters to inject.
+	netemKey = "network-chaos.nri.io/netem"
+	// Annotation with the pod interface to inject into.
+	interfaceKey = "network-chaos.nri.io/interface"
+	// Annotation with the time after which the fault is removed.
+	durationKey = "network-chaos.nri.io/duration"
+	// Pod interface to inject into by default.
+	defaultInterface = "eth0"
+)
+
+var (
+	log *logrus.Logger
+)
+
+// our network chaos plugin
+type plugin struct {
+	sync.Mutex
+	stub   stub.Stub
+	netem  *netem
+	faults map[string]*fault
+}
+
+// a fault injected into a pod
+type fault struct {
+	pod   string
+	netns string
+	dev   string
+	spec  string
+	timer *time.Timer
+}
+
+// Synchronize injects faults into existing pods.
+func (p *plugin) Synchronize(pods []*api.PodSandbox, _ []*api.Container) ([]*api.ContainerUpdate, error) {
+	for _, pod := range pods {
+		if err := p.RunPodSandbox(pod); err != nil {
+			log.Warnf("%s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+	}
+	return nil, nil
+}
+
+// RunPodSandbox injects the fault requested for the pod, once its network
+// is set up.
+func (p *plugin) RunPodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+	return p.reconcile(pod)
+}
+
+// PostUpdatePodSandbox updates or removes the fault of the pod, if it was
+// changed or removed from the pod annotations.
+func (p *plugin) PostUpdatePodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+	if err := p.reconcile(pod); err != nil {
+		log.Warnf("%s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+	return nil
+}
+
+// StopPodSandbox removes the fault of the pod before its network is torn down.
+func (p *plugin) StopPodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+	p.remove(pod.Id)
+	return nil
+}
+
+// reconcile brings the fault of a pod in sync with its annotations.
+func (p *plugin) reconcile(pod *api.PodSandbox) error {
+	spec, ok := pod.Annotations[netemKey]
+	if !ok {
+		p.remove(pod.Id)
+		return nil
+	}
+
+	dev := defaultInterface
+	if name, ok := pod.Annotations[interfaceKey]; ok {
+		dev = name
+	}
+
+	if f, ok := p.faults[pod.Id]; ok && f.spec == spec && f.dev == dev {
+		return nil
+	}
+	p.remove(pod.Id)
+
+	args, err := parseNetem(spec)
+	if err != nil {
+		return fmt.Errorf("invalid %s annotation: %w", netemKey, err)
+	}
+
+	var duration time.Duration
+	if value, ok := pod.Annotations[durationKey]; ok {
+		if duration, err = time.ParseDuration(value); err != nil || duration <= 0 {
+			return fmt.Errorf("invalid %s annotation %q", durationKey, value)
+		}
+	}
+
+	netns := networkNamespace(pod)
+	if netns == "" {
+		return fmt.Errorf("no network namespace for pod")
+	}
+
+	if err := p.netem.inject(netns, dev, args); err != nil {
+		return err
+	}
+
+	f := &fault{
+		pod:   pod.Namespace + "/" + pod.Name,
+		netns: netns,
+		dev:   dev,
+		spec:  spec,
+	}
+	if duration > 0 {
+		id := pod.Id
+		f.timer = time.AfterFunc(duration, func() {
+			p.Lock()
+			defer p.Unlock()
+			if p.faults[id] == f {
+				p.remove(id)
+			}
+		})
+	}
+	p.faults[pod.Id] = f
+
+	log.Infof("%s: injected %q into %s", f.pod, strings.Join(args, " "), dev)
+
+	return nil
+}
+
+// remove removes the fault of a pod, if any.
+func (p *plugin) remove(id string) {
+	f, ok := p.faults[id]
+	if !ok {
+		return
+	}
+	delete(p.faults, id)
+
+	if f.timer != nil {
+		f.timer.Stop()
+	}
+
+	if err := p.netem.remove(f.netns, f.dev); err != nil {
+		log.Warnf("%s: failed to remove fault from %s: %v", f.pod, f.dev, err)
+		return
+	}
+
+	log.Infof("%s: removed fault from %s", f.pod, f.dev)
+}
+
+// networkNamespace returns the path of the network namespace of the pod.
+func networkNamespace(pod *api.PodSandbox) string {
+	for _, ns := range pod.GetLinux().GetNamespaces() {
+		if ns.Type == "network" {
+			return ns.Path
+		}
+	}
+	return ""
+}
+
+func main() {
+	var (
+		pluginName string
+		pluginIdx  string
+		opts       []stub.Option
+		err        error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	p := &plugin{
+		netem:  &netem{},
+		faults: make(map[string]*fault),
+	}
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.BoolVar(&p.netem.dryRun, "dry-run", false, "only log tc commands, don't run them")
+	flag.Parse()
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	if p.stub, err = stub.New(p, opts...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
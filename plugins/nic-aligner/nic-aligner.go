@@ -0,0 +1,140 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strconv"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+const (
+	// Prefix of the key used for network device annotations.
+	deviceKey = "nic-aligner.nri.io/device"
+)
+
+var (
+	log *logrus.Logger
+)
+
+// our NIC aligner plugin
+type plugin struct {
+	stub  stub.Stub
+	sysfs *sysfs
+}
+
+// CreateContainer aligns the cpuset of the container with the NUMA node of
+// the network device selected for its pod.
+func (p *plugin) CreateContainer(pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+	name := pod.Namespace + "/" + pod.Name + "/" + ctr.Name
+
+	dev, err := selectDevice(pod, ctr)
+	if err != nil {
+		log.Warnf("%s: not aligning, failed to find network device: %v", name, err)
+		return nil, nil, nil
+	}
+	if dev == "" {
+		return nil, nil, nil
+	}
+
+	node, err := p.sysfs.numaNode(dev)
+	if err != nil {
+		log.Warnf("%s: not aligning: %v", name, err)
+		return nil, nil, nil
+	}
+	if node < 0 {
+		log.Infof("%s: not aligning, %s has no NUMA affinity", name, dev)
+		return nil, nil, nil
+	}
+
+	cpus, err := p.sysfs.cpus(node)
+	if err != nil {
+		log.Warnf("%s: not aligning: %v", name, err)
+		return nil, nil, nil
+	}
+
+	adjust := &api.ContainerAdjustment{}
+	adjust.SetLinuxCPUSetCPUs(cpus)
+	adjust.SetLinuxNUMANodes(strconv.Itoa(node))
+	adjust.AddLinuxPreferredNetworkDevice(dev)
+
+	log.Infof("%s: aligned to NUMA node %d (CPUs %s) of %s", name, node, cpus, dev)
+
+	return adjust, nil, nil
+}
+
+// selectDevice returns the network device selected for the container, by
+// annotation or by the CNI result of the pod network.
+func selectDevice(pod *api.PodSandbox, ctr *api.Container) (string, error) {
+	for _, key := range []string{
+		deviceKey + "/container." + ctr.Name,
+		deviceKey + "/pod",
+		deviceKey,
+	} {
+		if value, ok := pod.Annotations[key]; ok {
+			return value, nil
+		}
+	}
+
+	return podDevice(pod)
+}
+
+func main() {
+	var (
+		pluginName string
+		pluginIdx  string
+		opts       []stub.Option
+		err        error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	p := &plugin{
+		sysfs: &sysfs{},
+	}
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.StringVar(&p.sysfs.root, "sysfs", "/sys", "sysfs mount point to look up topology from")
+	flag.Parse()
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	if p.stub, err = stub.New(p, opts...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+var (
+	// PCI addresses, like 0000:3b:00.1.
+	pciAddress = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`)
+)
+
+// sysfs looks up the topology of network devices.
+type sysfs struct {
+	root string
+}
+
+// numaNode returns the NUMA node of a network device, given by interface
+// name or PCI address. It returns -1 if the node is not known, as on hosts
+// without NUMA.
+func (s *sysfs) numaNode(dev string) (int, error) {
+	var file string
+	if pciAddress.MatchString(dev) {
+		file = filepath.Join(s.root, "bus/pci/devices", dev, "numa_node")
+	} else {
+		file = filepath.Join(s.root, "class/net", dev, "device/numa_node")
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return -1, fmt.Errorf("failed to look up NUMA node of %s: %w", dev, err)
+	}
+
+	node, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return -1, fmt.Errorf("invalid NUMA node for %s: %w", dev, err)
+	}
+
+	return node, nil
+}
+
+// cpus returns the CPUs of a NUMA node, in cpuset list format.
+func (s *sysfs) cpus(node int) (string, error) {
+	file := filepath.Join(s.root, "devices/system/node", "node"+strconv.Itoa(node), "cpulist")
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up CPUs of NUMA node %d: %w", node, err)
+	}
+
+	cpus := strings.TrimSpace(string(data))
+	if cpus == "" {
+		return "", fmt.Errorf("NUMA node %d has no CPUs", node)
+	}
+
+	return cpus, nil
+}
+
+// the parts of a CNI result we need
+type cniResult struct {
+	Interfaces []*cniInterface `json:"interfaces"`
+}
+
+type cniInterface struct {
+	Name    string `json:"name"`
+	Sandbox string `json:"sandbox"`
+	PciID   string `json:"pciID"`
+}
+
+// a cached CNI result, as stored by libcni
+type cniCacheEntry struct {
+	Result *cniResult `json:"result"`
+}
+
+// podDevice returns the PCI address of the first network device moved into
+// the pod, like an SR-IOV VF, from the CNI result referenced by the pod
+// network status. The reference is either the result itself, or the path
+// of a cached result.
+func podDevice(pod *api.PodSandbox) (string, error) {
+	ref := strings.TrimSpace(pod.GetNetwork().GetCniResult())
+	if ref == "" {
+		return "", errors.New("no CNI result for pod")
+	}
+
+	data := []byte(ref)
+	if !strings.HasPrefix(ref, "{") {
+		var err error
+		if data, err = os.ReadFile(ref); err != nil {
+			return "", fmt.Errorf("failed to read CNI result: %w", err)
+		}
+	}
+
+	entry := &cniCacheEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return "", fmt.Errorf("failed to parse CNI result: %w", err)
+	}
+	result := entry.Result
+	if result == nil {
+		result = &cniResult{}
+		if err := json.Unmarshal(data, result); err != nil {
+			return "", fmt.Errorf("failed to parse CNI result: %w", err)
+		}
+	}
+
+	for _, iface := range result.Interfaces {
+		if iface.Sandbox != "" && iface.PciID != "" {
+			return iface.PciID, nil
+		}
+	}
+
+	return "", nil
+}
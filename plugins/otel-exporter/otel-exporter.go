@@ -0,0 +1,377 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+var (
+	log *logrus.Logger
+)
+
+// our OpenTelemetry exporter plugin
+type plugin struct {
+	sync.Mutex
+	stub     stub.Stub
+	exporter *exporter
+	pods     map[string]*podTrace
+}
+
+// spans of a pod
+type podTrace struct {
+	span       *span
+	startup    *span
+	pending    int
+	containers map[string]*containerTrace
+}
+
+// spans of a container
+type containerTrace struct {
+	span  *span
+	start *span
+}
+
+// Synchronize starts tracing existing pods and containers.
+func (p *plugin) Synchronize(pods []*api.PodSandbox, containers []*api.Container) ([]*api.ContainerUpdate, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	podMap := make(map[string]*api.PodSandbox)
+	for _, pod := range pods {
+		podMap[pod.Id] = pod
+		p.pod(pod)
+	}
+	for _, ctr := range containers {
+		if pod, ok := podMap[ctr.PodSandboxId]; ok {
+			p.container(pod, ctr)
+		}
+	}
+
+	return nil, nil
+}
+
+// RunPodSandbox starts the span of the pod and its startup.
+func (p *plugin) RunPodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+
+	now := time.Now()
+	t := newPodTrace(pod, now)
+	t.startup = newSpan(t.span.TraceID, t.span.SpanID, "pod startup", now)
+	p.pods[pod.Id] = t
+
+	return nil
+}
+
+// StopPodSandbox records stopping the pod.
+func (p *plugin) StopPodSandbox(pod *api.PodSandbox) error {
+	p.podEvent(pod, "StopPodSandbox")
+	return nil
+}
+
+// UpdatePodSandbox records updating the pod.
+func (p *plugin) UpdatePodSandbox(pod *api.PodSandbox, _ *api.LinuxResources) error {
+	p.podEvent(pod, "UpdatePodSandbox")
+	return nil
+}
+
+// PostUpdatePodSandbox records having updated the pod.
+func (p *plugin) PostUpdatePodSandbox(pod *api.PodSandbox) error {
+	p.podEvent(pod, "PostUpdatePodSandbox")
+	return nil
+}
+
+// RemovePodSandbox ends all spans of the pod.
+func (p *plugin) RemovePodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+
+	t, ok := p.pods[pod.Id]
+	if !ok {
+		return nil
+	}
+	delete(p.pods, pod.Id)
+
+	now := time.Now()
+	for _, c := range t.containers {
+		if c.start != nil {
+			p.exporter.finish(c.start, now)
+		}
+		p.exporter.finish(c.span, now)
+	}
+	if t.startup != nil {
+		p.exporter.finish(t.startup, now)
+	}
+	p.exporter.finish(t.span, now)
+
+	return nil
+}
+
+// CreateContainer starts the span of the container and its startup.
+func (p *plugin) CreateContainer(pod *api.PodSandbox, ctr *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	t := p.pod(pod)
+	c := p.container(pod, ctr)
+	c.start = newSpan(c.span.TraceID, c.span.SpanID, "container startup", time.Now())
+	t.pending++
+
+	return nil, nil, nil
+}
+
+// PostCreateContainer records having created the container.
+func (p *plugin) PostCreateContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	p.containerEvent(pod, ctr, "PostCreateContainer")
+	return nil
+}
+
+// StartContainer records starting the container.
+func (p *plugin) StartContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	p.containerEvent(pod, ctr, "StartContainer")
+	return nil
+}
+
+// PostStartContainer ends the startup span of the container, and that of
+// the pod once no other containers of the pod are starting.
+func (p *plugin) PostStartContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	p.Lock()
+	defer p.Unlock()
+
+	t := p.pod(pod)
+	c := p.container(pod, ctr)
+	if c.start == nil {
+		return nil
+	}
+
+	now := time.Now()
+	p.exporter.finish(c.start, now)
+	c.start = nil
+
+	if t.pending--; t.pending == 0 && t.startup != nil {
+		p.exporter.finish(t.startup, now)
+		t.startup = nil
+	}
+
+	return nil
+}
+
+// UpdateContainer records updating the container.
+func (p *plugin) UpdateContainer(pod *api.PodSandbox, ctr *api.Container) ([]*api.ContainerUpdate, error) {
+	p.containerEvent(pod, ctr, "UpdateContainer")
+	return nil, nil
+}
+
+// PostUpdateContainer records having updated the container.
+func (p *plugin) PostUpdateContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	p.containerEvent(pod, ctr, "PostUpdateContainer")
+	return nil
+}
+
+// StopContainer records stopping the container.
+func (p *plugin) StopContainer(pod *api.PodSandbox, ctr *api.Container) ([]*api.ContainerUpdate, error) {
+	p.containerEvent(pod, ctr, "StopContainer")
+	return nil, nil
+}
+
+// ContainerExit records the exit of the container.
+func (p *plugin) ContainerExit(pod *api.PodSandbox, ctr *api.Container, exit *api.ContainerExit) error {
+	p.containerEvent(pod, ctr, "ContainerExit",
+		intAttr("exit.code", int64(exit.GetExitCode())),
+		stringAttr("exit.reason", exit.GetReason()),
+		boolAttr("exit.oom_killed", exit.GetOomKilled()),
+	)
+	return nil
+}
+
+// ContainerOOM records an OOM kill in the container.
+func (p *plugin) ContainerOOM(pod *api.PodSandbox, ctr *api.Container) error {
+	p.containerEvent(pod, ctr, "ContainerOOM")
+	return nil
+}
+
+// PreExec records an exec session in the container.
+func (p *plugin) PreExec(pod *api.PodSandbox, ctr *api.Container, exec *api.ExecSession) error {
+	p.containerEvent(pod, ctr, "PreExec", boolAttr("exec.attach", exec.GetAttach()))
+	return nil
+}
+
+// RemoveContainer ends the spans of the container.
+func (p *plugin) RemoveContainer(pod *api.PodSandbox, ctr *api.Container) error {
+	p.Lock()
+	defer p.Unlock()
+
+	t := p.pod(pod)
+	c := p.container(pod, ctr)
+	delete(t.containers, ctr.Id)
+
+	now := time.Now()
+	if c.start != nil {
+		p.exporter.finish(c.start, now)
+		t.pending--
+	}
+	p.exporter.finish(c.span, now)
+
+	return nil
+}
+
+// ImagePulled exports a span for the pulled image.
+func (p *plugin) ImagePulled(image *api.Image) error {
+	p.imageSpan("image pulled", image)
+	return nil
+}
+
+// ImageRemoved exports a span for the removed image.
+func (p *plugin) ImageRemoved(image *api.Image) error {
+	p.imageSpan("image removed", image)
+	return nil
+}
+
+// pod returns the spans of a pod, starting them if the pod is not traced
+// yet, as for pods which existed before the plugin started.
+func (p *plugin) pod(pod *api.PodSandbox) *podTrace {
+	if t, ok := p.pods[pod.Id]; ok {
+		return t
+	}
+
+	t := newPodTrace(pod, time.Now())
+	t.span.Attributes = append(t.span.Attributes, boolAttr("nri.synchronized", true))
+	p.pods[pod.Id] = t
+
+	return t
+}
+
+// newPodTrace starts the span of a pod at the given time.
+func newPodTrace(pod *api.PodSandbox, start time.Time) *podTrace {
+	return &podTrace{
+		span: newSpan(traceID(pod.Uid), "", "pod "+pod.Namespace+"/"+pod.Name, start,
+			stringAttr("k8s.namespace.name", pod.Namespace),
+			stringAttr("k8s.pod.name", pod.Name),
+			stringAttr("k8s.pod.uid", pod.Uid),
+			stringAttr("nri.pod.id", pod.Id),
+			stringAttr("nri.runtime.handler", pod.RuntimeHandler),
+		),
+		containers: make(map[string]*containerTrace),
+	}
+}
+
+// container returns the spans of a container, starting them if necessary.
+func (p *plugin) container(pod *api.PodSandbox, ctr *api.Container) *containerTrace {
+	t := p.pod(pod)
+	if c, ok := t.containers[ctr.Id]; ok {
+		return c
+	}
+
+	c := &containerTrace{
+		span: newSpan(t.span.TraceID, t.span.SpanID, "container "+ctr.Name, time.Now(),
+			stringAttr("k8s.container.name", ctr.Name),
+			stringAttr("nri.container.id", ctr.Id),
+		),
+	}
+	t.containers[ctr.Id] = c
+
+	return c
+}
+
+// podEvent records an event in the span of a pod.
+func (p *plugin) podEvent(pod *api.PodSandbox, name string, attrs ...*attribute) {
+	p.Lock()
+	defer p.Unlock()
+	p.pod(pod).span.addEvent(name, time.Now(), attrs...)
+}
+
+// containerEvent records an event in the span of a container.
+func (p *plugin) containerEvent(pod *api.PodSandbox, ctr *api.Container, name string, attrs ...*attribute) {
+	p.Lock()
+	defer p.Unlock()
+	p.container(pod, ctr).span.addEvent(name, time.Now(), attrs...)
+}
+
+// imageSpan exports a span for an image event.
+func (p *plugin) imageSpan(name string, image *api.Image) {
+	now := time.Now()
+	s := newSpan(traceID(image.GetDigest()+image.GetName()), "", name, now,
+		stringAttr("container.image.name", image.GetName()),
+		stringAttr("container.image.digest", image.GetDigest()),
+		intAttr("container.image.size", int64(image.GetSize())),
+	)
+	p.exporter.finish(s, now)
+}
+
+func main() {
+	var (
+		pluginName string
+		pluginIdx  string
+		endpoint   string
+		service    string
+		interval   time.Duration
+		verbose    bool
+		opts       []stub.Option
+		err        error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.StringVar(&endpoint, "endpoint", "http://localhost:4318/v1/traces", "OTLP/HTTP traces endpoint to export to")
+	flag.StringVar(&service, "service", "nri", "service name to export spans with")
+	flag.DurationVar(&interval, "interval", 5*time.Second, "interval to export spans at")
+	flag.BoolVar(&verbose, "verbose", false, "enable (more) verbose logging")
+	flag.Parse()
+
+	if verbose {
+		log.SetLevel(logrus.DebugLevel)
+	}
+
+	p := &plugin{
+		exporter: newExporter(endpoint, service),
+		pods:     make(map[string]*podTrace),
+	}
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	if p.stub, err = stub.New(p, opts...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	go p.exporter.run(interval)
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,219 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Largest number of finished spans buffered for export.
+	maxBufferedSpans = 4096
+	// Kind of all spans we export, SPAN_KIND_INTERNAL.
+	spanKindInternal = 1
+)
+
+// span is an OpenTelemetry span, in the OTLP/JSON encoding.
+type span struct {
+	TraceID      string       `json:"traceId"`
+	SpanID       string       `json:"spanId"`
+	ParentSpanID string       `json:"parentSpanId,omitempty"`
+	Name         string       `json:"name"`
+	Kind         int          `json:"kind"`
+	Start        string       `json:"startTimeUnixNano"`
+	End          string       `json:"endTimeUnixNano"`
+	Attributes   []*attribute `json:"attributes,omitempty"`
+	Events       []*event     `json:"events,omitempty"`
+}
+
+// event is a timestamped event of a span.
+type event struct {
+	Time       string       `json:"timeUnixNano"`
+	Name       string       `json:"name"`
+	Attributes []*attribute `json:"attributes,omitempty"`
+}
+
+// attribute is a key-value pair of a span, an event or a resource.
+type attribute struct {
+	Key   string `json:"key"`
+	Value *value `json:"value"`
+}
+
+type value struct {
+	String *string `json:"stringValue,omitempty"`
+	Int    *string `json:"intValue,omitempty"`
+	Bool   *bool   `json:"boolValue,omitempty"`
+}
+
+// stringAttr returns a string attribute.
+func stringAttr(key, v string) *attribute {
+	return &attribute{Key: key, Value: &value{String: &v}}
+}
+
+// intAttr returns an integer attribute.
+func intAttr(key string, v int64) *attribute {
+	s := strconv.FormatInt(v, 10)
+	return &attribute{Key: key, Value: &value{Int: &s}}
+}
+
+// boolAttr returns a boolean attribute.
+func boolAttr(key string, v bool) *attribute {
+	return &attribute{Key: key, Value: &value{Bool: &v}}
+}
+
+// timestamp returns a time in the OTLP/JSON encoding.
+func timestamp(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// traceID derives a stable trace ID from an ID, so that all spans of a pod
+// end up in the same trace, even across plugin restarts.
+func traceID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:16])
+}
+
+// spanID returns a random span ID.
+func spanID() string {
+	var id [8]byte
+	_, _ = rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
+
+// newSpan starts a span at the given time.
+func newSpan(trace, parent, name string, start time.Time, attrs ...*attribute) *span {
+	return &span{
+		TraceID:      trace,
+		SpanID:       spanID(),
+		ParentSpanID: parent,
+		Name:         name,
+		Kind:         spanKindInternal,
+		Start:        timestamp(start),
+		Attributes:   attrs,
+	}
+}
+
+// addEvent adds an event to the span.
+func (s *span) addEvent(name string, t time.Time, attrs ...*attribute) {
+	s.Events = append(s.Events, &event{
+		Time:       timestamp(t),
+		Name:       name,
+		Attributes: attrs,
+	})
+}
+
+// exporter exports finished spans in batches using OTLP/HTTP with JSON
+// encoding.
+type exporter struct {
+	sync.Mutex
+	endpoint string
+	service  string
+	client   *http.Client
+	spans    []*span
+	dropped  int
+}
+
+// newExporter creates an exporter for the given OTLP/HTTP traces endpoint.
+func newExporter(endpoint, service string) *exporter {
+	return &exporter{
+		endpoint: endpoint,
+		service:  service,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// finish ends the span and queues it for export.
+func (e *exporter) finish(s *span, end time.Time) {
+	s.End = timestamp(end)
+
+	e.Lock()
+	defer e.Unlock()
+
+	if len(e.spans) >= maxBufferedSpans {
+		e.dropped++
+		return
+	}
+	e.spans = append(e.spans, s)
+}
+
+// run periodically exports queued spans.
+func (e *exporter) run(interval time.Duration) {
+	for range time.Tick(interval) {
+		if err := e.flush(); err != nil {
+			log.Warnf("failed to export spans: %v", err)
+		}
+	}
+}
+
+// flush exports queued spans. Spans failing to export are dropped.
+func (e *exporter) flush() error {
+	e.Lock()
+	spans, dropped := e.spans, e.dropped
+	e.spans, e.dropped = nil, 0
+	e.Unlock()
+
+	if dropped > 0 {
+		log.Warnf("dropped %d spans, export buffer full", dropped)
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": []*attribute{stringAttr("service.name", e.service)},
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]string{"name": "github.com/containerd/nri/plugins/otel-exporter"},
+						"spans": spans,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode %d spans: %w", len(spans), err)
+	}
+
+	rpl, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to send %d spans: %w", len(spans), err)
+	}
+	defer rpl.Body.Close()
+	_, _ = io.Copy(io.Discard, rpl.Body)
+
+	if rpl.StatusCode/100 != 2 {
+		return fmt.Errorf("failed to send %d spans: %s", len(spans), rpl.Status)
+	}
+
+	log.Debugf("exported %d spans", len(spans))
+
+	return nil
+}
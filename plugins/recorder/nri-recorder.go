@@ -0,0 +1,216 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/containerd/nri/pkg/adaptation/adaptationtest"
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+type config struct {
+	TraceFile string `json:"traceFile"`
+}
+
+type plugin struct {
+	sync.Mutex
+	stub  stub.Stub
+	trace *adaptationtest.Trace
+}
+
+var (
+	cfg config
+	log *logrus.Logger
+	_   = stub.ConfigureInterface(&plugin{})
+	_   = stub.PodLifecycleInterface(&plugin{})
+	_   = stub.ContainerAdjusterInterface(&plugin{})
+	_   = stub.ContainerLifecycleInterface(&plugin{})
+)
+
+// Events the recorder subscribes to. These are the ones a trace can replay.
+var events = api.MustParseEventMask("pod", "container")
+
+func (p *plugin) Configure(config, runtime, version string) (stub.EventMask, error) {
+	log.Infof("got configuration data: %q from runtime %s %s", config, runtime, version)
+	if config == "" {
+		return events, nil
+	}
+
+	if err := yaml.Unmarshal([]byte(config), &cfg); err != nil {
+		return 0, fmt.Errorf("failed to parse provided configuration: %w", err)
+	}
+	if cfg.TraceFile == "" {
+		return 0, fmt.Errorf("invalid configuration, no trace file given")
+	}
+
+	return events, nil
+}
+
+func (p *plugin) Synchronize(pods []*api.PodSandbox, containers []*api.Container) ([]*api.ContainerUpdate, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.trace = &adaptationtest.Trace{
+		Pods:       pods,
+		Containers: containers,
+	}
+
+	return nil, p.save()
+}
+
+func (p *plugin) RunPodSandbox(pod *api.PodSandbox) error {
+	return p.record("RunPodSandbox", pod, nil)
+}
+
+func (p *plugin) StopPodSandbox(pod *api.PodSandbox) error {
+	return p.record("StopPodSandbox", pod, nil)
+}
+
+func (p *plugin) RemovePodSandbox(pod *api.PodSandbox) error {
+	return p.record("RemovePodSandbox", pod, nil)
+}
+
+func (p *plugin) CreateContainer(pod *api.PodSandbox, container *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+	return nil, nil, p.record("CreateContainer", pod, container)
+}
+
+func (p *plugin) PostCreateContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record("PostCreateContainer", pod, container)
+}
+
+func (p *plugin) StartContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record("StartContainer", pod, container)
+}
+
+func (p *plugin) PostStartContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record("PostStartContainer", pod, container)
+}
+
+func (p *plugin) UpdateContainer(pod *api.PodSandbox, container *api.Container) ([]*api.ContainerUpdate, error) {
+	return nil, p.record("UpdateContainer", pod, container)
+}
+
+func (p *plugin) PostUpdateContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record("PostUpdateContainer", pod, container)
+}
+
+func (p *plugin) StopContainer(pod *api.PodSandbox, container *api.Container) ([]*api.ContainerUpdate, error) {
+	return nil, p.record("StopContainer", pod, container)
+}
+
+func (p *plugin) RemoveContainer(pod *api.PodSandbox, container *api.Container) error {
+	return p.record("RemoveContainer", pod, container)
+}
+
+func (p *plugin) onClose() {
+	log.Infof("connection to the runtime lost, exiting...")
+	os.Exit(0)
+}
+
+// record a request or event, then save the trace.
+func (p *plugin) record(event string, pod *api.PodSandbox, container *api.Container) error {
+	p.Lock()
+	defer p.Unlock()
+
+	if p.trace == nil {
+		p.trace = &adaptationtest.Trace{}
+	}
+
+	now := time.Now()
+	step := &adaptationtest.TraceStep{
+		Time:      &now,
+		Event:     event,
+		Pod:       pod,
+		Container: container,
+	}
+	if event == "UpdateContainer" {
+		step.Resources = container.GetLinux().GetResources()
+	}
+	p.trace.Steps = append(p.trace.Steps, step)
+
+	if err := p.save(); err != nil {
+		log.Errorf("%v", err)
+	}
+
+	return nil
+}
+
+// save the trace, replacing the trace file atomically.
+func (p *plugin) save() error {
+	data, err := yaml.Marshal(p.trace)
+	if err != nil {
+		return fmt.Errorf("failed to marshal trace: %w", err)
+	}
+
+	tmp := filepath.Join(filepath.Dir(cfg.TraceFile), "."+filepath.Base(cfg.TraceFile)+".tmp")
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write trace: %w", err)
+	}
+	if err = os.Rename(tmp, cfg.TraceFile); err != nil {
+		return fmt.Errorf("failed to write trace: %w", err)
+	}
+
+	return nil
+}
+
+func main() {
+	var (
+		pluginName string
+		pluginIdx  string
+		opts       []stub.Option
+		err        error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.StringVar(&cfg.TraceFile, "trace-file", "nri-trace.yaml", "file to record the trace to")
+	flag.Parse()
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	p := &plugin{}
+	if p.stub, err = stub.New(p, append(opts, stub.WithOnClose(p.onClose))...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,248 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/netip"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/ipam"
+	"github.com/containerd/nri/pkg/state"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+const (
+	// Prefix of the key used for static IP annotations.
+	staticIPKey = "static-ip.nri.io"
+	// Annotation for the pool to allocate an address from.
+	poolKey = staticIPKey + "/pool"
+	// Annotation for a specific address to reserve.
+	addressKey = staticIPKey + "/address"
+	// Annotation the allocated address is published in.
+	assignedKey = staticIPKey + "/assigned"
+	// Environment variable the allocated address is injected as.
+	addressEnv = "STATIC_IP"
+)
+
+var (
+	log *logrus.Logger
+)
+
+// a configured address pool
+type poolConfig struct {
+	name    string
+	prefix  netip.Prefix
+	exclude []string
+}
+
+// poolFlags collects pools given on the command line.
+type poolFlags []*poolConfig
+
+func (f *poolFlags) String() string {
+	var pools []string
+	for _, p := range *f {
+		pools = append(pools, p.name+"="+p.prefix.String())
+	}
+	return strings.Join(pools, " ")
+}
+
+// Set parses a pool given as name=CIDR[,excluded-address...].
+func (f *poolFlags) Set(value string) error {
+	name, spec, ok := strings.Cut(value, "=")
+	if !ok || name == "" {
+		return fmt.Errorf("invalid pool %q, expected name=CIDR[,excluded...]", value)
+	}
+	fields := strings.Split(spec, ",")
+	prefix, err := netip.ParsePrefix(fields[0])
+	if err != nil {
+		return fmt.Errorf("invalid pool %q: %w", value, err)
+	}
+	*f = append(*f, &poolConfig{
+		name:    name,
+		prefix:  prefix.Masked(),
+		exclude: fields[1:],
+	})
+	return nil
+}
+
+// our static IP plugin
+type plugin struct {
+	stub  stub.Stub
+	pools poolFlags
+	alloc *ipam.Allocator
+}
+
+// Synchronize releases the addresses of pods which are gone.
+func (p *plugin) Synchronize(pods []*api.PodSandbox, _ []*api.Container) ([]*api.ContainerUpdate, error) {
+	owners := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		owners = append(owners, pod.Id)
+	}
+
+	released, err := p.alloc.Reconcile(owners)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range released {
+		log.Infof("released address %s of stale pod %s", a.Addr, a.Owner)
+	}
+
+	return nil, nil
+}
+
+// AdjustPodSandbox allocates an address to an annotated pod being created.
+func (p *plugin) AdjustPodSandbox(pod *api.PodSandbox) (*api.PodSandboxAdjustment, error) {
+	addr, err := p.allocate(pod)
+	if err != nil || !addr.IsValid() {
+		return nil, err
+	}
+
+	log.Infof("%s/%s: allocated address %s", pod.Namespace, pod.Name, addr)
+
+	adjust := &api.PodSandboxAdjustment{}
+	adjust.AddAnnotation(assignedKey, addr.String())
+	adjust.AddExtraHost(addr.String(), pod.Name)
+
+	return adjust, nil
+}
+
+// CreateContainer injects the address of the pod into its containers.
+func (p *plugin) CreateContainer(pod *api.PodSandbox, _ *api.Container) (*api.ContainerAdjustment, []*api.ContainerUpdate, error) {
+	for _, pool := range p.pools {
+		if addr, ok := p.alloc.Lookup(pod.Id, pool.name); ok {
+			adjust := &api.ContainerAdjustment{}
+			adjust.AddEnv(addressEnv, addr.String())
+			return adjust, nil, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// RemovePodSandbox releases the address of a pod being removed.
+func (p *plugin) RemovePodSandbox(pod *api.PodSandbox) error {
+	released, err := p.alloc.Release(pod.Id)
+	if err != nil {
+		return err
+	}
+	for _, a := range released {
+		log.Infof("%s/%s: released address %s", pod.Namespace, pod.Name, a.Addr)
+	}
+	return nil
+}
+
+// allocate an address for the pod according to its annotations.
+func (p *plugin) allocate(pod *api.PodSandbox) (netip.Addr, error) {
+	var (
+		poolName = pod.Annotations[poolKey]
+		address  = pod.Annotations[addressKey]
+	)
+
+	if address == "" {
+		if poolName == "" {
+			return netip.Addr{}, nil
+		}
+		return p.alloc.Allocate(pod.Id, poolName)
+	}
+
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid annotation %q: %w", addressKey, err)
+	}
+
+	if poolName == "" {
+		for _, pool := range p.pools {
+			if pool.prefix.Contains(addr) {
+				poolName = pool.name
+				break
+			}
+		}
+		if poolName == "" {
+			return netip.Addr{}, fmt.Errorf("address %s not in any pool", addr)
+		}
+	}
+
+	if err := p.alloc.Reserve(pod.Id, poolName, addr); err != nil {
+		return netip.Addr{}, err
+	}
+
+	return addr, nil
+}
+
+func main() {
+	var (
+		pluginName string
+		pluginIdx  string
+		stateFile  string
+		opts       []stub.Option
+		err        error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	p := &plugin{}
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.StringVar(&stateFile, "state-file", "/var/lib/nri/static-ip.json", "file to persist allocated addresses in")
+	flag.Var(&p.pools, "pool", "address pool as name=CIDR[,excluded...], can be repeated")
+	flag.Parse()
+
+	if len(p.pools) == 0 {
+		log.Fatalf("no address pools given")
+	}
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	store, err := state.Open(stateFile)
+	if err != nil {
+		log.Fatalf("failed to open state: %v", err)
+	}
+	defer store.Close()
+
+	allocOpts := []ipam.Option{ipam.WithStore(store)}
+	for _, pool := range p.pools {
+		allocOpts = append(allocOpts, ipam.WithPool(pool.name, pool.prefix.String(), pool.exclude...))
+	}
+	if p.alloc, err = ipam.New(allocOpts...); err != nil {
+		log.Fatalf("failed to create address allocator: %v", err)
+	}
+
+	if p.stub, err = stub.New(p, opts...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// rate units of tc, in bits per second
+var rateUnits = []struct {
+	suffix string
+	scale  uint64
+}{
+	// Longer suffixes first, so that kibit is not taken for bit.
+	{"kibit", 1 << 10}, {"mibit", 1 << 20}, {"gibit", 1 << 30}, {"tibit", 1 << 40},
+	{"kibps", 8 << 10}, {"mibps", 8 << 20}, {"gibps", 8 << 30}, {"tibps", 8 << 40},
+	{"kbit", 1e3}, {"mbit", 1e6}, {"gbit", 1e9}, {"tbit", 1e12},
+	{"kbps", 8e3}, {"mbps", 8e6}, {"gbps", 8e9}, {"tbps", 8e12},
+	{"bit", 1}, {"bps", 8},
+}
+
+// parseRate parses a rate in tc units into bits per second.
+func parseRate(rate string) (uint64, error) {
+	s := strings.ToLower(strings.TrimSpace(rate))
+	scale := uint64(1)
+	for _, u := range rateUnits {
+		if strings.HasSuffix(s, u.suffix) {
+			s, scale = strings.TrimSuffix(s, u.suffix), u.scale
+			break
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid rate %q", rate)
+	}
+	return uint64(v * float64(scale)), nil
+}
+
+// linkSpeed returns the speed of a network interface in bits per second.
+func linkSpeed(dev string) (uint64, error) {
+	data, err := os.ReadFile(filepath.Join("/sys/class/net", dev, "speed"))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read link speed of %s: %w", dev, err)
+	}
+	mbits, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil || mbits <= 0 {
+		return 0, fmt.Errorf("unknown link speed of %s", dev)
+	}
+	return uint64(mbits) * 1e6, nil
+}
+
+// checkCapacity sets up the node capacity of the configuration, and parses
+// the rates of the classes to account against it.
+func (cfg *config) checkCapacity() error {
+	var err error
+	switch {
+	case cfg.Capacity != "":
+		if cfg.capacity, err = parseRate(cfg.Capacity); err != nil {
+			return fmt.Errorf("invalid capacity: %w", err)
+		}
+	case cfg.CapacityInterface != "":
+		if cfg.capacity, err = linkSpeed(cfg.CapacityInterface); err != nil {
+			return err
+		}
+	default:
+		if cfg.DowngradeClass != "" {
+			return fmt.Errorf("downgrade class %s without a capacity", cfg.DowngradeClass)
+		}
+		return nil
+	}
+
+	if cfg.DowngradeClass != "" && cfg.class("", cfg.DowngradeClass) == nil {
+		return fmt.Errorf("unknown downgrade class %s", cfg.DowngradeClass)
+	}
+
+	classes := cfg.Classes
+	for _, overrides := range cfg.overrides {
+		for _, o := range overrides {
+			classes = append(classes, o)
+		}
+	}
+	for _, c := range classes {
+		if c.rate, err = parseRate(c.Rate); err != nil {
+			return fmt.Errorf("class %s: %w", c.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// admit checks that the rate guaranteed to the pod by its class fits within
+// the node capacity, together with the rates guaranteed to other pods. Pods
+// which don't fit are downgraded, if a downgrade class is configured and the
+// pod fits with that, otherwise they are rejected as retryable, since they
+// may fit once other pods stop.
+func (p *plugin) admit(pod *api.PodSandbox, c *class) (*class, error) {
+	capacity := p.cfg.capacity
+	if capacity == 0 {
+		return c, nil
+	}
+
+	committed := uint64(0)
+	for id, s := range p.shaped {
+		if id != pod.Id {
+			committed += s.rate
+		}
+	}
+
+	if committed+c.rate <= capacity {
+		return c, nil
+	}
+
+	if d := p.cfg.class(pod.Namespace, p.cfg.DowngradeClass); d != nil && committed+d.rate <= capacity {
+		log.Warnf("%s/%s: downgrading from class %s to %s, %d of %d bit/s guaranteed",
+			pod.Namespace, pod.Name, c.Name, d.Name, committed, capacity)
+		return d, nil
+	}
+
+	return nil, &api.ErrRejected{
+		Reason: "InsufficientBandwidth",
+		Message: fmt.Sprintf("not enough capacity for class %s, %d of %d bit/s guaranteed",
+			c.Name, committed, capacity),
+		Retryable: true,
+	}
+}
@@ -0,0 +1,87 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/containerd/nri/pkg/api"
+)
+
+// the parts of a CNI result we need
+type cniResult struct {
+	Interfaces []*cniInterface `json:"interfaces"`
+}
+
+type cniInterface struct {
+	Name    string `json:"name"`
+	Sandbox string `json:"sandbox"`
+}
+
+// a cached CNI result, as stored by libcni
+type cniCacheEntry struct {
+	Result *cniResult `json:"result"`
+}
+
+// hostInterface returns the name of the host-side veth interface of the pod
+// network, from the CNI result referenced by the pod network status. The
+// reference is either the result itself, or the path of a cached result.
+func hostInterface(pod *api.PodSandbox) (string, error) {
+	ref := strings.TrimSpace(pod.GetNetwork().GetCniResult())
+	if ref == "" {
+		return "", errors.New("no CNI result for pod")
+	}
+
+	data := []byte(ref)
+	if !strings.HasPrefix(ref, "{") {
+		var err error
+		if data, err = os.ReadFile(ref); err != nil {
+			return "", fmt.Errorf("failed to read CNI result: %w", err)
+		}
+	}
+
+	entry := &cniCacheEntry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return "", fmt.Errorf("failed to parse CNI result: %w", err)
+	}
+	result := entry.Result
+	if result == nil {
+		result = &cniResult{}
+		if err := json.Unmarshal(data, result); err != nil {
+			return "", fmt.Errorf("failed to parse CNI result: %w", err)
+		}
+	}
+
+	// Plugins list the host-side end of a veth pair right before its end in
+	// the pod, after any bridge it is attached to.
+	host := ""
+	for _, iface := range result.Interfaces {
+		if iface.Sandbox != "" {
+			break
+		}
+		host = iface.Name
+	}
+	if host == "" {
+		return "", errors.New("no host-side interface in CNI result")
+	}
+
+	return host, nil
+}
@@ -0,0 +1,214 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// a time window with different shaping for a class
+type window struct {
+	// Start of the window, as HH:MM in local time.
+	Start string `json:"start"`
+	// End of the window, as HH:MM in local time. Windows ending before
+	// they start span midnight.
+	End string `json:"end"`
+	// Rate during the window. Unset parameters are taken from the class.
+	Rate string `json:"rate"`
+	// Ceil during the window. Defaults to Rate, if that is set.
+	Ceil string `json:"ceil"`
+	// Priority during the window.
+	Priority *int `json:"priority"`
+	// Burst during the window.
+	Burst string `json:"burst"`
+
+	start, end int // minutes since midnight
+}
+
+// a monthly traffic quota for pods of a class
+type quota struct {
+	// Bytes pods of the class can receive per calendar month.
+	Bytes uint64 `json:"bytes"`
+	// Rate pods are limited to once they exhaust the quota.
+	Rate string `json:"rate"`
+}
+
+// a shaped pod
+type shapedPod struct {
+	namespace string
+	name      string
+	dev       string
+	class     string
+	applied   *class
+	rate      uint64
+	month     string
+	used      uint64
+	last      uint64
+}
+
+// check checks the window and fills in defaults.
+func (w *window) check() error {
+	var err error
+	if w.start, err = parseTimeOfDay(w.Start); err != nil {
+		return err
+	}
+	if w.end, err = parseTimeOfDay(w.End); err != nil {
+		return err
+	}
+	if w.start == w.end {
+		return fmt.Errorf("empty window %s-%s", w.Start, w.End)
+	}
+	if w.Rate != "" && w.Ceil == "" {
+		w.Ceil = w.Rate
+	}
+	if w.Priority != nil && (*w.Priority < 0 || *w.Priority > 7) {
+		return fmt.Errorf("window %s-%s: invalid priority %d", w.Start, w.End, *w.Priority)
+	}
+	return nil
+}
+
+// contains checks if the window contains the given time of day.
+func (w *window) contains(now time.Time) bool {
+	m := now.Hour()*60 + now.Minute()
+	if w.start < w.end {
+		return w.start <= m && m < w.end
+	}
+	return m >= w.start || m < w.end
+}
+
+// parseTimeOfDay parses HH:MM into minutes since midnight.
+func parseTimeOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// at returns the shaping of the class at the given time, taking its first
+// window containing the time, and its quota if that is exhausted, into
+// account.
+func (c *class) at(now time.Time, exhausted bool) *class {
+	e := &class{
+		Name:     c.Name,
+		Rate:     c.Rate,
+		Ceil:     c.Ceil,
+		Priority: c.Priority,
+		Burst:    c.Burst,
+	}
+
+	for _, w := range c.Windows {
+		if !w.contains(now) {
+			continue
+		}
+		if w.Rate != "" {
+			e.Rate, e.Ceil = w.Rate, w.Ceil
+		} else if w.Ceil != "" {
+			e.Ceil = w.Ceil
+		}
+		if w.Priority != nil {
+			e.Priority = w.Priority
+		}
+		if w.Burst != "" {
+			e.Burst = w.Burst
+		}
+		break
+	}
+
+	if exhausted && c.Quota != nil {
+		e.Rate, e.Ceil = c.Quota.Rate, c.Quota.Rate
+	}
+
+	return e
+}
+
+// equal checks if two classes shape traffic the same way.
+func (c *class) equal(o *class) bool {
+	return c.Rate == o.Rate && c.Ceil == o.Ceil && *c.Priority == *o.Priority && c.Burst == o.Burst
+}
+
+// poll periodically samples the usage of pods and reapplies shaping to pods
+// whose shaping changes with time windows or exhausted quotas.
+func (p *plugin) poll() {
+	for range time.Tick(p.interval) {
+		p.reschedule(time.Now())
+	}
+}
+
+// reschedule samples the usage of pods, if enabled, accounts the traffic of
+// pods with a quota, and reapplies shaping to the pods whose shaping has
+// changed.
+func (p *plugin) reschedule(now time.Time) {
+	p.Lock()
+	defer p.Unlock()
+
+	month := now.Format("2006-01")
+	for id, s := range p.shaped {
+		if p.collector != nil {
+			if err := p.collector.sample(id, s, now); err != nil {
+				log.Warnf("%s/%s: failed to sample usage: %v", s.namespace, s.name, err)
+			}
+		}
+
+		c := p.cfg.class(s.namespace, s.class)
+		if c == nil {
+			continue
+		}
+
+		exhausted := false
+		if c.Quota != nil {
+			p.account(s, month)
+			exhausted = s.used >= c.Quota.Bytes
+		}
+
+		e := c.at(now, exhausted)
+		if e.equal(s.applied) {
+			continue
+		}
+		if err := p.shaper.setup(s.dev, e); err != nil {
+			log.Warnf("%s/%s: failed to reshape %s: %v", s.namespace, s.name, s.dev, err)
+			continue
+		}
+		s.applied = e
+
+		log.Infof("%s/%s: reshaping %s of class %s to rate %s, ceil %s", s.namespace, s.name,
+			s.dev, c.Name, e.Rate, e.Ceil)
+	}
+}
+
+// account adds the traffic sent to the pod since the last time to its
+// usage for the month.
+func (p *plugin) account(s *shapedPod, month string) {
+	sent, err := p.shaper.sent(s.dev)
+	if err != nil {
+		log.Warnf("%s/%s: failed to account traffic: %v", s.namespace, s.name, err)
+		return
+	}
+
+	if s.month != month {
+		s.month = month
+		s.used = 0
+	}
+	// Counters are reset if the qdisc is recreated.
+	if sent >= s.last {
+		s.used += sent - s.last
+	} else {
+		s.used += sent
+	}
+	s.last = sent
+}
@@ -0,0 +1,392 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/netprobe"
+)
+
+// plugin configuration
+type config struct {
+	// Classes traffic can be shaped to.
+	Classes []*class `json:"classes"`
+	// DefaultClass for pods without a class annotation. If unset, such
+	// pods are not shaped.
+	DefaultClass string `json:"defaultClass"`
+	// UnknownClass for pods annotated with a class which is not defined.
+	// If unset, such pods are rejected.
+	UnknownClass string `json:"unknownClass"`
+	// Namespaces overrides classes for pods in some namespaces. Overrides
+	// are given by class name, and inherit what they don't set from the
+	// class they override.
+	Namespaces map[string][]*class `json:"namespaces"`
+	// Capacity of the node in tc units, like 10gbit, which the total of the
+	// rates guaranteed to pods must not exceed. If unset, pods are admitted
+	// regardless of their rates.
+	Capacity string `json:"capacity"`
+	// CapacityInterface to take the capacity from, by its link speed, if
+	// Capacity is unset.
+	CapacityInterface string `json:"capacityInterface"`
+	// DowngradeClass to shape pods to which don't fit within the capacity
+	// with their own class. If unset, such pods are rejected.
+	DowngradeClass string `json:"downgradeClass"`
+
+	// classes overridden per namespace
+	overrides map[string]map[string]*class
+	// capacity in bits per second
+	capacity uint64
+}
+
+// a latency class
+type class struct {
+	// Name of the class, used in pod annotations.
+	Name string `json:"name"`
+	// Parent class to inherit unset parameters from.
+	Parent string `json:"parent"`
+	// Rate guaranteed to pods of the class, in tc units, like 100mbit.
+	Rate string `json:"rate"`
+	// Ceil is the rate pods of the class can borrow up to. Defaults to Rate.
+	Ceil string `json:"ceil"`
+	// Priority of the class when borrowing, 0 (highest) to 7 (lowest).
+	Priority *int `json:"priority"`
+	// Burst size in tc units, like 32kb.
+	Burst string `json:"burst"`
+	// Windows of time with different shaping, like higher rates at night.
+	Windows []*window `json:"windows"`
+	// Quota of traffic per month, after which pods are limited further.
+	Quota *quota `json:"quota"`
+
+	rate uint64 // Rate in bits per second, if there is a capacity
+}
+
+// parseConfig parses and checks the given YAML configuration.
+func parseConfig(data []byte) (*config, error) {
+	cfg := &config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse configuration: %w", err)
+	}
+
+	classes := map[string]*class{}
+	for _, c := range cfg.Classes {
+		if c.Name == "" {
+			return nil, fmt.Errorf("class without a name")
+		}
+		if _, ok := classes[c.Name]; ok {
+			return nil, fmt.Errorf("class %s defined more than once", c.Name)
+		}
+		classes[c.Name] = c
+	}
+
+	// Resolve inheritance before filling in defaults, so that defaults of
+	// parents don't override what children set, like Ceil defaulting to Rate.
+	resolved := map[string]struct{}{}
+	for _, c := range cfg.Classes {
+		if err := c.resolve(classes, resolved, map[string]struct{}{}); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg.overrides = map[string]map[string]*class{}
+	for ns, overrides := range cfg.Namespaces {
+		cfg.overrides[ns] = map[string]*class{}
+		for _, o := range overrides {
+			base, ok := classes[o.Name]
+			if !ok {
+				return nil, fmt.Errorf("namespace %s: override of unknown class %q", ns, o.Name)
+			}
+			if _, ok := cfg.overrides[ns][o.Name]; ok {
+				return nil, fmt.Errorf("namespace %s: class %s overridden more than once", ns, o.Name)
+			}
+			if o.Parent != "" {
+				return nil, fmt.Errorf("namespace %s: override of class %s cannot set a parent", ns, o.Name)
+			}
+			o.inherit(base)
+			cfg.overrides[ns][o.Name] = o
+		}
+	}
+
+	for _, c := range cfg.Classes {
+		if err := c.complete(); err != nil {
+			return nil, err
+		}
+	}
+	for ns, overrides := range cfg.overrides {
+		for _, o := range overrides {
+			if err := o.complete(); err != nil {
+				return nil, fmt.Errorf("namespace %s: %w", ns, err)
+			}
+		}
+	}
+
+	if cfg.DefaultClass != "" && cfg.class("", cfg.DefaultClass) == nil {
+		return nil, fmt.Errorf("unknown default class %s", cfg.DefaultClass)
+	}
+	if cfg.UnknownClass != "" && cfg.class("", cfg.UnknownClass) == nil {
+		return nil, fmt.Errorf("unknown class %s for unknown classes", cfg.UnknownClass)
+	}
+
+	if err := cfg.checkCapacity(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// resolve resolves the inheritance of the class from its ancestors.
+func (c *class) resolve(classes map[string]*class, resolved, visiting map[string]struct{}) error {
+	if _, ok := resolved[c.Name]; ok || c.Parent == "" {
+		resolved[c.Name] = struct{}{}
+		return nil
+	}
+	if _, ok := visiting[c.Name]; ok {
+		return fmt.Errorf("class %s inherits from itself", c.Name)
+	}
+	visiting[c.Name] = struct{}{}
+
+	parent, ok := classes[c.Parent]
+	if !ok {
+		return fmt.Errorf("class %s: unknown parent class %q", c.Name, c.Parent)
+	}
+	if err := parent.resolve(classes, resolved, visiting); err != nil {
+		return err
+	}
+	c.inherit(parent)
+	resolved[c.Name] = struct{}{}
+
+	return nil
+}
+
+// inherit sets the parameters of the class which are unset from another.
+func (c *class) inherit(from *class) {
+	if c.Rate == "" {
+		c.Rate = from.Rate
+	}
+	if c.Ceil == "" {
+		c.Ceil = from.Ceil
+	}
+	if c.Priority == nil {
+		c.Priority = from.Priority
+	}
+	if c.Burst == "" {
+		c.Burst = from.Burst
+	}
+	if c.Windows == nil {
+		c.Windows = from.Windows
+	}
+	if c.Quota == nil {
+		c.Quota = from.Quota
+	}
+}
+
+// complete checks the class and fills in defaults.
+func (c *class) complete() error {
+	if c.Rate == "" {
+		return fmt.Errorf("class %s: no rate", c.Name)
+	}
+	if c.Ceil == "" {
+		c.Ceil = c.Rate
+	}
+	if c.Priority == nil {
+		c.Priority = new(int)
+	}
+	if *c.Priority < 0 || *c.Priority > 7 {
+		return fmt.Errorf("class %s: invalid priority %d", c.Name, *c.Priority)
+	}
+	for _, w := range c.Windows {
+		if err := w.check(); err != nil {
+			return fmt.Errorf("class %s: %w", c.Name, err)
+		}
+	}
+	if q := c.Quota; q != nil && (q.Bytes == 0 || q.Rate == "") {
+		return fmt.Errorf("class %s: quota needs bytes and a rate", c.Name)
+	}
+	return nil
+}
+
+// class returns the named class, with any override for the namespace.
+func (cfg *config) class(namespace, name string) *class {
+	if c, ok := cfg.overrides[namespace][name]; ok {
+		return c
+	}
+	for _, c := range cfg.Classes {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// unknownClass returns the rejection for pods annotated with an unknown
+// class name, listing the valid classes and suggesting the closest one, if
+// any is close.
+func (cfg *config) unknownClass(name string) error {
+	var (
+		valid      []string
+		suggestion string
+		best       = len(name)/2 + 1
+	)
+	for _, c := range cfg.Classes {
+		valid = append(valid, c.Name)
+		if d := editDistance(strings.ToLower(name), strings.ToLower(c.Name)); d < best {
+			suggestion, best = c.Name, d
+		}
+	}
+
+	msg := fmt.Sprintf("unknown latency class %q", name)
+	if suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	return &api.ErrRejected{
+		Reason:  "UnknownLatencyClass",
+		Message: msg + " (valid classes: " + strings.Join(valid, ", ") + ")",
+	}
+}
+
+// editDistance returns the Levenshtein distance of two strings.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// checkNode checks that the node, with its root filesystem at the given
+// directory, has tc and the qdiscs needed for shaping.
+func checkNode(root string) error {
+	prober, err := netprobe.New(netprobe.WithRoot(root))
+	if err != nil {
+		return err
+	}
+	err = prober.Probe().Check(netprobe.FeatureTC, netprobe.FeatureHTB, netprobe.FeatureFQCodel)
+	if err != nil {
+		return fmt.Errorf("node cannot shape traffic: %w", err)
+	}
+	return nil
+}
+
+// shaper sets up HTB shaping on interfaces using tc.
+type shaper struct {
+	dryRun bool
+}
+
+// setup replaces the root qdisc of the interface with an HTB hierarchy
+// shaping all traffic to the class. On the host-side end of a veth pair
+// this shapes traffic sent to the pod.
+func (s *shaper) setup(dev string, c *class) error {
+	htb := []string{"rate", c.Rate, "ceil", c.Ceil, "prio", strconv.Itoa(*c.Priority)}
+	if c.Burst != "" {
+		htb = append(htb, "burst", c.Burst, "cburst", c.Burst)
+	}
+
+	for _, args := range [][]string{
+		{"qdisc", "replace", "dev", dev, "root", "handle", "1:", "htb", "default", "10"},
+		{"class", "replace", "dev", dev, "parent", "1:", "classid", "1:1", "htb", "rate", c.Ceil},
+		append([]string{"class", "replace", "dev", dev, "parent", "1:1", "classid", "1:10", "htb"}, htb...),
+		{"qdisc", "replace", "dev", dev, "parent", "1:10", "handle", "10:", "fq_codel"},
+	} {
+		if err := s.tc(args...); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cleanup removes shaping from the interface. The interface may already
+// be gone with the pod network, which is not an error.
+func (s *shaper) cleanup(dev string) error {
+	err := s.tc("qdisc", "del", "dev", dev, "root")
+	if err != nil && strings.Contains(err.Error(), "Cannot find device") {
+		return nil
+	}
+	return err
+}
+
+// sent returns the number of bytes sent through the shaped class of the
+// interface.
+func (s *shaper) sent(dev string) (uint64, error) {
+	if s.dryRun {
+		return 0, nil
+	}
+
+	out, err := s.run("-s", "class", "show", "dev", dev, "classid", "1:10")
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(out)
+	for i := 0; i+2 < len(fields); i++ {
+		if fields[i] == "Sent" && fields[i+2] == "bytes" {
+			return strconv.ParseUint(fields[i+1], 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("no byte counter for class 1:10 of %s", dev)
+}
+
+// tc runs a tc command.
+func (s *shaper) tc(args ...string) error {
+	log.Infof("tc %s", strings.Join(args, " "))
+	if s.dryRun {
+		return nil
+	}
+
+	_, err := s.run(args...)
+	return err
+}
+
+// run runs tc and returns its output.
+func (s *shaper) run(args ...string) (string, error) {
+	out, err := exec.Command("tc", args...).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("tc %s failed: %w: %s", strings.Join(args, " "), err,
+			strings.TrimSpace(string(out)))
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,245 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/containerd/nri/pkg/api"
+	"github.com/containerd/nri/pkg/stub"
+)
+
+const (
+	// Annotation for the latency class of a pod.
+	classKey = "tc-shaper.nri.io/class"
+)
+
+var (
+	log *logrus.Logger
+)
+
+// our shaper plugin
+type plugin struct {
+	sync.Mutex
+	stub   stub.Stub
+	cfg    *config
+	shaper *shaper
+	shaped map[string]*shapedPod
+
+	interval  time.Duration
+	collector *collector
+}
+
+// Configure the plugin, if the runtime passes a configuration.
+func (p *plugin) Configure(config, runtime, version string) (stub.EventMask, error) {
+	if config == "" {
+		return 0, nil
+	}
+
+	cfg, err := parseConfig([]byte(config))
+	if err != nil {
+		return 0, err
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.cfg = cfg
+
+	log.Infof("configured with %d classes by %s %s", len(cfg.Classes), runtime, version)
+
+	return 0, nil
+}
+
+// Synchronize sets up shaping for existing pods.
+func (p *plugin) Synchronize(pods []*api.PodSandbox, _ []*api.Container) ([]*api.ContainerUpdate, error) {
+	for _, pod := range pods {
+		if err := p.RunPodSandbox(pod); err != nil {
+			log.Warnf("%s/%s: %v", pod.Namespace, pod.Name, err)
+		}
+	}
+	return nil, nil
+}
+
+// RunPodSandbox sets up shaping for the pod, once its network is set up.
+func (p *plugin) RunPodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+
+	name, ok := pod.Annotations[classKey]
+	if !ok {
+		name = p.cfg.DefaultClass
+	}
+	if name == "" {
+		return nil
+	}
+
+	c := p.cfg.class(pod.Namespace, name)
+	if c == nil {
+		err := p.cfg.unknownClass(name)
+		if p.cfg.UnknownClass == "" {
+			return err
+		}
+		log.Warnf("%s/%s: %v, using class %s", pod.Namespace, pod.Name, err, p.cfg.UnknownClass)
+		c = p.cfg.class(pod.Namespace, p.cfg.UnknownClass)
+	}
+
+	// Pods without a veth, like ones in the host network, are not shaped.
+	dev, err := hostInterface(pod)
+	if err != nil {
+		log.Warnf("%s/%s: not shaping, failed to find host interface: %v",
+			pod.Namespace, pod.Name, err)
+		return nil
+	}
+
+	if c, err = p.admit(pod, c); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	e := c.at(now, false)
+	if err := p.shaper.setup(dev, e); err != nil {
+		return err
+	}
+
+	s := &shapedPod{
+		namespace: pod.Namespace,
+		name:      pod.Name,
+		dev:       dev,
+		class:     c.Name,
+		applied:   e,
+		rate:      c.rate,
+		month:     now.Format("2006-01"),
+	}
+	if c.Quota != nil {
+		if s.last, err = p.shaper.sent(dev); err != nil {
+			log.Warnf("%s/%s: failed to account traffic: %v", pod.Namespace, pod.Name, err)
+		}
+	}
+	p.shaped[pod.Id] = s
+
+	log.Infof("%s/%s: shaping %s to class %s", pod.Namespace, pod.Name, dev, c.Name)
+
+	return nil
+}
+
+// StopPodSandbox removes shaping before the pod network is torn down.
+func (p *plugin) StopPodSandbox(pod *api.PodSandbox) error {
+	p.Lock()
+	defer p.Unlock()
+
+	s, ok := p.shaped[pod.Id]
+	if !ok {
+		return nil
+	}
+	delete(p.shaped, pod.Id)
+	if p.collector != nil {
+		p.collector.forget(pod.Id)
+	}
+
+	if err := p.shaper.cleanup(s.dev); err != nil {
+		log.Warnf("%s/%s: failed to remove shaping from %s: %v", pod.Namespace, pod.Name, s.dev, err)
+	}
+
+	return nil
+}
+
+func main() {
+	var (
+		pluginName  string
+		pluginIdx   string
+		configFile  string
+		hostRoot    string
+		metricsAddr string
+		opts        []stub.Option
+		err         error
+	)
+
+	log = logrus.StandardLogger()
+	log.SetFormatter(&logrus.TextFormatter{
+		PadLevelText: true,
+	})
+
+	p := &plugin{
+		cfg:    &config{},
+		shaper: &shaper{},
+		shaped: make(map[string]*shapedPod),
+	}
+
+	flag.StringVar(&pluginName, "name", "", "plugin name to register to NRI")
+	flag.StringVar(&pluginIdx, "idx", "", "plugin index to register to NRI")
+	flag.StringVar(&configFile, "config", "", "configuration file with latency classes")
+	flag.BoolVar(&p.shaper.dryRun, "dry-run", false, "only log tc commands, don't run them")
+	flag.DurationVar(&p.interval, "interval", time.Minute, "interval to check time windows and quotas at")
+	flag.StringVar(&metricsAddr, "metrics-addr", "", "if set, sample pod network usage and serve it on this address, like :9111")
+	flag.StringVar(&hostRoot, "host-root", "", "if set, check the node with its root filesystem here supports shaping")
+	flag.Parse()
+
+	if pluginName != "" {
+		opts = append(opts, stub.WithPluginName(pluginName))
+	}
+	if pluginIdx != "" {
+		opts = append(opts, stub.WithPluginIdx(pluginIdx))
+	}
+
+	if configFile != "" {
+		data, err := os.ReadFile(configFile)
+		if err != nil {
+			log.Fatalf("failed to read configuration: %v", err)
+		}
+		if p.cfg, err = parseConfig(data); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+
+	if hostRoot != "" {
+		if err := checkNode(hostRoot); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+
+	if p.interval <= 0 {
+		log.Fatalf("invalid -interval")
+	}
+
+	if metricsAddr != "" {
+		p.collector = newCollector()
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", p.collector)
+		go func() {
+			log.Errorf("metrics server exited: %v", http.ListenAndServe(metricsAddr, mux))
+		}()
+	}
+
+	if p.stub, err = stub.New(p, opts...); err != nil {
+		log.Fatalf("failed to create plugin stub: %v", err)
+	}
+
+	go p.poll()
+
+	err = p.stub.Run(context.Background())
+	if err != nil {
+		log.Errorf("plugin exited with error %v", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,164 @@
+/*
+   Copyright The containerd Authors.
+
+   Licensed under the Apache License, Version 2.0 (the "License");
+   you may not use this file except in compliance with the License.
+   You may obtain a copy of the License at
+
+       http://www.apache.org/licenses/LICENSE-2.0
+
+   Unless required by applicable law or agreed to in writing, software
+   distributed under the License is distributed on an "AS IS" BASIS,
+   WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+   See the License for the specific language governing permissions and
+   limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// network usage of a shaped pod
+type usage struct {
+	namespace string
+	name      string
+	class     string
+	// bytes received and transmitted by the pod
+	rxBytes uint64
+	txBytes uint64
+	// bits per second received and transmitted since the previous sample
+	rxRate float64
+	txRate float64
+	// rate guaranteed to the pod, in bits per second
+	guaranteed uint64
+	sampled    time.Time
+}
+
+// collector samples the traffic counters of shaped pods and serves them in
+// the Prometheus text format. The samples show how much of their guaranteed
+// rate pods actually use, which could be lent to other pods.
+type collector struct {
+	sync.Mutex
+	pods map[string]*usage
+}
+
+func newCollector() *collector {
+	return &collector{
+		pods: make(map[string]*usage),
+	}
+}
+
+// sample samples the counters of the host-side interface of a pod. Traffic
+// transmitted by the host-side end is received by the pod, and vice versa.
+func (c *collector) sample(id string, s *shapedPod, now time.Time) error {
+	tx, err := readCounter(s.dev, "rx_bytes")
+	if err != nil {
+		return err
+	}
+	rx, err := readCounter(s.dev, "tx_bytes")
+	if err != nil {
+		return err
+	}
+	guaranteed, _ := parseRate(s.applied.Rate)
+
+	c.Lock()
+	defer c.Unlock()
+
+	u, ok := c.pods[id]
+	if !ok {
+		u = &usage{}
+		c.pods[id] = u
+	} else if elapsed := now.Sub(u.sampled).Seconds(); elapsed > 0 {
+		u.rxRate = counterRate(u.rxBytes, rx, elapsed)
+		u.txRate = counterRate(u.txBytes, tx, elapsed)
+	}
+	u.namespace, u.name, u.class = s.namespace, s.name, s.applied.Name
+	u.rxBytes, u.txBytes = rx, tx
+	u.guaranteed = guaranteed
+	u.sampled = now
+
+	return nil
+}
+
+// forget forgets the usage of a pod.
+func (c *collector) forget(id string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.pods, id)
+}
+
+// ServeHTTP serves the usage of pods.
+func (c *collector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	c.Lock()
+	defer c.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+
+	ids := make([]string, 0, len(c.pods))
+	for id := range c.pods {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, m := range []struct {
+		name, typ, help string
+		value           func(*usage) string
+	}{
+		{
+			"nri_tc_shaper_pod_receive_bytes_total", "counter", "Bytes received by the pod.",
+			func(u *usage) string { return strconv.FormatUint(u.rxBytes, 10) },
+		},
+		{
+			"nri_tc_shaper_pod_transmit_bytes_total", "counter", "Bytes transmitted by the pod.",
+			func(u *usage) string { return strconv.FormatUint(u.txBytes, 10) },
+		},
+		{
+			"nri_tc_shaper_pod_receive_rate_bits", "gauge", "Bits per second received by the pod.",
+			func(u *usage) string { return strconv.FormatFloat(u.rxRate, 'f', 0, 64) },
+		},
+		{
+			"nri_tc_shaper_pod_transmit_rate_bits", "gauge", "Bits per second transmitted by the pod.",
+			func(u *usage) string { return strconv.FormatFloat(u.txRate, 'f', 0, 64) },
+		},
+		{
+			"nri_tc_shaper_pod_guaranteed_rate_bits", "gauge", "Bits per second guaranteed to the pod.",
+			func(u *usage) string { return strconv.FormatUint(u.guaranteed, 10) },
+		},
+	} {
+		fmt.Fprintf(w, "# HELP %s %s\n", m.name, m.help)
+		fmt.Fprintf(w, "# TYPE %s %s\n", m.name, m.typ)
+		for _, id := range ids {
+			u := c.pods[id]
+			fmt.Fprintf(w, "%s{namespace=%q,pod=%q,class=%q} %s\n", m.name, u.namespace, u.name,
+				u.class, m.value(u))
+		}
+	}
+}
+
+// readCounter reads a statistics counter of a network interface.
+func readCounter(dev, name string) (uint64, error) {
+	data, err := os.ReadFile(filepath.Join("/sys/class/net", dev, "statistics", name))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s of %s: %w", name, dev, err)
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// counterRate returns the rate in bits per second between two samples of
+// a byte counter. Counters are reset if the interface is recreated.
+func counterRate(prev, cur uint64, elapsed float64) float64 {
+	if cur < prev {
+		return 0
+	}
+	return float64(cur-prev) * 8 / elapsed
+}
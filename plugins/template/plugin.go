@@ -43,6 +43,16 @@ var (
 	log *logrus.Logger
 )
 
+// Make sure the plugin implements the interfaces it is meant to.
+var (
+	_ stub.ConfigureInterface          = (*plugin)(nil)
+	_ stub.SynchronizeInterface        = (*plugin)(nil)
+	_ stub.ShutdownInterface           = (*plugin)(nil)
+	_ stub.PodLifecycleInterface       = (*plugin)(nil)
+	_ stub.ContainerAdjusterInterface  = (*plugin)(nil)
+	_ stub.ContainerLifecycleInterface = (*plugin)(nil)
+)
+
 func (p *plugin) Configure(config, runtime, version string) (stub.EventMask, error) {
 	log.Infof("Connected to %s/%s...", runtime, version)
 
@@ -65,7 +75,7 @@ func (p *plugin) Synchronize(pods []*api.PodSandbox, containers []*api.Container
 	return nil, nil
 }
 
-func (p *plugin) Shutdown() {
+func (p *plugin) Shutdown(_ *api.ShutdownRequest) {
 	log.Info("Runtime shutting down...")
 }
 
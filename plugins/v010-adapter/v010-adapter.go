@@ -43,7 +43,7 @@ func (p *plugin) Configure(config, runtime, version string) (stub.EventMask, err
 	return 0, nil
 }
 
-func (p *plugin) Shutdown() {
+func (p *plugin) Shutdown(_ *api.ShutdownRequest) {
 	log.Info("Runtime shutting down...")
 }
 